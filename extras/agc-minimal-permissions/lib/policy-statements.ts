@@ -814,6 +814,12 @@ export class AgcPermissions {
                         "Update*",
                         "Delete*",
                     ),
+                    ...actions("logs",
+                        "DeleteLogGroup",
+                    ),
+                    ...actions("ecr",
+                        "DeleteRepository",
+                    ),
                 ],
                 resources: [
                     this.arn({service: "apigateway", account: "", resource: "/restapis*"}),
@@ -826,6 +832,8 @@ export class AgcPermissions {
                     this.arn({service: "servicediscovery", resource: "*"}),
                     this.arn({service: "batch", resource: "job-queue", resourceName: "TaskBatch*"}),
                     this.arn({service: "batch", resource: "compute-environment", resourceName: "TaskBatch*"}),
+                    this.arn({service: "logs", resource: "log-group:*"}),
+                    this.arn({service: "ecr", resource: "repository", resourceName: "*"}),
                 ]
             }),
             new PolicyStatement({
@@ -848,6 +856,16 @@ export class AgcPermissions {
                         "DeleteVpcEndpointServiceConfigurations",
                         "DescribeVpcEndpointServiceConfigurations",
                     ),
+                    // inventory and verification of AGC tagged resources
+                    ...actions("tag",
+                        "GetResources",
+                    ),
+                    ...actions("logs",
+                        "DescribeLogGroups",
+                    ),
+                    ...actions("ecr",
+                        "DescribeRepositories",
+                    ),
                 ],
                 resources: [
                     "*"
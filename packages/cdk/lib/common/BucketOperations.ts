@@ -1,8 +1,37 @@
-import { Arn, ArnFormat } from "aws-cdk-lib";
+import { Arn, ArnFormat, Aws } from "aws-cdk-lib";
 import { Bucket, IBucket } from "aws-cdk-lib/aws-s3";
-import { IRole } from "aws-cdk-lib/aws-iam";
+import { Effect, IRole, PolicyStatement } from "aws-cdk-lib/aws-iam";
 import { Construct } from "constructs";
 
+const readKeyActions = ["kms:Decrypt"];
+const readWriteKeyActions = ["kms:Decrypt", "kms:GenerateDataKey"];
+const readObjectActions = ["s3:GetObject*"];
+const readWriteObjectActions = [
+  ...readObjectActions,
+  "s3:PutObject",
+  "s3:PutObjectLegalHold",
+  "s3:PutObjectRetention",
+  "s3:PutObjectTagging",
+  "s3:PutObjectVersionTagging",
+  "s3:Abort*",
+  "s3:DeleteObject*",
+];
+
+export interface DataAccessOptions {
+  /**
+   * ARNs of the KMS keys that encrypt the read-only data of the project.
+   */
+  readonly readKmsKeyArns?: string[];
+  /**
+   * ARNs of the KMS keys that encrypt the read-write data of the project.
+   */
+  readonly readWriteKmsKeyArns?: string[];
+  /**
+   * The account expected to own each cross-account bucket of the project, keyed by bucket name.
+   */
+  readonly dataBucketOwners?: { [bucketName: string]: string };
+}
+
 export class BucketOperations {
   private static readonly importedBuckets: Record<string, IBucket> = {};
 
@@ -12,14 +41,64 @@ export class BucketOperations {
       const bucketName = arnComponents.resource;
       const bucketPrefix = arnComponents.resourceName;
       const bucket = this.importBucket(scope, `${bucketName}Bucket`, bucketName);
-      if (readOnly) {
-        bucket.grantRead(role, bucketPrefix);
+      if (bucketPrefix) {
+        this.grantPrefixAccess(role, bucket, bucketPrefix, readOnly);
+      } else if (readOnly) {
+        bucket.grantRead(role);
       } else {
-        bucket.grantReadWrite(role, bucketPrefix);
+        bucket.grantReadWrite(role);
       }
     });
   }
 
+  /**
+   * Grants a role access to the objects matching a key pattern, 'cohortA/*' or the key of a single object. The bucket
+   * grants of the CDK allow listing the whole bucket, so listing is granted here only under the prefix of the pattern.
+   */
+  private static grantPrefixAccess(role: IRole, bucket: IBucket, keyPattern: string, readOnly?: boolean): void {
+    const prefix = keyPattern.replace(/\/?\*$/, "");
+    role.addToPrincipalPolicy(
+      new PolicyStatement({
+        actions: readOnly ? readObjectActions : readWriteObjectActions,
+        resources: [bucket.arnForObjects(keyPattern)],
+      })
+    );
+    role.addToPrincipalPolicy(
+      new PolicyStatement({
+        actions: ["s3:ListBucket"],
+        resources: [bucket.bucketArn],
+        conditions: { StringLike: { "s3:prefix": [prefix, `${prefix}/*`] } },
+      })
+    );
+    role.addToPrincipalPolicy(new PolicyStatement({ actions: ["s3:GetBucketLocation"], resources: [bucket.bucketArn] }));
+  }
+
+  /**
+   * Grants a role the use of the KMS keys of the project data and denies it access to the data buckets whose owner
+   * isn't the expected account, so that a re-created bucket of the same name in another account is never used.
+   */
+  public static grantDataAccess(role: IRole, options: DataAccessOptions): void {
+    const { readKmsKeyArns = [], readWriteKmsKeyArns = [], dataBucketOwners = {} } = options;
+    if (readKmsKeyArns.length > 0) {
+      role.addToPrincipalPolicy(new PolicyStatement({ actions: readKeyActions, resources: readKmsKeyArns }));
+    }
+    if (readWriteKmsKeyArns.length > 0) {
+      role.addToPrincipalPolicy(new PolicyStatement({ actions: readWriteKeyActions, resources: readWriteKmsKeyArns }));
+    }
+    Object.keys(dataBucketOwners).forEach((bucketName) => {
+      role.addToPrincipalPolicy(
+        new PolicyStatement({
+          effect: Effect.DENY,
+          actions: ["s3:*"],
+          resources: [`arn:${Aws.PARTITION}:s3:::${bucketName}`, `arn:${Aws.PARTITION}:s3:::${bucketName}/*`],
+          conditions: {
+            StringNotEquals: { "s3:ResourceAccount": dataBucketOwners[bucketName] },
+          },
+        })
+      );
+    });
+  }
+
   public static importBucket(scope: Construct, bucketId: string, bucketName: string): IBucket {
     if (!this.importedBuckets[bucketId]) {
       this.importedBuckets[bucketId] = Bucket.fromBucketName(scope, bucketId, bucketName);
@@ -0,0 +1,77 @@
+import { Arn, ArnFormat } from "aws-cdk-lib";
+import { Secret } from "aws-cdk-lib/aws-ecs";
+import { IGrantable } from "aws-cdk-lib/aws-iam";
+import { ISecret, Secret as SecretsManagerSecret } from "aws-cdk-lib/aws-secretsmanager";
+import { StringParameter } from "aws-cdk-lib/aws-ssm";
+import { Construct } from "constructs";
+import { TaskSecret } from "../types";
+
+const secretSourceSecretsManager = "secretsManager";
+
+/**
+ * Names of the variables, comma separated, that engines forward to the containers of the workflow tasks.
+ */
+export const TASK_ENVIRONMENT_NAMES_VARIABLE = "AGC_TASK_ENVIRONMENT_NAMES";
+/**
+ * Names of the variables, comma separated, that hold secrets and must not be printed by the engines.
+ */
+export const TASK_SECRET_NAMES_VARIABLE = "AGC_TASK_SECRET_NAMES";
+
+/**
+ * The environment variables and secrets of a context, which are set in the containers of its engine and forwarded by
+ * the engine to the containers of the workflow tasks.
+ */
+export class TaskEnvironment {
+  public readonly environment: { [key: string]: string };
+  public readonly secrets: { [key: string]: Secret };
+  /**
+   * The store and the name or ARN of each secret, as '<source>:<valueFrom>', for the engines that read the secrets
+   * themselves.
+   */
+  public readonly secretReferences: { [key: string]: string };
+
+  constructor(scope: Construct, environment: { [key: string]: string }, taskSecrets: TaskSecret[]) {
+    this.secrets = {};
+    this.secretReferences = {};
+    taskSecrets.forEach((taskSecret) => {
+      this.secrets[taskSecret.name] = TaskEnvironment.renderSecret(scope, taskSecret);
+      this.secretReferences[taskSecret.name] = `${taskSecret.source}:${taskSecret.valueFrom}`;
+    });
+
+    const names = Object.keys(environment).concat(Object.keys(this.secrets));
+    this.environment = { ...environment };
+    if (names.length > 0) {
+      this.environment[TASK_ENVIRONMENT_NAMES_VARIABLE] = names.join(",");
+    }
+    if (taskSecrets.length > 0) {
+      this.environment[TASK_SECRET_NAMES_VARIABLE] = Object.keys(this.secrets).join(",");
+    }
+  }
+
+  /**
+   * Grants the roles that start the containers ssm:GetParameters and secretsmanager:GetSecretValue on the secrets.
+   */
+  public grantRead(...grantees: (IGrantable | undefined)[]): void {
+    Object.values(this.secrets).forEach((secret) => {
+      grantees.forEach((grantee) => grantee && secret.grantRead(grantee));
+    });
+  }
+
+  private static renderSecret(scope: Construct, taskSecret: TaskSecret): Secret {
+    const id = `TaskSecret${taskSecret.name}`;
+    if (taskSecret.source == secretSourceSecretsManager) {
+      return Secret.fromSecretsManager(TaskEnvironment.importSecret(scope, id, taskSecret.valueFrom));
+    }
+    const parameterName = taskSecret.valueFrom.startsWith("arn:")
+      ? Arn.split(taskSecret.valueFrom, ArnFormat.SLASH_RESOURCE_NAME).resourceName!
+      : taskSecret.valueFrom;
+    return Secret.fromSsmParameter(StringParameter.fromSecureStringParameterAttributes(scope, id, { parameterName }));
+  }
+
+  private static importSecret(scope: Construct, id: string, valueFrom: string): ISecret {
+    if (valueFrom.startsWith("arn:")) {
+      return SecretsManagerSecret.fromSecretCompleteArn(scope, id, valueFrom);
+    }
+    return SecretsManagerSecret.fromSecretNameV2(scope, id, valueFrom);
+  }
+}
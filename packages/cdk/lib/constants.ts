@@ -25,4 +25,5 @@ export const ENGINE_NEXTFLOW = "nextflow";
 export const ENGINE_SNAKEMAKE = "snakemake";
 export const ENGINE_TOIL = "toil";
 
-export const TAGGED_RESOURCE_TYPES: TaggedResourceTypes = ["volume"];
+// Tagged through the launch templates of compute environments so that the cost of task jobs is allocated to the tags of the context
+export const TAGGED_RESOURCE_TYPES: TaggedResourceTypes = ["instance", "volume", "network-interface"];
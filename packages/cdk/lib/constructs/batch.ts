@@ -1,5 +1,5 @@
 import { Fn, Names, Stack } from "aws-cdk-lib";
-import { ComputeEnvironment, ComputeResourceType, IComputeEnvironment, IJobQueue, JobQueue } from "@aws-cdk/aws-batch-alpha";
+import { AllocationStrategy, ComputeEnvironment, ComputeResourceType, IComputeEnvironment, IJobQueue, JobQueue } from "@aws-cdk/aws-batch-alpha";
 import { CfnLaunchTemplate, IMachineImage, InstanceType, IVpc, SubnetSelection } from "aws-cdk-lib/aws-ec2";
 import {
   CfnInstanceProfile,
@@ -18,6 +18,12 @@ import { batchArn, ec2Arn } from "../util";
 import { APP_NAME, APP_TAG_KEY, TAGGED_RESOURCE_TYPES } from "../constants";
 import { CfnLaunchTemplateProps } from "aws-cdk-lib/aws-ec2/lib/ec2.generated";
 import { Construct } from "constructs";
+import { ComputeVolume } from "../types";
+
+// The root device of the ECS optimized Amazon Linux 2 AMI
+const rootDeviceName = "/dev/xvda";
+// The initial device of amazon-ebs-autoscale, see ecs-additions/get-amazon-ebs-autoscale.sh
+const scratchDeviceName = "/dev/xvdba";
 
 export interface ComputeOptions {
   /**
@@ -58,6 +64,52 @@ export interface ComputeOptions {
    */
   maxVCpus?: number;
 
+  /**
+   * The minimum number of EC2 vCPUs that a compute-environment keeps running.
+   *
+   * @default 0
+   */
+  minVCpus?: number;
+
+  /**
+   * The maximum percentage of the On-Demand price paid for Spot instances.
+   *
+   * This property is only used with the SPOT compute type.
+   *
+   * @default 100
+   */
+  spotBidPercentage?: number;
+
+  /**
+   * The strategy used to pick instance types when the compute environment scales out.
+   *
+   * SPOT_CAPACITY_OPTIMIZED is only used with the SPOT compute type.
+   *
+   * @default aws-batch:{@link ComputeResources#allocationStrategy}
+   */
+  allocationStrategy?: AllocationStrategy;
+
+  /**
+   * The EBS root volume of the instances.
+   *
+   * @default the root volume of the AMI
+   */
+  rootVolume?: ComputeVolume;
+
+  /**
+   * The EBS volume attached as the initial volume of the auto-scaled docker storage of the instances.
+   *
+   * @default created by amazon-ebs-autoscale
+   */
+  scratchVolume?: ComputeVolume;
+
+  /**
+   * Instance families that may not be launched in the compute environment.
+   *
+   * @default none
+   */
+  excludedInstanceFamilies?: string[];
+
   /**
    * The tags to apply to any compute resources
    * @default none
@@ -127,8 +179,8 @@ export class Batch extends Construct {
   public grantJobAdministration(grantee: IGrantable, jobDefinitionName = "*"): Grant {
     return Grant.addToPrincipal({
       grantee: grantee,
-      actions: ["batch:SubmitJob"],
-      resourceArns: [this.jobQueue.jobQueueArn, batchArn(this, "job-definition", jobDefinitionName)],
+      actions: ["batch:SubmitJob", "batch:TagResource"],
+      resourceArns: [this.jobQueue.jobQueueArn, batchArn(this, "job-definition", jobDefinitionName), batchArn(this, "job")],
     });
   }
 
@@ -201,7 +253,7 @@ export class Batch extends Construct {
       });
     }
 
-    const launchTemplateProps = this.renderLaunchTemplateProps(options.launchTemplateData, options.resourceTags);
+    const launchTemplateProps = this.renderLaunchTemplateProps(options);
 
     /*
      * TAKE NOTE! If you change the launch template you will need to destroy any existing contexts and deploy. A CDK update won't
@@ -217,9 +269,12 @@ export class Batch extends Construct {
         vpc: options.vpc,
         type: computeType,
         maxvCpus: options.maxVCpus,
+        minvCpus: options.minVCpus,
+        bidPercentage: computeType == ComputeResourceType.SPOT ? options.spotBidPercentage : undefined,
+        allocationStrategy: this.getAllocationStrategy(computeType, options.allocationStrategy),
         image: options.computeEnvImage,
         instanceRole: instanceProfile.attrArn,
-        instanceTypes: getInstanceTypesForBatch(options.instanceTypes, computeType, Stack.of(this).region),
+        instanceTypes: getInstanceTypesForBatch(options.instanceTypes, computeType, Stack.of(this).region, options.excludedInstanceFamilies),
         launchTemplate: launchTemplate && {
           launchTemplateName: launchTemplate.launchTemplateName!,
         },
@@ -229,7 +284,16 @@ export class Batch extends Construct {
     });
   }
 
-  private renderLaunchTemplateProps(launchTemplateData?: string, resourceTags?: { [p: string]: string }): CfnLaunchTemplateProps | undefined {
+  private getAllocationStrategy(computeType: ComputeResourceType, allocationStrategy?: AllocationStrategy): AllocationStrategy | undefined {
+    // On-Demand compute environments, like the head compute environment of an engine, can't be capacity optimized for Spot
+    if (allocationStrategy == AllocationStrategy.SPOT_CAPACITY_OPTIMIZED && computeType != ComputeResourceType.SPOT) {
+      return AllocationStrategy.BEST_FIT_PROGRESSIVE;
+    }
+    return allocationStrategy;
+  }
+
+  private renderLaunchTemplateProps(options: ComputeOptions): CfnLaunchTemplateProps | undefined {
+    const { launchTemplateData, resourceTags } = options;
     if (launchTemplateData) {
       let tagSpecifications;
 
@@ -243,15 +307,38 @@ export class Batch extends Construct {
         }));
       }
 
+      const blockDeviceMappings = [
+        ...this.renderBlockDeviceMappings(rootDeviceName, options.rootVolume),
+        ...this.renderBlockDeviceMappings(scratchDeviceName, options.scratchVolume),
+      ];
+
       return {
         launchTemplateName: Names.uniqueId(this),
         launchTemplateData: {
           userData: Fn.base64(launchTemplateData),
           tagSpecifications,
+          blockDeviceMappings: blockDeviceMappings.length > 0 ? blockDeviceMappings : undefined,
         },
       };
     }
 
     return undefined;
   }
+
+  private renderBlockDeviceMappings(deviceName: string, volume?: ComputeVolume): CfnLaunchTemplate.BlockDeviceMappingProperty[] {
+    if (!volume) {
+      return [];
+    }
+    return [
+      {
+        deviceName,
+        ebs: {
+          volumeSize: volume.size,
+          volumeType: volume.volumeType,
+          encrypted: true,
+          deleteOnTermination: true,
+        },
+      },
+    ];
+  }
 }
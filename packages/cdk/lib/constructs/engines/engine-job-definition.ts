@@ -2,13 +2,24 @@ import { JobDefinition, JobDefinitionProps } from "@aws-cdk/aws-batch-alpha";
 import { Construct } from "constructs";
 import { renderBatchLogConfiguration } from "../../util";
 import { ILogGroup } from "aws-cdk-lib/aws-logs";
+import { TaskEnvironment } from "../../common/TaskEnvironment";
 
 interface EngineJobDefinitionProps extends JobDefinitionProps {
   readonly logGroup: ILogGroup;
+  /**
+   * Environment variables and secrets of the context, which the engine forwards to the workflow tasks.
+   *
+   * @default - No environment variables or secrets of the context.
+   */
+  readonly taskEnvironment?: TaskEnvironment;
 }
 
 export class EngineJobDefinition extends JobDefinition {
   constructor(scope: Construct, id: string, props: EngineJobDefinitionProps) {
+    const { taskEnvironment } = props;
+    // Secrets are read by the container agent with the execution role, which jobs on EC2 don't have by default
+    const hasSecrets = taskEnvironment && Object.keys(taskEnvironment.secrets).length > 0;
+    const executionRole = props.container.executionRole ?? (hasSecrets ? props.container.jobRole : undefined);
     super(scope, id, {
       ...props,
       container: {
@@ -17,11 +28,18 @@ export class EngineJobDefinition extends JobDefinition {
         environment: {
           AWS_METADATA_SERVICE_TIMEOUT: "10",
           AWS_METADATA_SERVICE_NUM_ATTEMPTS: "10",
+          ...taskEnvironment?.environment,
           ...props.container.environment,
         },
+        secrets: {
+          ...taskEnvironment?.secrets,
+          ...props.container.secrets,
+        },
+        executionRole,
         memoryLimitMiB: props.container.memoryLimitMiB || 2048,
         vcpus: props.container.vcpus || 1,
       },
     });
+    taskEnvironment?.grantRead(props.container.jobRole, executionRole);
   }
 }
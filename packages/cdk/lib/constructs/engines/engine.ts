@@ -4,11 +4,13 @@ import { IVpc, SubnetSelection } from "aws-cdk-lib/aws-ec2";
 import { AccessPoint, FileSystem, PerformanceMode, ThroughputMode } from "aws-cdk-lib/aws-efs";
 import { RemovalPolicy, Size } from "aws-cdk-lib";
 import { MountPoint, Volume } from "aws-cdk-lib/aws-ecs";
+import { TaskEnvironment } from "../../common/TaskEnvironment";
 
 export interface EngineProps {
   readonly vpc: IVpc;
   readonly subnets: SubnetSelection;
   readonly rootDirS3Uri: string;
+  readonly taskEnvironment?: TaskEnvironment;
 }
 
 export class Engine extends Construct {
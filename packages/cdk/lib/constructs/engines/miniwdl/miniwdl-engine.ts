@@ -25,7 +25,7 @@ export class MiniWdlEngine extends Engine {
   constructor(scope: Construct, id: string, props: MiniWdlEngineProps) {
     super(scope, id);
 
-    const { vpc, subnets, iops, rootDirS3Uri, engineBatch, workerBatch } = props;
+    const { vpc, subnets, iops, rootDirS3Uri, engineBatch, workerBatch, taskEnvironment } = props;
     if (iops?.toMebibytes() == 0 || iops == undefined) {
       this.fileSystem = this.createFileSystemDefaultThroughput(vpc, subnets);
     } else {
@@ -39,6 +39,7 @@ export class MiniWdlEngine extends Engine {
 
     this.headJobDefinition = new EngineJobDefinition(this, "MiniwdlHeadJobDef", {
       logGroup: this.logGroup,
+      taskEnvironment,
       platformCapabilities: [PlatformCapabilities.FARGATE],
       container: {
         memoryLimitMiB: this.engineMemoryMiB,
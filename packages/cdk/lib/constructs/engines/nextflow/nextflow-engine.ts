@@ -8,6 +8,12 @@ import { Engine, EngineProps } from "../engine";
 export interface NextflowEngineProps extends EngineProps {
   readonly jobQueueArn: string;
   readonly taskRole: IRole;
+  /**
+   * Whether the project reads data from requester pays buckets.
+   *
+   * @default false
+   */
+  readonly requesterPays?: boolean;
 }
 
 const NEXTFLOW_IMAGE_DESIGNATION = "nextflow";
@@ -20,6 +26,7 @@ export class NextflowEngine extends Engine {
 
     this.headJobDefinition = new EngineJobDefinition(this, "NexflowHeadJobDef", {
       logGroup: this.logGroup,
+      taskEnvironment: props.taskEnvironment,
       container: {
         jobRole: props.taskRole,
         image: createEcrImage(this, NEXTFLOW_IMAGE_DESIGNATION),
@@ -28,6 +35,10 @@ export class NextflowEngine extends Engine {
           NF_JOB_QUEUE: props.jobQueueArn,
           NF_WORKDIR: `${props.rootDirS3Uri}/runs`,
           NF_LOGSDIR: `${props.rootDirS3Uri}/logs`,
+          NF_REQUESTER_PAYS: String(props.requesterPays ?? false),
+          NF_TASK_SECRETS: Object.entries(props.taskEnvironment?.secretReferences ?? {})
+            .map(([name, reference]) => `${name}=${reference}`)
+            .join(","),
         },
         volumes: [],
       },
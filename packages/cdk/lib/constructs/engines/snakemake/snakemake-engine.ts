@@ -26,7 +26,7 @@ export class SnakemakeEngine extends Engine {
   constructor(scope: Construct, id: string, props: SnakemakeEngineProps) {
     super(scope, id);
 
-    const { vpc, subnets, iops, engineBatch, workerBatch } = props;
+    const { vpc, subnets, iops, engineBatch, workerBatch, taskEnvironment } = props;
     if (iops?.toMebibytes() == 0 || iops == undefined) {
       this.fileSystem = this.createFileSystemDefaultThroughput(vpc, subnets);
     } else {
@@ -39,6 +39,7 @@ export class SnakemakeEngine extends Engine {
     this.fileSystem.grant(workerBatch.role, "elasticfilesystem:DescribeMountTargets", "elasticfilesystem:DescribeFileSystems");
     this.headJobDefinition = new EngineJobDefinition(this, "SnakemakeHeadJobDef", {
       logGroup: this.logGroup,
+      taskEnvironment,
       platformCapabilities: [PlatformCapabilities.FARGATE],
       container: {
         memoryLimitMiB: this.engineMemoryMiB,
@@ -1,7 +1,9 @@
 import { getEnvNumber, getEnvBoolOrDefault, getEnvString, getEnvStringListOrDefault, getEnvStringOrDefault } from "./";
 import { InstanceType } from "aws-cdk-lib/aws-ec2";
+import { AllocationStrategy } from "@aws-cdk/aws-batch-alpha";
 import { Node } from "constructs";
-import { ServiceContainer } from "../types";
+import { ComputeVolume, ServiceContainer, TaskSecret } from "../types";
+import { TaskEnvironment } from "../common/TaskEnvironment";
 
 const oneCpuUnit = 1024;
 const oneGBinMiB = 1024;
@@ -40,6 +42,22 @@ export class ContextAppParameters {
    * A list of ARNs that batch will access for workflow reads and writes.
    */
   public readonly readWriteBucketArns?: string[];
+  /**
+   * ARNs of the KMS keys that encrypt the read-only data of the project.
+   */
+  public readonly readKmsKeyArns?: string[];
+  /**
+   * ARNs of the KMS keys that encrypt the read-write data of the project.
+   */
+  public readonly readWriteKmsKeyArns?: string[];
+  /**
+   * Names of the data buckets whose requester pays for the requests and the data transfer.
+   */
+  public readonly requesterPaysBuckets?: string[];
+  /**
+   * The account expected to own each cross-account data bucket, keyed by bucket name.
+   */
+  public readonly dataBucketOwners: { [bucketName: string]: string };
   /**
    * A KMS Policy to enable cross account S3 SSE-KMS.
    */
@@ -95,6 +113,34 @@ export class ContextAppParameters {
    * The types of EC2 instances that may be launched in the compute environment.
    */
   public readonly instanceTypes?: InstanceType[];
+  /**
+   * The minimum number of Amazon EC2 vCPUs that an environment keeps running.
+   */
+  public readonly minVCpus?: number;
+  /**
+   * The maximum percentage of the On-Demand price paid for Spot instances.
+   */
+  public readonly spotBidPercentage?: number;
+  /**
+   * The strategy used to pick instance types when the compute environment scales out.
+   */
+  public readonly allocationStrategy?: AllocationStrategy;
+  /**
+   * The EBS root volume of the compute instances.
+   */
+  public readonly rootVolume?: ComputeVolume;
+  /**
+   * The EBS scratch volume of the compute instances, the initial volume of the auto-scaled docker storage.
+   */
+  public readonly scratchVolume?: ComputeVolume;
+  /**
+   * The AMI of the compute instances of the context, overriding the AMI of the account.
+   */
+  public readonly contextAmi?: string;
+  /**
+   * Instance families that may not be launched in the compute environment.
+   */
+  public readonly excludedInstanceFamilies?: string[];
   /**
    * If true, put EC2 instances into public subnets instead of private subnets.
    * This allows you to obtain significantly lower ongoing costs if used in conjunction with the usePublicSubnets option
@@ -114,6 +160,16 @@ export class ContextAppParameters {
    */
   public readonly customTags: { [key: string]: string };
 
+  /**
+   * Environment variables set in the containers of the engine and of the workflow tasks.
+   */
+  public readonly taskEnvironment: { [key: string]: string };
+  /**
+   * SSM parameters and Secrets Manager secrets set as environment variables in the containers of the engine and of the
+   * workflow tasks.
+   */
+  public readonly taskSecrets: TaskSecret[];
+
   constructor(node: Node) {
     const instanceTypeStrings = getEnvStringListOrDefault(node, "BATCH_COMPUTE_INSTANCE_TYPES");
 
@@ -126,6 +182,11 @@ export class ContextAppParameters {
     this.artifactBucketName = getEnvString(node, "ARTIFACT_BUCKET");
     this.readBucketArns = getEnvStringListOrDefault(node, "READ_BUCKET_ARNS");
     this.readWriteBucketArns = getEnvStringListOrDefault(node, "READ_WRITE_BUCKET_ARNS");
+    this.readKmsKeyArns = getEnvStringListOrDefault(node, "READ_KMS_KEY_ARNS");
+    this.readWriteKmsKeyArns = getEnvStringListOrDefault(node, "READ_WRITE_KMS_KEY_ARNS");
+    this.requesterPaysBuckets = getEnvStringListOrDefault(node, "REQUESTER_PAYS_BUCKETS");
+    const bucketOwnersJson = getEnvStringOrDefault(node, "DATA_BUCKET_OWNERS");
+    this.dataBucketOwners = bucketOwnersJson ? JSON.parse(bucketOwnersJson) : {};
 
     this.kmsDecryptPolicy = getEnvStringOrDefault(node, "KMS_DECRYPT_POLICY", undefined);
 
@@ -142,6 +203,13 @@ export class ContextAppParameters {
     this.maxVCpus = getEnvNumber(node, "MAX_V_CPUS");
     this.requestSpotInstances = getEnvBoolOrDefault(node, "REQUEST_SPOT_INSTANCES", false)!;
     this.instanceTypes = instanceTypeStrings ? instanceTypeStrings.map((instanceType) => new InstanceType(instanceType.trim())) : undefined;
+    this.minVCpus = getEnvNumber(node, "MIN_V_CPUS");
+    this.spotBidPercentage = getEnvNumber(node, "SPOT_BID_PERCENTAGE") || undefined;
+    this.allocationStrategy = this.getAllocationStrategy(getEnvStringOrDefault(node, "ALLOCATION_STRATEGY"));
+    this.rootVolume = this.getComputeVolume(node, "ROOT_VOLUME");
+    this.scratchVolume = this.getComputeVolume(node, "SCRATCH_VOLUME");
+    this.contextAmi = getEnvStringOrDefault(node, "CONTEXT_AMI");
+    this.excludedInstanceFamilies = getEnvStringListOrDefault(node, "EXCLUDED_INSTANCE_FAMILIES");
 
     this.usePublicSubnets = getEnvBoolOrDefault(node, "PUBLIC_SUBNETS", false);
     this.agcVersion = getEnvString(node, "AGC_VERSION");
@@ -153,6 +221,11 @@ export class ContextAppParameters {
       this.customTags = {};
     }
 
+    const taskEnvironmentJson = getEnvStringOrDefault(node, "TASK_ENVIRONMENT");
+    this.taskEnvironment = taskEnvironmentJson ? JSON.parse(taskEnvironmentJson) : {};
+    const taskSecretsJson = getEnvStringOrDefault(node, "TASK_SECRETS");
+    this.taskSecrets = taskSecretsJson ? JSON.parse(taskSecretsJson) : [];
+
     this.engineType = this.getEngineType();
   }
 
@@ -168,7 +241,7 @@ export class ContextAppParameters {
    * This function defines the container that server-based engines (like Toil
    * or Cromwell) will run their servers in. It is going to run on Fargate.
    */
-  public getEngineContainer(jobQueueArn: string, additionalEnvVars?: { [key: string]: string }): ServiceContainer {
+  public getEngineContainer(jobQueueArn: string, additionalEnvVars?: { [key: string]: string }, taskEnvironment?: TaskEnvironment): ServiceContainer {
     return {
       serviceName: this.engineName,
       imageConfig: { designation: this.engineDesignation },
@@ -180,8 +253,10 @@ export class ContextAppParameters {
         S3BUCKET: this.outputBucketName,
         ROOT_DIR: this.getEngineBucketPath(),
         JOB_QUEUE_ARN: jobQueueArn,
+        ...taskEnvironment?.environment,
         ...additionalEnvVars,
       },
+      secrets: taskEnvironment?.secrets,
     };
   }
 
@@ -201,6 +276,25 @@ export class ContextAppParameters {
     };
   }
 
+  private getAllocationStrategy(allocationStrategy?: string): AllocationStrategy | undefined {
+    if (!allocationStrategy) {
+      return undefined;
+    }
+    if (!Object.values<string>(AllocationStrategy).includes(allocationStrategy)) {
+      throw Error(`Allocation strategy '${allocationStrategy}' is not supported`);
+    }
+    return allocationStrategy as AllocationStrategy;
+  }
+
+  private getComputeVolume(node: Node, keyPrefix: string): ComputeVolume | undefined {
+    const size = getEnvNumber(node, `${keyPrefix}_SIZE`) || undefined;
+    const volumeType = getEnvStringOrDefault(node, `${keyPrefix}_TYPE`);
+    if (size == undefined && volumeType == undefined) {
+      return undefined;
+    }
+    return { size, volumeType };
+  }
+
   public getDefaultFilesystem(): string {
     let defFilesystem: string;
     switch (this.engineName) {
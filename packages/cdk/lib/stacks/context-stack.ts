@@ -39,9 +39,12 @@ export class ContextStack extends Stack {
     this.vpc = Vpc.fromLookup(this, "Vpc", { vpcId });
     const subnetIds = getCommonParameterList(this, VPC_SUBNETS_PARAMETER_NAME, VPC_NUMBER_SUBNETS_PARAMETER_NAME);
     this.subnets = subnetSelectionFromIds(this, subnetIds);
-    this.computeEnvImage = MachineImage.fromSsmParameter(`/${APP_NAME}/_common/${COMPUTE_IMAGE_PARAMETER_NAME}`);
 
     const { contextParameters } = props;
+    const { contextAmi } = contextParameters;
+    this.computeEnvImage = contextAmi
+      ? MachineImage.genericLinux({ [this.region]: contextAmi })
+      : MachineImage.fromSsmParameter(`/${APP_NAME}/_common/${COMPUTE_IMAGE_PARAMETER_NAME}`);
     const { engineName } = contextParameters;
     const { filesystemType } = contextParameters;
     const { fsProvisionedThroughput } = contextParameters;
@@ -132,6 +135,7 @@ export class ContextStack extends Stack {
       jobQueue,
       headQueue,
       computeEnvImage,
+      taskRoles: batchStack.batchRoles,
     }).outputToParent();
   }
 
@@ -57,10 +57,10 @@ export class BatchConstruct extends Construct {
     readBucketArns.push(artifactBucket.bucketArn);
     readWriteBucketArns.push(outputBucket.bucketArn);
 
-    const batchRoles = this.getBatchRoles();
-    for (const role of batchRoles) {
+    for (const role of this.batchRoles) {
       BucketOperations.grantBucketAccess(this, role, readBucketArns, true);
       BucketOperations.grantBucketAccess(this, role, readWriteBucketArns, false);
+      BucketOperations.grantDataAccess(role, contextParameters);
     }
   }
 
@@ -79,6 +79,12 @@ export class BatchConstruct extends Construct {
       computeEnvImage,
       instanceTypes: appParams.instanceTypes,
       maxVCpus: appParams.maxVCpus,
+      minVCpus: appParams.minVCpus,
+      spotBidPercentage: appParams.spotBidPercentage,
+      allocationStrategy: appParams.allocationStrategy,
+      rootVolume: appParams.rootVolume,
+      scratchVolume: appParams.scratchVolume,
+      excludedInstanceFamilies: appParams.excludedInstanceFamilies,
       launchTemplateData: LaunchTemplateData.renderLaunchTemplateData(appParams.engineName),
       awsPolicyNames: ["AmazonSSMManagedInstanceCore", "CloudWatchAgentServerPolicy"],
       resourceTags: Stack.of(this).tags.tagValues(),
@@ -86,7 +92,10 @@ export class BatchConstruct extends Construct {
     });
   }
 
-  private getBatchRoles(): IRole[] {
+  /**
+   * Roles of the instances of the compute environments, which the containers of the jobs run with.
+   */
+  public get batchRoles(): IRole[] {
     const roles: IRole[] = [];
     if (this.batchOnDemand) {
       roles.push(this.batchOnDemand.role);
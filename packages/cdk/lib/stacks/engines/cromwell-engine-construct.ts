@@ -14,6 +14,8 @@ import { CromwellEngineRole } from "../../roles/cromwell-engine-role";
 import { CromwellAdapterRole } from "../../roles/cromwell-adapter-role";
 import { IJobQueue } from "@aws-cdk/aws-batch-alpha";
 import { Construct } from "constructs";
+import { BucketOperations } from "../../common/BucketOperations";
+import { TaskEnvironment } from "../../common/TaskEnvironment";
 
 export interface CromwellEngineConstructProps extends EngineOptions {
   /**
@@ -41,7 +43,8 @@ export class CromwellEngineConstruct extends EngineConstruct {
     super(scope, id);
     const params = props.contextParameters;
     this.engineLogGroup = new LogGroup(this, "EngineLogGroup");
-    const engineContainer = params.getEngineContainer(props.jobQueue.jobQueueArn);
+    const taskEnvironment = new TaskEnvironment(this, params.taskEnvironment, params.taskSecrets);
+    const engineContainer = params.getEngineContainer(props.jobQueue.jobQueueArn, {}, taskEnvironment);
     const artifactBucket = Bucket.fromBucketName(this, "ArtifactBucket", params.artifactBucketName);
     const outputBucket = Bucket.fromBucketName(this, "OutputBucket", params.outputBucketName);
 
@@ -51,6 +54,8 @@ export class CromwellEngineConstruct extends EngineConstruct {
       readWriteBucketArns: (params.readWriteBucketArns ?? []).concat(outputBucket.bucketArn),
       policies: props.policyOptions,
     });
+    BucketOperations.grantDataAccess(this.engineRole, params);
+    taskEnvironment.grantRead(this.engineRole);
     this.adapterRole = new CromwellAdapterRole(this, "CromwellAdapterRole", {
       readOnlyBucketArns: [],
       readWriteBucketArns: [outputBucket.bucketArn],
@@ -115,6 +120,7 @@ export class CromwellEngineConstruct extends EngineConstruct {
       cpu: serviceContainer.cpu,
       memoryLimitMiB: serviceContainer.memoryLimitMiB,
       environment: serviceContainer.environment,
+      secrets: serviceContainer.secrets,
       containerName: serviceContainer.serviceName,
       image: createEcrImage(this, serviceContainer.imageConfig.designation),
       logging: LogDriver.awsLogs({ logGroup, streamPrefix: id, datetimeFormat: this.cromwellLogDateTimeFormat }),
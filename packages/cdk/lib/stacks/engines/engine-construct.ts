@@ -44,7 +44,8 @@ export abstract class EngineConstruct extends Construct {
       code: Code.fromBucket(Bucket.fromBucketName(scope, "WesAdapter", Fn.importValue(WES_BUCKET_NAME)), getCommonParameter(this, WES_KEY_PARAMETER_NAME)),
       handler: "index.handler",
       runtime: Runtime.PYTHON_3_9,
-      environment,
+      // The adapter tags the workflow jobs it submits to AWS Batch with the tags of the context
+      environment: { ...environment, JOB_TAGS: JSON.stringify(Stack.of(scope).tags.tagValues()) },
       role,
       timeout: Duration.seconds(60),
       memorySize: 256,
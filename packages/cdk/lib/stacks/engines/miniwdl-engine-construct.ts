@@ -15,6 +15,7 @@ import { BatchPolicies } from "../../roles/policies/batch-policies";
 import { EngineOptions } from "../../types";
 import { Construct } from "constructs";
 import { LaunchTemplateData } from "../../constructs/launch-template-data";
+import { TaskEnvironment } from "../../common/TaskEnvironment";
 
 export class MiniwdlEngineConstruct extends EngineConstruct {
   public readonly apiProxy: ApiProxy;
@@ -58,6 +59,7 @@ export class MiniwdlEngineConstruct extends EngineConstruct {
       rootDirS3Uri: rootDirS3Uri,
       engineBatch: this.batchHead,
       workerBatch: this.batchWorkers,
+      taskEnvironment: new TaskEnvironment(this, params.taskEnvironment, params.taskSecrets),
     });
 
     const adapterRole = new Role(this, "MiniWdlAdapterRole", {
@@ -126,6 +128,7 @@ export class MiniwdlEngineConstruct extends EngineConstruct {
     for (const role of batchRoles) {
       BucketOperations.grantBucketAccess(this, role, readBucketArns, true);
       BucketOperations.grantBucketAccess(this, role, readWriteBucketArns, false);
+      BucketOperations.grantDataAccess(role, contextParameters);
     }
   }
 
@@ -143,6 +146,11 @@ export class MiniwdlEngineConstruct extends EngineConstruct {
       computeType,
       instanceTypes: appParams.instanceTypes,
       maxVCpus: appParams.maxVCpus,
+      minVCpus: appParams.minVCpus,
+      spotBidPercentage: appParams.spotBidPercentage,
+      allocationStrategy: appParams.allocationStrategy,
+      rootVolume: appParams.rootVolume,
+      excludedInstanceFamilies: appParams.excludedInstanceFamilies,
       launchTemplateData: LaunchTemplateData.renderLaunchTemplateData(ENGINE_MINIWDL),
       awsPolicyNames: ["AmazonSSMManagedInstanceCore", "CloudWatchAgentServerPolicy"],
       resourceTags: Stack.of(this).tags.tagValues(),
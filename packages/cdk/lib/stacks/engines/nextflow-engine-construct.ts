@@ -10,6 +10,10 @@ import { NextflowEngineRole } from "../../roles/nextflow-engine-role";
 import { NextflowAdapterRole } from "../../roles/nextflow-adapter-role";
 import { Construct } from "constructs";
 import { IMachineImage } from "aws-cdk-lib/aws-ec2";
+import { IRole } from "aws-cdk-lib/aws-iam";
+import { batchArn } from "../../util";
+import { BucketOperations } from "../../common/BucketOperations";
+import { TaskEnvironment } from "../../common/TaskEnvironment";
 
 export interface NextflowEngineConstructProps extends EngineOptions {
   /**
@@ -24,6 +28,10 @@ export interface NextflowEngineConstructProps extends EngineOptions {
    * Image used for the Nextflow head node
    */
   readonly computeEnvImage: IMachineImage;
+  /**
+   * Roles of the instances that run the workflow tasks, whose containers read the secrets of the context.
+   */
+  readonly taskRoles: IRole[];
 }
 
 export class NextflowEngineConstruct extends EngineConstruct {
@@ -44,17 +52,22 @@ export class NextflowEngineConstruct extends EngineConstruct {
       readWriteBucketArns: (params.readWriteBucketArns ?? []).concat(outputBucket.bucketArn),
       policies: props.policyOptions,
     });
+    BucketOperations.grantDataAccess(engineRole, params);
 
+    const taskEnvironment = new TaskEnvironment(this, params.taskEnvironment, params.taskSecrets);
     this.nextflowEngine = new NextflowEngine(this, "NextflowEngine", {
       vpc: props.vpc,
       subnets: props.subnets,
       jobQueueArn: props.jobQueue.jobQueueArn,
       rootDirS3Uri: params.getEngineBucketPath(),
       taskRole: engineRole,
+      requesterPays: (params.requesterPaysBuckets ?? []).length > 0,
+      taskEnvironment,
     });
+    taskEnvironment.grantRead(...props.taskRoles);
 
     const adapterRole = new NextflowAdapterRole(this, "NextflowAdapterRole", {
-      batchJobPolicyArns: [this.nextflowEngine.headJobDefinition.jobDefinitionArn, props.headQueue.jobQueueArn],
+      batchJobPolicyArns: [this.nextflowEngine.headJobDefinition.jobDefinitionArn, props.headQueue.jobQueueArn, batchArn(this, "job")],
       readOnlyBucketArns: [],
       readWriteBucketArns: [outputBucket.bucketArn],
     });
@@ -16,6 +16,7 @@ import { Bucket, IBucket } from "aws-cdk-lib/aws-s3";
 import { BucketOperations } from "../../common/BucketOperations";
 import { LaunchTemplateData } from "../../constructs/launch-template-data";
 import { IFunction } from "aws-cdk-lib/aws-lambda";
+import { TaskEnvironment } from "../../common/TaskEnvironment";
 
 export class SnakemakeEngineConstruct extends EngineConstruct {
   public readonly apiProxy: ApiProxy;
@@ -99,6 +100,7 @@ export class SnakemakeEngineConstruct extends EngineConstruct {
       engineBatch: batchHead,
       workerBatch: batchWorkers,
       rootDirS3Uri: props.contextParameters.getEngineBucketPath(),
+      taskEnvironment: new TaskEnvironment(this, props.contextParameters.taskEnvironment, props.contextParameters.taskSecrets),
     });
   }
 
@@ -159,6 +161,7 @@ export class SnakemakeEngineConstruct extends EngineConstruct {
     batchRoles.forEach((role) => {
       BucketOperations.grantBucketAccess(this, role, readBucketArns, true);
       BucketOperations.grantBucketAccess(this, role, readWriteBucketArns, false);
+      BucketOperations.grantDataAccess(role, contextParameters);
     });
   }
 
@@ -176,6 +179,11 @@ export class SnakemakeEngineConstruct extends EngineConstruct {
       computeType,
       instanceTypes: appParams.instanceTypes,
       maxVCpus: appParams.maxVCpus,
+      minVCpus: appParams.minVCpus,
+      spotBidPercentage: appParams.spotBidPercentage,
+      allocationStrategy: appParams.allocationStrategy,
+      rootVolume: appParams.rootVolume,
+      excludedInstanceFamilies: appParams.excludedInstanceFamilies,
       launchTemplateData: LaunchTemplateData.renderLaunchTemplateData(ENGINE_SNAKEMAKE),
       awsPolicyNames: ["AmazonSSMManagedInstanceCore", "CloudWatchAgentServerPolicy"],
       resourceTags: Stack.of(this).tags.tagValues(),
@@ -12,6 +12,8 @@ import { ToilJobRole } from "../../roles/toil-job-role";
 import { ToilEngineRole } from "../../roles/toil-engine-role";
 import { IJobQueue } from "@aws-cdk/aws-batch-alpha";
 import { Construct } from "constructs";
+import { BucketOperations } from "../../common/BucketOperations";
+import { TaskEnvironment } from "../../common/TaskEnvironment";
 
 export interface ToilEngineConstructProps extends EngineOptions {
   /**
@@ -50,10 +52,19 @@ export class ToilEngineConstruct extends EngineConstruct {
       policies: props.policyOptions,
     });
 
+    BucketOperations.grantDataAccess(this.jobRole, params);
+    BucketOperations.grantDataAccess(this.engineRole, params);
+
     // Make the container and pass it the ARN of the role to use for individual jobs.
-    const engineContainer = params.getEngineContainer(props.jobQueue.jobQueueArn, {
-      TOIL_AWS_BATCH_JOB_ROLE_ARN: this.jobRole.roleArn,
-    });
+    const taskEnvironment = new TaskEnvironment(this, params.taskEnvironment, params.taskSecrets);
+    taskEnvironment.grantRead(this.engineRole);
+    const engineContainer = params.getEngineContainer(
+      props.jobQueue.jobQueueArn,
+      {
+        TOIL_AWS_BATCH_JOB_ROLE_ARN: this.jobRole.roleArn,
+      },
+      taskEnvironment
+    );
 
     this.engine = this.getEngineServiceDefinition(props.vpc, props.subnets, engineContainer, this.engineLogGroup);
 
@@ -86,6 +97,7 @@ export class ToilEngineConstruct extends EngineConstruct {
       cpu: serviceContainer.cpu,
       memoryLimitMiB: serviceContainer.memoryLimitMiB,
       environment: serviceContainer.environment,
+      secrets: serviceContainer.secrets,
       containerName: serviceContainer.serviceName,
       image: createEcrImage(this, serviceContainer.imageConfig.designation),
       logging: LogDriver.awsLogs({ logGroup, streamPrefix: id }),
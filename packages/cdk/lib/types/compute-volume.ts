@@ -0,0 +1,10 @@
+export interface ComputeVolume {
+  /**
+   * Size of the EBS volume in GiB.
+   */
+  size?: number;
+  /**
+   * EBS volume type, for example "gp3".
+   */
+  volumeType?: string;
+}
@@ -2,3 +2,5 @@ export type Maybe<T> = T | undefined;
 
 export { EngineOptions } from "./engine-options";
 export { ServiceContainer } from "./service-container";
+export { TaskSecret } from "./task-secret";
+export { ComputeVolume } from "./compute-volume";
@@ -1,3 +1,5 @@
+import { Secret } from "aws-cdk-lib/aws-ecs";
+
 export interface ImageConfiguration {
   /**
    * Parameter designation to look up additional repository information.
@@ -49,4 +51,12 @@ export interface ServiceContainer {
   environment?: {
     [key: string]: string;
   };
+  /**
+   * The secrets to expose to the container as environment variables.
+   *
+   * @default - No secrets.
+   */
+  secrets?: {
+    [key: string]: Secret;
+  };
 }
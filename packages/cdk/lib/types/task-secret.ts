@@ -0,0 +1,14 @@
+export interface TaskSecret {
+  /**
+   * Name of the environment variable that holds the secret.
+   */
+  name: string;
+  /**
+   * Name or ARN of the SSM parameter or Secrets Manager secret.
+   */
+  valueFrom: string;
+  /**
+   * Store the secret is read from, either "parameterStore" or "secretsManager".
+   */
+  source: string;
+}
@@ -174,20 +174,35 @@ const unLaunchedInstanceTypesByRegion: { [key in string]: { [key in string]: boo
   "sa-east-1": { "m5n.large": true, "m5n.xlarge": true, "m5n.2xlarge": true, "m5n.4xlarge": true },
 };
 
-export const getInstanceTypesForBatch = (instanceTypes: InstanceType[] | undefined, computeType: ComputeResourceType, region?: string): InstanceType[] => {
+export const getInstanceTypesForBatch = (
+  instanceTypes: InstanceType[] | undefined,
+  computeType: ComputeResourceType,
+  region?: string,
+  excludedInstanceFamilies: string[] = []
+): InstanceType[] => {
   if (instanceTypes && instanceTypes.length > 0) {
     const armBasedInstances = instanceTypes.filter((instanceType) => instanceType.architecture == InstanceArchitecture.ARM_64);
     if (armBasedInstances.length > 0) {
       throw new Error("ARM based instance type is not supported in Amazon Genomics CLI");
     }
-    return instanceTypes;
+    const allowedInstanceTypes = instanceTypes.filter((instanceType) => !isInstanceFamilyExcluded(instanceType.toString(), excludedInstanceFamilies));
+    if (allowedInstanceTypes.length == 0) {
+      throw new Error("All the instance types of the context belong to excluded instance families");
+    }
+    return allowedInstanceTypes;
   }
 
   return optimalInstanceTypes[computeType]
     .filter((instanceType) => isInstanceTypeSupported(instanceType, region))
+    .filter((instanceType) => !isInstanceFamilyExcluded(instanceType, excludedInstanceFamilies))
     .map((instanceType) => new InstanceType(instanceType.trim()));
 };
 
+const isInstanceFamilyExcluded = (instanceType: string, excludedInstanceFamilies: string[]): boolean => {
+  const family = instanceType.trim().split(".")[0].toLowerCase();
+  return excludedInstanceFamilies.some((excludedFamily) => excludedFamily.trim().toLowerCase() == family);
+};
+
 const isInstanceTypeSupported = (instanceType: string, region?: string): boolean => {
   return !(region !== undefined && unLaunchedInstanceTypesByRegion[region] !== undefined && unLaunchedInstanceTypesByRegion[region][instanceType]);
 };
@@ -0,0 +1,116 @@
+import { App, Stack } from "aws-cdk-lib";
+import { Match, Template } from "aws-cdk-lib/assertions";
+import { AllocationStrategy, ComputeResourceType } from "@aws-cdk/aws-batch-alpha";
+import { InstanceType, MachineImage, SubnetType, Vpc } from "aws-cdk-lib/aws-ec2";
+import { Batch } from "../lib/constructs";
+import { getInstanceTypesForBatch } from "../lib/util/instance-types";
+
+const renderStack = (): Stack => new Stack(new App(), "ContextStack", { env: { account: "123456789012", region: "us-east-1" } });
+
+describe("Batch", () => {
+  test("compute environment and launch template use the compute settings of the context", () => {
+    const stack = renderStack();
+    const vpc = new Vpc(stack, "Vpc");
+    new Batch(stack, "TaskBatchSpot", {
+      vpc,
+      subnets: { subnetType: SubnetType.PRIVATE_WITH_EGRESS },
+      computeType: ComputeResourceType.SPOT,
+      computeEnvImage: MachineImage.genericLinux({ "us-east-1": "ami-0123456789abcdef0" }),
+      launchTemplateData: "#!/bin/bash",
+      minVCpus: 4,
+      maxVCpus: 512,
+      spotBidPercentage: 60,
+      allocationStrategy: AllocationStrategy.SPOT_CAPACITY_OPTIMIZED,
+      rootVolume: { size: 50, volumeType: "gp3" },
+      scratchVolume: { size: 500 },
+      excludedInstanceFamilies: ["m4", "r4"],
+    });
+
+    const template = Template.fromStack(stack);
+    template.hasResourceProperties("AWS::Batch::ComputeEnvironment", {
+      ComputeResources: Match.objectLike({
+        MinvCpus: 4,
+        MaxvCpus: 512,
+        BidPercentage: 60,
+        AllocationStrategy: "SPOT_CAPACITY_OPTIMIZED",
+        ImageId: "ami-0123456789abcdef0",
+        InstanceTypes: Match.not(Match.arrayWith(["m4.large"])),
+      }),
+    });
+    template.hasResourceProperties("AWS::EC2::LaunchTemplate", {
+      LaunchTemplateData: Match.objectLike({
+        BlockDeviceMappings: [
+          { DeviceName: "/dev/xvda", Ebs: { VolumeSize: 50, VolumeType: "gp3", Encrypted: true, DeleteOnTermination: true } },
+          { DeviceName: "/dev/xvdba", Ebs: { VolumeSize: 500, Encrypted: true, DeleteOnTermination: true } },
+        ],
+      }),
+    });
+  });
+
+  test("spot settings are not applied to on-demand compute environments", () => {
+    const stack = renderStack();
+    new Batch(stack, "TaskBatch", {
+      vpc: new Vpc(stack, "Vpc"),
+      subnets: { subnetType: SubnetType.PRIVATE_WITH_EGRESS },
+      computeType: ComputeResourceType.ON_DEMAND,
+      launchTemplateData: "#!/bin/bash",
+      spotBidPercentage: 60,
+      allocationStrategy: AllocationStrategy.SPOT_CAPACITY_OPTIMIZED,
+    });
+
+    const template = Template.fromStack(stack);
+    template.hasResourceProperties("AWS::Batch::ComputeEnvironment", {
+      ComputeResources: Match.objectLike({
+        BidPercentage: Match.absent(),
+        AllocationStrategy: "BEST_FIT_PROGRESSIVE",
+      }),
+    });
+    template.hasResourceProperties("AWS::EC2::LaunchTemplate", {
+      LaunchTemplateData: Match.objectLike({ BlockDeviceMappings: Match.absent() }),
+    });
+  });
+});
+
+describe("Batch tags", () => {
+  test("instances, volumes and network interfaces launched for jobs carry the tags of the context", () => {
+    const stack = renderStack();
+    new Batch(stack, "TaskBatch", {
+      vpc: new Vpc(stack, "Vpc"),
+      subnets: { subnetType: SubnetType.PRIVATE_WITH_EGRESS },
+      computeType: ComputeResourceType.ON_DEMAND,
+      launchTemplateData: "#!/bin/bash",
+      resourceTags: { "cost-center": "5678" },
+    });
+
+    const tags = [{ Key: "cost-center", Value: "5678" }];
+    const template = Template.fromStack(stack);
+    template.hasResourceProperties("AWS::Batch::ComputeEnvironment", {
+      ComputeResources: Match.objectLike({ Tags: { "cost-center": "5678" } }),
+    });
+    template.hasResourceProperties("AWS::EC2::LaunchTemplate", {
+      LaunchTemplateData: Match.objectLike({
+        TagSpecifications: [
+          { ResourceType: "instance", Tags: tags },
+          { ResourceType: "volume", Tags: tags },
+          { ResourceType: "network-interface", Tags: tags },
+        ],
+      }),
+    });
+  });
+});
+
+describe("getInstanceTypesForBatch", () => {
+  test("excluded instance families are removed from the optimal instance types", () => {
+    const instanceTypes = getInstanceTypesForBatch(undefined, ComputeResourceType.ON_DEMAND, "us-east-1", ["m5", "R5"]).map(String);
+    expect(instanceTypes).toContain("c5.large");
+    expect(instanceTypes).toContain("m5a.large");
+    expect(instanceTypes).not.toContain("m5.large");
+    expect(instanceTypes).not.toContain("r5.xlarge");
+  });
+
+  test("excluded instance families are removed from the instance types of the context", () => {
+    const instanceTypes = [new InstanceType("c5"), new InstanceType("p3.2xlarge")];
+    expect(getInstanceTypesForBatch(instanceTypes, ComputeResourceType.ON_DEMAND, "us-east-1", ["p3"]).map(String)).toEqual(["c5"]);
+    expect(() => getInstanceTypesForBatch(instanceTypes, ComputeResourceType.ON_DEMAND, "us-east-1", ["c5", "p3"])).toThrow();
+  });
+});
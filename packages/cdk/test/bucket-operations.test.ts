@@ -0,0 +1,67 @@
+import { App, Stack } from "aws-cdk-lib";
+import { Match, Template } from "aws-cdk-lib/assertions";
+import { Role, ServicePrincipal } from "aws-cdk-lib/aws-iam";
+import { BucketOperations } from "../lib/common/BucketOperations";
+
+const readKeyArn = "arn:aws:kms:us-east-1:210987654321:key/1234abcd-12ab-34cd-56ef-1234567890ab";
+const readWriteKeyArn = "arn:aws:kms:us-east-1:123456789012:key/abcd1234-12ab-34cd-56ef-1234567890ab";
+
+describe("BucketOperations.grantBucketAccess", () => {
+  test("roles may only list and read the objects under the prefix of a prefix location", () => {
+    const stack = new Stack(new App(), "ContextStack", { env: { account: "123456789012", region: "us-east-1" } });
+    const role = new Role(stack, "TaskRole", { assumedBy: new ServicePrincipal("ecs-tasks.amazonaws.com") });
+
+    BucketOperations.grantBucketAccess(stack, role, ["arn:aws:s3:::cohort-bucket/cohortA/*"], true);
+
+    const template = Template.fromStack(stack);
+    const prefixCondition = { StringLike: { "s3:prefix": ["cohortA", "cohortA/*"] } };
+    template.hasResourceProperties("AWS::IAM::Policy", {
+      PolicyDocument: {
+        Statement: Match.arrayWith([
+          Match.objectLike({ Effect: "Allow", Action: "s3:GetObject*" }),
+          Match.objectLike({ Effect: "Allow", Action: "s3:ListBucket", Condition: prefixCondition }),
+        ]),
+      },
+    });
+    const statements = Object.values(template.findResources("AWS::IAM::Policy")).flatMap((policy) => policy.Properties.PolicyDocument.Statement);
+    statements
+      .filter((statement) => [statement.Action].flat().some((action: string) => action.startsWith("s3:List")))
+      .forEach((statement) => expect(statement.Condition).toEqual(prefixCondition));
+  });
+});
+
+describe("BucketOperations.grantDataAccess", () => {
+  test("roles may use the keys of the data and only access buckets of their expected owner", () => {
+    const stack = new Stack(new App(), "ContextStack", { env: { account: "123456789012", region: "us-east-1" } });
+    const role = new Role(stack, "TaskRole", { assumedBy: new ServicePrincipal("ecs-tasks.amazonaws.com") });
+
+    BucketOperations.grantDataAccess(role, {
+      readKmsKeyArns: [readKeyArn],
+      readWriteKmsKeyArns: [readWriteKeyArn],
+      dataBucketOwners: { "partner-bucket": "210987654321" },
+    });
+
+    Template.fromStack(stack).hasResourceProperties("AWS::IAM::Policy", {
+      PolicyDocument: {
+        Statement: Match.arrayWith([
+          Match.objectLike({ Effect: "Allow", Action: "kms:Decrypt", Resource: readKeyArn }),
+          Match.objectLike({ Effect: "Allow", Action: ["kms:Decrypt", "kms:GenerateDataKey"], Resource: readWriteKeyArn }),
+          Match.objectLike({
+            Effect: "Deny",
+            Action: "s3:*",
+            Condition: { StringNotEquals: { "s3:ResourceAccount": "210987654321" } },
+          }),
+        ]),
+      },
+    });
+  });
+
+  test("roles are unchanged without keys or bucket owners", () => {
+    const stack = new Stack(new App(), "ContextStack", { env: { account: "123456789012", region: "us-east-1" } });
+    const role = new Role(stack, "TaskRole", { assumedBy: new ServicePrincipal("ecs-tasks.amazonaws.com") });
+
+    BucketOperations.grantDataAccess(role, {});
+
+    Template.fromStack(stack).resourceCountIs("AWS::IAM::Policy", 0);
+  });
+});
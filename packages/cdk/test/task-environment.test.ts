@@ -0,0 +1,81 @@
+import { App, Stack } from "aws-cdk-lib";
+import { Match, Template } from "aws-cdk-lib/assertions";
+import { ContainerImage } from "aws-cdk-lib/aws-ecs";
+import { Role, ServicePrincipal } from "aws-cdk-lib/aws-iam";
+import { LogGroup } from "aws-cdk-lib/aws-logs";
+import { TaskEnvironment } from "../lib/common/TaskEnvironment";
+import { EngineJobDefinition } from "../lib/constructs/engines/engine-job-definition";
+
+const renderJobDefinition = (taskEnvironment: TaskEnvironment, stack: Stack): void => {
+  new EngineJobDefinition(stack, "HeadJobDef", {
+    logGroup: new LogGroup(stack, "EngineLogGroup"),
+    taskEnvironment,
+    container: {
+      image: ContainerImage.fromRegistry("public.ecr.aws/nextflow/nextflow"),
+      jobRole: new Role(stack, "JobRole", { assumedBy: new ServicePrincipal("ecs-tasks.amazonaws.com") }),
+      environment: { NF_JOB_QUEUE: "queue" },
+    },
+  });
+};
+
+describe("TaskEnvironment", () => {
+  test("engine job definitions carry the environment variables and secrets of the context", () => {
+    const stack = new Stack(new App(), "ContextStack", { env: { account: "123456789012", region: "us-east-1" } });
+    const taskEnvironment = new TaskEnvironment(stack, { REFERENCE_VERSION: "hg38" }, [
+      { name: "LICENSE_SERVER", valueFrom: "/license/server", source: "parameterStore" },
+      { name: "API_TOKEN", valueFrom: "api-token", source: "secretsManager" },
+    ]);
+    renderJobDefinition(taskEnvironment, stack);
+
+    const template = Template.fromStack(stack);
+    template.hasResourceProperties("AWS::Batch::JobDefinition", {
+      ContainerProperties: {
+        Environment: Match.arrayWith([
+          { Name: "REFERENCE_VERSION", Value: "hg38" },
+          { Name: "AGC_TASK_ENVIRONMENT_NAMES", Value: "REFERENCE_VERSION,LICENSE_SERVER,API_TOKEN" },
+          { Name: "AGC_TASK_SECRET_NAMES", Value: "LICENSE_SERVER,API_TOKEN" },
+          { Name: "NF_JOB_QUEUE", Value: "queue" },
+        ]),
+        Secrets: Match.arrayWith([
+          { Name: "LICENSE_SERVER", ValueFrom: Match.stringLikeRegexp("parameter/license/server") },
+          { Name: "API_TOKEN", ValueFrom: Match.anyValue() },
+        ]),
+        ExecutionRoleArn: Match.anyValue(),
+      },
+    });
+    template.hasResourceProperties("AWS::IAM::Policy", {
+      PolicyDocument: {
+        Statement: Match.arrayWith([
+          Match.objectLike({ Action: Match.arrayWith(["ssm:GetParameters"]) }),
+          Match.objectLike({ Action: Match.arrayWith(["secretsmanager:GetSecretValue"]) }),
+        ]),
+      },
+    });
+  });
+
+  test("secret references name the store and the secret that each secret is read from", () => {
+    const stack = new Stack(new App(), "ContextStack", { env: { account: "123456789012", region: "us-east-1" } });
+    const taskEnvironment = new TaskEnvironment(stack, {}, [
+      { name: "LICENSE_SERVER", valueFrom: "/license/server", source: "parameterStore" },
+      { name: "API_TOKEN", valueFrom: "api-token", source: "secretsManager" },
+    ]);
+
+    expect(taskEnvironment.secretReferences).toEqual({
+      LICENSE_SERVER: "parameterStore:/license/server",
+      API_TOKEN: "secretsManager:api-token",
+    });
+  });
+
+  test("engine job definitions are unchanged without environment variables or secrets", () => {
+    const stack = new Stack(new App(), "ContextStack", { env: { account: "123456789012", region: "us-east-1" } });
+    renderJobDefinition(new TaskEnvironment(stack, {}, []), stack);
+
+    const template = Template.fromStack(stack);
+    template.hasResourceProperties("AWS::Batch::JobDefinition", {
+      ContainerProperties: {
+        Environment: Match.not(Match.arrayWith([Match.objectLike({ Name: "AGC_TASK_ENVIRONMENT_NAMES" })])),
+        ExecutionRoleArn: Match.absent(),
+      },
+    });
+  });
+});
@@ -40,6 +40,12 @@ type mainVars struct {
 type formatVars struct {
 	format string
 }
+type credentialVars struct {
+	roleArn         string
+	externalId      string
+	sessionName     string
+	sessionDuration string
+}
 
 var newConfigClient = func() (storage.ConfigClient, error) {
 	return config.NewConfigClient()
@@ -90,6 +96,7 @@ func BuildCommandDocsForHugo(cmd *cobra.Command, dir string) error {
 func buildRootCmd() *cobra.Command {
 	vars := mainVars{}
 	formatVars := formatVars{}
+	credentialVars := credentialVars{}
 	cmd := &cobra.Command{
 		Use:   "agc",
 		Short: shortDescription,
@@ -101,6 +108,13 @@ func buildRootCmd() *cobra.Command {
 				return err
 			}
 			setFormatter(formatVars)
+			setProfileDefaults()
+			if err := setNetwork(); err != nil {
+				return err
+			}
+			if err := setCredentials(credentialVars); err != nil {
+				return err
+			}
 			checkCliVersion()
 			return nil
 		},
@@ -134,6 +148,10 @@ func buildRootCmd() *cobra.Command {
 	cmd.PersistentFlags().BoolVarP(&logging.Verbose, cli.VerboseFlag, cli.VerboseFlagShort, false, cli.VerboseFlagDescription)
 	cmd.PersistentFlags().BoolVar(&logging.Silent, cli.SilentFlag, false, cli.SilentFlagDescription)
 	cmd.PersistentFlags().StringVar(&formatVars.format, cli.FormatFlag, "", cli.FormatFlagDescription)
+	cmd.PersistentFlags().StringVar(&credentialVars.roleArn, cli.RoleArnFlag, "", cli.RoleArnFlagDescription)
+	cmd.PersistentFlags().StringVar(&credentialVars.externalId, cli.ExternalIdFlag, "", cli.ExternalIdFlagDescription)
+	cmd.PersistentFlags().StringVar(&credentialVars.sessionName, cli.SessionNameFlag, "", cli.SessionNameFlagDescription)
+	cmd.PersistentFlags().StringVar(&credentialVars.sessionDuration, cli.SessionDurationFlag, "", cli.SessionDurationFlagDescription)
 	cmd.Flags().StringVar(&vars.docPath, "docs", "", "generate markdown documenting the CLI to the specified path")
 	cmd.Flag("docs").Hidden = true
 
@@ -0,0 +1,77 @@
+package main
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/aws/amazon-genomics-cli/internal/pkg/aws"
+	"github.com/aws/amazon-genomics-cli/internal/pkg/cli/clierror/actionableerror"
+	"github.com/aws/amazon-genomics-cli/internal/pkg/cli/config"
+	"github.com/rs/zerolog/log"
+)
+
+// setCredentials configures the role that AWS clients assume. Values of the command line flags take precedence
+// over the ones stored in the config file.
+func setCredentials(c credentialVars) error {
+	credentials := config.Credentials{}
+	configClient, err := newConfigClient()
+	if err != nil {
+		log.Debug().Err(err).Msg("unable to create config client to read credentials")
+	} else if credentials, err = configClient.GetCredentials(); err != nil {
+		log.Debug().Err(err).Msg("unable to read credentials from config file")
+	}
+
+	options, err := assumeRoleOptions(mergeCredentials(credentials, c))
+	if err != nil {
+		return err
+	}
+	aws.SetAssumeRole(options)
+	return nil
+}
+
+// mergeCredentials overrides stored credentials with the flags that are set. The options stored for a role are
+// dropped when another role is provided.
+func mergeCredentials(credentials config.Credentials, c credentialVars) config.Credentials {
+	if c.roleArn != "" && c.roleArn != credentials.RoleArn {
+		credentials = config.Credentials{RoleArn: c.roleArn}
+	}
+	if c.externalId != "" {
+		credentials.ExternalId = c.externalId
+	}
+	if c.sessionName != "" {
+		credentials.SessionName = c.sessionName
+	}
+	if c.sessionDuration != "" {
+		credentials.SessionDuration = c.sessionDuration
+	}
+	return credentials
+}
+
+func assumeRoleOptions(credentials config.Credentials) (aws.AssumeRoleOptions, error) {
+	if credentials.RoleArn == "" {
+		if credentials.ExternalId != "" || credentials.SessionName != "" || credentials.SessionDuration != "" {
+			return aws.AssumeRoleOptions{}, actionableerror.New(
+				fmt.Errorf("an external id, session name or session duration was provided without a role"),
+				"Please provide the role to assume with '--role-arn' or in the credentials section of ~/.agc/config.yaml",
+			)
+		}
+		return aws.AssumeRoleOptions{}, nil
+	}
+	var duration time.Duration
+	if credentials.SessionDuration != "" {
+		var err error
+		duration, err = time.ParseDuration(credentials.SessionDuration)
+		if err != nil {
+			return aws.AssumeRoleOptions{}, actionableerror.New(
+				fmt.Errorf("invalid session duration '%s': %w", credentials.SessionDuration, err),
+				"Please provide a duration such as '1h' or '90m'",
+			)
+		}
+	}
+	return aws.AssumeRoleOptions{
+		RoleArn:     credentials.RoleArn,
+		ExternalId:  credentials.ExternalId,
+		SessionName: credentials.SessionName,
+		Duration:    duration,
+	}, nil
+}
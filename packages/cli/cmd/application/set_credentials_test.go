@@ -0,0 +1,91 @@
+package main
+
+import (
+	"testing"
+	"time"
+
+	"github.com/aws/amazon-genomics-cli/internal/pkg/aws"
+	"github.com/aws/amazon-genomics-cli/internal/pkg/cli/config"
+	"github.com/aws/amazon-genomics-cli/internal/pkg/storage"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	testRoleArn      = "arn:aws:iam::111122223333:role/AgcProjectRole"
+	testOtherRoleArn = "arn:aws:iam::111122223333:role/OtherRole"
+)
+
+func TestAssumeRoleOptions(t *testing.T) {
+	testCases := map[string]struct {
+		credentials config.Credentials
+		expected    aws.AssumeRoleOptions
+		expectedErr bool
+	}{
+		"no role": {},
+		"role": {
+			credentials: config.Credentials{RoleArn: testRoleArn, ExternalId: "external-id", SessionName: "me", SessionDuration: "90m"},
+			expected:    aws.AssumeRoleOptions{RoleArn: testRoleArn, ExternalId: "external-id", SessionName: "me", Duration: 90 * time.Minute},
+		},
+		"external id without role": {
+			credentials: config.Credentials{ExternalId: "external-id"},
+			expectedErr: true,
+		},
+		"invalid duration": {
+			credentials: config.Credentials{RoleArn: testRoleArn, SessionDuration: "forever"},
+			expectedErr: true,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			options, err := assumeRoleOptions(tc.credentials)
+			if tc.expectedErr {
+				assert.Error(t, err)
+			} else {
+				require.NoError(t, err)
+				assert.Equal(t, tc.expected, options)
+			}
+		})
+	}
+}
+
+func TestMergeCredentials(t *testing.T) {
+	stored := config.Credentials{RoleArn: testRoleArn, ExternalId: "config-id", SessionDuration: "2h"}
+	testCases := map[string]struct {
+		vars     credentialVars
+		expected config.Credentials
+	}{
+		"no flags": {
+			expected: stored,
+		},
+		"same role": {
+			vars:     credentialVars{roleArn: testRoleArn, sessionName: "me"},
+			expected: config.Credentials{RoleArn: testRoleArn, ExternalId: "config-id", SessionName: "me", SessionDuration: "2h"},
+		},
+		"other role": {
+			vars:     credentialVars{roleArn: testOtherRoleArn, sessionDuration: "1h"},
+			expected: config.Credentials{RoleArn: testOtherRoleArn, SessionDuration: "1h"},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			assert.Equal(t, tc.expected, mergeCredentials(stored, tc.vars))
+		})
+	}
+}
+
+func TestSetCredentials_InvalidOptions(t *testing.T) {
+	mocks := createMocks(t)
+	defer mocks.ctrl.Finish()
+	origConfigClient := newConfigClient
+	newConfigClient = func() (storage.ConfigClient, error) {
+		return mocks.configMock, nil
+	}
+	defer func() { newConfigClient = origConfigClient }()
+	mocks.configMock.EXPECT().GetCredentials().Return(config.Credentials{}, nil)
+
+	err := setCredentials(credentialVars{externalId: "external-id"})
+	assert.Error(t, err)
+}
@@ -0,0 +1,30 @@
+package main
+
+import (
+	"github.com/aws/amazon-genomics-cli/internal/pkg/cli/config"
+	"github.com/aws/amazon-genomics-cli/internal/pkg/network"
+	"github.com/rs/zerolog/log"
+)
+
+// setNetwork applies the proxy and CA bundle stored in the config file to every client the CLI creates. A stored
+// setting that is no longer valid, such as a CA bundle that has been deleted, is ignored with a warning rather than
+// failing the command, so that it can still be corrected with 'agc configure network'.
+func setNetwork() error {
+	settings := config.Network{}
+	configClient, err := newConfigClient()
+	if err != nil {
+		log.Debug().Err(err).Msg("unable to create config client to read network settings")
+	} else if settings, err = configClient.GetNetwork(); err != nil {
+		log.Debug().Err(err).Msg("unable to read network settings from config file")
+	}
+
+	if err := network.Validate(network.Settings{Proxy: settings.Proxy}); err != nil {
+		log.Warn().Msgf("Ignoring the proxy of the config file: %v. Please correct it with 'agc configure network'", err)
+		settings.Proxy = ""
+	}
+	if err := network.Validate(network.Settings{CaBundle: settings.CaBundle}); err != nil {
+		log.Warn().Msgf("Ignoring the CA bundle of the config file and trusting the certificate authorities of the system only: %v. Please correct it with 'agc configure network'", err)
+		settings.CaBundle = ""
+	}
+	return network.Set(network.Settings{Proxy: settings.Proxy, CaBundle: settings.CaBundle})
+}
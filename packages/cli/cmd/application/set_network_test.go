@@ -0,0 +1,64 @@
+package main
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/aws/amazon-genomics-cli/internal/pkg/cli"
+	"github.com/aws/amazon-genomics-cli/internal/pkg/cli/config"
+	"github.com/aws/amazon-genomics-cli/internal/pkg/network"
+	"github.com/aws/amazon-genomics-cli/internal/pkg/storage"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSetNetwork(t *testing.T) {
+	testCases := map[string]struct {
+		network config.Network
+	}{
+		"no settings": {},
+		"proxy": {
+			network: config.Network{Proxy: "http://proxy.example.com:3128"},
+		},
+		"invalid proxy is ignored": {
+			network: config.Network{Proxy: "proxy.example.com"},
+		},
+		"missing CA bundle is ignored": {
+			network: config.Network{Proxy: "http://proxy.example.com:3128", CaBundle: filepath.Join(t.TempDir(), "missing.pem")},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			mocks := createMocks(t)
+			defer mocks.ctrl.Finish()
+			origConfigClient := newConfigClient
+			newConfigClient = func() (storage.ConfigClient, error) {
+				return mocks.configMock, nil
+			}
+			defer func() { newConfigClient = origConfigClient }()
+			defer func() { _ = network.Set(network.Settings{}) }()
+			mocks.configMock.EXPECT().GetNetwork().Return(tc.network, nil)
+
+			assert.NoError(t, setNetwork())
+		})
+	}
+}
+
+func TestSetNetwork_DeletedCaBundleCanBeRemoved(t *testing.T) {
+	t.Setenv("HOME", t.TempDir())
+	defer func() { _ = network.Set(network.Settings{}) }()
+	configClient, err := config.NewConfigClient()
+	require.NoError(t, err)
+	bundlePath := filepath.Join(t.TempDir(), "proxy-ca.pem")
+	require.NoError(t, configClient.SetNetwork(config.Network{CaBundle: bundlePath}))
+
+	require.NoError(t, setNetwork())
+	configureNetwork := cli.BuildConfigureNetworkCommand()
+	configureNetwork.SetArgs([]string{})
+	require.NoError(t, configureNetwork.Execute())
+
+	settings, err := configClient.GetNetwork()
+	require.NoError(t, err)
+	assert.Empty(t, settings.CaBundle)
+}
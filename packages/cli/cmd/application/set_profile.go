@@ -0,0 +1,33 @@
+package main
+
+import (
+	"github.com/aws/amazon-genomics-cli/internal/pkg/cli"
+	"github.com/rs/zerolog/log"
+)
+
+// setProfileDefaults applies the AWS profile and the default context of the active configuration profile
+func setProfileDefaults() {
+	configClient, err := newConfigClient()
+	if err != nil {
+		log.Debug().Err(err).Msg("unable to create config client to read the configuration profile")
+		return
+	}
+	configuration, err := configClient.Read()
+	if err != nil {
+		log.Debug().Err(err).Msg("unable to read the configuration profile from config file")
+		return
+	}
+	if profileNames, err := configClient.ListProfiles(); err == nil && !contains(profileNames, configuration.Profile) {
+		log.Warn().Msgf("Configuration profile '%s' has no settings yet, create it with 'agc configure --profile-name %s'", configuration.Profile, configuration.Profile)
+	}
+	cli.SetProfileDefaults(configuration.AwsProfile, configuration.DefaultContext)
+}
+
+func contains(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,24 @@
+package main
+
+import (
+	"testing"
+
+	"github.com/aws/amazon-genomics-cli/internal/pkg/cli"
+	"github.com/aws/amazon-genomics-cli/internal/pkg/cli/config"
+	"github.com/aws/amazon-genomics-cli/internal/pkg/storage"
+)
+
+func TestSetProfileDefaults(t *testing.T) {
+	mocks := createMocks(t)
+	defer mocks.ctrl.Finish()
+	origConfigClient := newConfigClient
+	newConfigClient = func() (storage.ConfigClient, error) {
+		return mocks.configMock, nil
+	}
+	defer func() { newConfigClient = origConfigClient }()
+	defer cli.SetProfileDefaults("", "")
+	mocks.configMock.EXPECT().Read().Return(config.Config{Profile: "prod", AwsProfile: "prod-account", DefaultContext: "ctx1"}, nil)
+	mocks.configMock.EXPECT().ListProfiles().Return([]string{config.DefaultProfileName, "prod"}, nil)
+
+	setProfileDefaults()
+}
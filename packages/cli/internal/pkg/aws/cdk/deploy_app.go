@@ -10,16 +10,19 @@ import (
 var mkDirTemp = os.MkdirTemp
 
 func (client Client) DeployApp(appDir string, context []string, executionName string) (ProgressStream, error) {
+	environment, err := client.environment()
+	if err != nil {
+		return nil, err
+	}
 	tmpDir, _ := mkDirTemp(appDir, "cdk-output")
-	cmdArgs := []string{
-		"deploy",
-		"--all",
-		"--profile", client.profile,
+	cmdArgs := []string{"deploy", "--all"}
+	cmdArgs = append(cmdArgs, client.profileArguments()...)
+	cmdArgs = append(cmdArgs,
 		"--require-approval", "never",
 		"--toolkit-stack-name", awsresources.RenderBootstrapStackName(),
 		"--output", tmpDir,
-	}
+	)
 	cmdArgs = appendContextArguments(cmdArgs, context)
-	progressStream, err := executeCdkCommandAndCleanupDirectory(appDir, cmdArgs, tmpDir, executionName)
+	progressStream, err := executeCdkCommandInEnvironment(appDir, cmdArgs, tmpDir, executionName, environment)
 	return progressStream, actionableerror.FindSuggestionForError(err, actionableerror.AwsErrorMessageToSuggestedActionMap)
 }
@@ -6,16 +6,20 @@ import (
 )
 
 func (client Client) DestroyApp(appDir string, context []string, executionName string) (ProgressStream, error) {
+	environment, err := client.environment()
+	if err != nil {
+		return nil, err
+	}
 	tmpDir, _ := mkDirTemp(appDir, "cdk-output")
 	cmdArgs := []string{
 		"destroy",
 		"--all",
 		"--force",
 		"--toolkit-stack-name", awsresources.RenderBootstrapStackName(),
-		"--profile", client.profile,
-		"--output", tmpDir,
 	}
+	cmdArgs = append(cmdArgs, client.profileArguments()...)
+	cmdArgs = append(cmdArgs, "--output", tmpDir)
 	cmdArgs = appendContextArguments(cmdArgs, context)
-	progressStream, err := executeCdkCommandAndCleanupDirectory(appDir, cmdArgs, tmpDir, executionName)
+	progressStream, err := executeCdkCommandInEnvironment(appDir, cmdArgs, tmpDir, executionName, environment)
 	return progressStream, actionableerror.FindSuggestionForError(err, actionableerror.AwsErrorMessageToSuggestedActionMap)
 }
@@ -12,6 +12,7 @@ import (
 	"sync"
 
 	"github.com/aws/amazon-genomics-cli/internal/pkg/cli/clierror/actionableerror"
+	"github.com/aws/amazon-genomics-cli/internal/pkg/network"
 	"github.com/rs/zerolog/log"
 )
 
@@ -29,10 +30,19 @@ func executeCdkCommand(appDir string, commandArgs []string, executionName string
 }
 
 func executeCdkCommandAndCleanupDirectory(appDir string, commandArgs []string, tmpDir string, executionName string) (ProgressStream, error) {
+	return executeCdkCommandInEnvironment(appDir, commandArgs, tmpDir, executionName, nil)
+}
+
+// executeCdkCommandInEnvironment runs a CDK command with the given variables added to the environment of the process
+func executeCdkCommandInEnvironment(appDir string, commandArgs []string, tmpDir string, executionName string, environment []string) (ProgressStream, error) {
 	log.Debug().Msgf("executeCDKCommand(%s, %v)", appDir, commandArgs)
 	cmdArgs := append([]string{"run", "cdk", "--"}, commandArgs...)
 	cmd := execCommand("npm", cmdArgs...)
 	cmd.Dir = appDir
+	environment = append(environment, network.Environment()...)
+	if len(environment) > 0 {
+		cmd.Env = append(os.Environ(), environment...)
+	}
 
 	// Note that cmd won't have any access to stdin, stdout, or stderr that go
 	// anywhere by default. It does not inherit our streams. This is a problem
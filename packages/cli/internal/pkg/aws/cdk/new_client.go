@@ -1,5 +1,12 @@
 package cdk
 
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+)
+
 type Interface interface {
 	Bootstrap(appDir string, context []string, executionName string) (ProgressStream, error)
 	ClearContext(appDir string) error
@@ -9,7 +16,9 @@ type Interface interface {
 
 type Client struct {
 	Interface
-	profile string
+	profile     string
+	region      string
+	credentials aws.CredentialsProvider
 }
 
 func NewClient(profile string) Interface {
@@ -17,3 +26,40 @@ func NewClient(profile string) Interface {
 		profile: profile,
 	}
 }
+
+// NewTargetClient returns a client whose CDK commands operate in the given region. When credentials are supplied
+// they are handed to the CDK in place of the credentials of the profile.
+func NewTargetClient(profile, region string, credentials aws.CredentialsProvider) Interface {
+	return Client{
+		profile:     profile,
+		region:      region,
+		credentials: credentials,
+	}
+}
+
+func (client Client) profileArguments() []string {
+	if client.credentials != nil {
+		return nil
+	}
+	return []string{"--profile", client.profile}
+}
+
+// environment returns the variables added to the environment of the CDK process to select the region and credentials
+func (client Client) environment() ([]string, error) {
+	var environment []string
+	if client.region != "" {
+		environment = append(environment, "AWS_REGION="+client.region, "AWS_DEFAULT_REGION="+client.region)
+	}
+	if client.credentials != nil {
+		credentials, err := client.credentials.Retrieve(context.Background())
+		if err != nil {
+			return nil, fmt.Errorf("unable to retrieve credentials for the CDK: %w", err)
+		}
+		environment = append(environment,
+			"AWS_ACCESS_KEY_ID="+credentials.AccessKeyID,
+			"AWS_SECRET_ACCESS_KEY="+credentials.SecretAccessKey,
+			"AWS_SESSION_TOKEN="+credentials.SessionToken,
+		)
+	}
+	return environment, nil
+}
@@ -0,0 +1,62 @@
+package cdk
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/credentials"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestClient_Environment(t *testing.T) {
+	testCases := map[string]struct {
+		client           Interface
+		expectedArgs     []string
+		expectedEnv      []string
+		expectedErrorMsg string
+	}{
+		"profile": {
+			client:       NewClient(testDeployProfile),
+			expectedArgs: []string{"--profile", testDeployProfile},
+		},
+		"region": {
+			client:       NewTargetClient(testDeployProfile, "eu-west-1", nil),
+			expectedArgs: []string{"--profile", testDeployProfile},
+			expectedEnv:  []string{"AWS_REGION=eu-west-1", "AWS_DEFAULT_REGION=eu-west-1"},
+		},
+		"assumed role": {
+			client: NewTargetClient(testDeployProfile, "eu-west-1", credentials.NewStaticCredentialsProvider("AKID", "SECRET", "TOKEN")),
+			expectedEnv: []string{
+				"AWS_REGION=eu-west-1",
+				"AWS_DEFAULT_REGION=eu-west-1",
+				"AWS_ACCESS_KEY_ID=AKID",
+				"AWS_SECRET_ACCESS_KEY=SECRET",
+				"AWS_SESSION_TOKEN=TOKEN",
+			},
+		},
+		"credentials error": {
+			client: NewTargetClient(testDeployProfile, "", aws.CredentialsProviderFunc(func(context.Context) (aws.Credentials, error) {
+				return aws.Credentials{}, fmt.Errorf("some credentials error")
+			})),
+			expectedErrorMsg: "unable to retrieve credentials for the CDK: some credentials error",
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			client := tc.client.(Client)
+
+			environment, err := client.environment()
+
+			if tc.expectedErrorMsg != "" {
+				assert.EqualError(t, err, tc.expectedErrorMsg)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tc.expectedEnv, environment)
+			assert.Equal(t, tc.expectedArgs, client.profileArguments())
+		})
+	}
+}
@@ -0,0 +1,16 @@
+package costexplorer
+
+import (
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/costexplorer"
+)
+
+type Client struct {
+	costExplorer costExplorerInterface
+}
+
+func New(cfg aws.Config) *Client {
+	return &Client{
+		costExplorer: costexplorer.NewFromConfig(cfg),
+	}
+}
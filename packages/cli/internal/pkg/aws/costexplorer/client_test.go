@@ -0,0 +1,21 @@
+package costexplorer
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/service/costexplorer"
+	"github.com/stretchr/testify/mock"
+)
+
+type costExplorerMockClient struct {
+	mock.Mock
+}
+
+func (m *costExplorerMockClient) GetCostAndUsage(ctx context.Context, input *costexplorer.GetCostAndUsageInput, _ ...func(*costexplorer.Options)) (*costexplorer.GetCostAndUsageOutput, error) {
+	args := m.Called(ctx, input)
+	output := args.Get(0)
+	if output != nil {
+		return output.(*costexplorer.GetCostAndUsageOutput), args.Error(1)
+	}
+	return nil, args.Error(1)
+}
@@ -0,0 +1,95 @@
+package costexplorer
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strconv"
+	"time"
+
+	"github.com/aws/amazon-genomics-cli/internal/pkg/cli/clierror/actionableerror"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/costexplorer"
+	"github.com/aws/aws-sdk-go-v2/service/costexplorer/types"
+)
+
+const (
+	costMetric = "UnblendedCost"
+	dateLayout = "2006-01-02"
+)
+
+var now = time.Now
+
+// GetMonthToDateCost returns the unblended cost, in USD, of the resources that carry all of the given tags since the
+// start of the current month. Cost Explorer only reports the cost of tags that are activated as cost allocation tags
+// and its data may lag actual usage by up to a day.
+func (c *Client) GetMonthToDateCost(tags map[string]string) (float64, error) {
+	today := now().UTC()
+	start := time.Date(today.Year(), today.Month(), 1, 0, 0, 0, 0, time.UTC)
+	input := &costexplorer.GetCostAndUsageInput{
+		Granularity: types.GranularityMonthly,
+		Metrics:     []string{costMetric},
+		TimePeriod: &types.DateInterval{
+			Start: aws.String(start.Format(dateLayout)),
+			End:   aws.String(today.AddDate(0, 0, 1).Format(dateLayout)),
+		},
+		Filter: tagFilter(tags),
+	}
+
+	var cost float64
+	for {
+		output, err := c.costExplorer.GetCostAndUsage(context.Background(), input)
+		if err != nil {
+			return 0, actionableerror.FindSuggestionForError(err, actionableerror.AwsErrorMessageToSuggestedActionMap)
+		}
+		for _, result := range output.ResultsByTime {
+			amount, err := resultAmount(result)
+			if err != nil {
+				return 0, err
+			}
+			cost += amount
+		}
+		if output.NextPageToken == nil {
+			return cost, nil
+		}
+		input.NextPageToken = output.NextPageToken
+	}
+}
+
+func tagFilter(tags map[string]string) *types.Expression {
+	keys := make([]string, 0, len(tags))
+	for key := range tags {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	expressions := make([]types.Expression, len(keys))
+	for i, key := range keys {
+		expressions[i] = types.Expression{
+			Tags: &types.TagValues{
+				Key:          aws.String(key),
+				Values:       []string{tags[key]},
+				MatchOptions: []types.MatchOption{types.MatchOptionEquals},
+			},
+		}
+	}
+	switch len(expressions) {
+	case 0:
+		return nil
+	case 1:
+		return &expressions[0]
+	default:
+		return &types.Expression{And: expressions}
+	}
+}
+
+func resultAmount(result types.ResultByTime) (float64, error) {
+	metric, ok := result.Total[costMetric]
+	if !ok || metric.Amount == nil {
+		return 0, nil
+	}
+	if metric.Unit != nil && *metric.Unit != "USD" {
+		return 0, fmt.Errorf("cost is reported in '%s' rather than USD", *metric.Unit)
+	}
+	return strconv.ParseFloat(*metric.Amount, 64)
+}
@@ -0,0 +1,97 @@
+package costexplorer
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/costexplorer"
+	"github.com/aws/aws-sdk-go-v2/service/costexplorer/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+var testTags = map[string]string{"agc-project": "demo", "agc-context": "ctx"}
+
+func mockNow(t *testing.T) {
+	origNow := now
+	now = func() time.Time { return time.Date(2021, time.September, 17, 13, 0, 0, 0, time.UTC) }
+	t.Cleanup(func() { now = origNow })
+}
+
+func costOutput(amount, unit string, nextPageToken *string) *costexplorer.GetCostAndUsageOutput {
+	return &costexplorer.GetCostAndUsageOutput{
+		ResultsByTime: []types.ResultByTime{{
+			Total: map[string]types.MetricValue{costMetric: {Amount: aws.String(amount), Unit: aws.String(unit)}},
+		}},
+		NextPageToken: nextPageToken,
+	}
+}
+
+func TestClient_GetMonthToDateCost(t *testing.T) {
+	mockNow(t)
+	mockClient := new(costExplorerMockClient)
+	client := &Client{mockClient}
+	expectedInput := &costexplorer.GetCostAndUsageInput{
+		Granularity: types.GranularityMonthly,
+		Metrics:     []string{costMetric},
+		TimePeriod:  &types.DateInterval{Start: aws.String("2021-09-01"), End: aws.String("2021-09-18")},
+		Filter: &types.Expression{And: []types.Expression{
+			{Tags: &types.TagValues{Key: aws.String("agc-context"), Values: []string{"ctx"}, MatchOptions: []types.MatchOption{types.MatchOptionEquals}}},
+			{Tags: &types.TagValues{Key: aws.String("agc-project"), Values: []string{"demo"}, MatchOptions: []types.MatchOption{types.MatchOptionEquals}}},
+		}},
+	}
+	mockClient.On("GetCostAndUsage", context.Background(), expectedInput).Return(costOutput("12.5", "USD", nil), nil)
+
+	cost, err := client.GetMonthToDateCost(testTags)
+	require.NoError(t, err)
+	assert.Equal(t, 12.5, cost)
+	mockClient.AssertExpectations(t)
+}
+
+func TestClient_GetMonthToDateCost_Paginated(t *testing.T) {
+	mockNow(t)
+	mockClient := new(costExplorerMockClient)
+	client := &Client{mockClient}
+	mockClient.On("GetCostAndUsage", context.Background(), mock.MatchedBy(func(input *costexplorer.GetCostAndUsageInput) bool {
+		return input.NextPageToken == nil
+	})).Return(costOutput("10", "USD", aws.String("page2")), nil).Once()
+	mockClient.On("GetCostAndUsage", context.Background(), mock.MatchedBy(func(input *costexplorer.GetCostAndUsageInput) bool {
+		return input.NextPageToken != nil && *input.NextPageToken == "page2"
+	})).Return(costOutput("2.25", "USD", nil), nil).Once()
+
+	cost, err := client.GetMonthToDateCost(testTags)
+	require.NoError(t, err)
+	assert.Equal(t, 12.25, cost)
+	mockClient.AssertExpectations(t)
+}
+
+func TestClient_GetMonthToDateCost_SingleTag(t *testing.T) {
+	assert.Equal(t, &types.Expression{
+		Tags: &types.TagValues{Key: aws.String("agc-project"), Values: []string{"demo"}, MatchOptions: []types.MatchOption{types.MatchOptionEquals}},
+	}, tagFilter(map[string]string{"agc-project": "demo"}))
+	assert.Nil(t, tagFilter(nil))
+}
+
+func TestClient_GetMonthToDateCost_NotUsd(t *testing.T) {
+	mockNow(t)
+	mockClient := new(costExplorerMockClient)
+	client := &Client{mockClient}
+	mockClient.On("GetCostAndUsage", context.Background(), mock.Anything).Return(costOutput("12.5", "EUR", nil), nil)
+
+	_, err := client.GetMonthToDateCost(testTags)
+	assert.EqualError(t, err, "cost is reported in 'EUR' rather than USD")
+}
+
+func TestClient_GetMonthToDateCost_Error(t *testing.T) {
+	mockNow(t)
+	mockClient := new(costExplorerMockClient)
+	client := &Client{mockClient}
+	mockClient.On("GetCostAndUsage", context.Background(), mock.Anything).Return(nil, errors.New("some cost error"))
+
+	_, err := client.GetMonthToDateCost(testTags)
+	assert.Error(t, err)
+}
@@ -0,0 +1,15 @@
+package costexplorer
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/service/costexplorer"
+)
+
+type Interface interface {
+	GetMonthToDateCost(tags map[string]string) (float64, error)
+}
+
+type costExplorerInterface interface {
+	GetCostAndUsage(context.Context, *costexplorer.GetCostAndUsageInput, ...func(*costexplorer.Options)) (*costexplorer.GetCostAndUsageOutput, error)
+}
@@ -14,8 +14,12 @@ type LogPaginator interface {
 type Interface interface {
 	GetLogsPaginated(input GetLogsInput) LogPaginator
 	StreamLogs(ctx context.Context, logGroupName string, streams ...string) <-chan StreamEvent
+	LogGroupExists(logGroupName string) (bool, error)
+	DeleteLogGroup(logGroupName string) error
 }
 
 type cwlInterface interface {
 	cloudwatchlogs.FilterLogEventsAPIClient
+	cloudwatchlogs.DescribeLogGroupsAPIClient
+	DeleteLogGroup(context.Context, *cloudwatchlogs.DeleteLogGroupInput, ...func(*cloudwatchlogs.Options)) (*cloudwatchlogs.DeleteLogGroupOutput, error)
 }
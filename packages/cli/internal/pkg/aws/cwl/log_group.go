@@ -0,0 +1,44 @@
+package cwl
+
+import (
+	"context"
+	"errors"
+
+	"github.com/aws/amazon-genomics-cli/internal/pkg/cli/clierror/actionableerror"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/cloudwatchlogs"
+	"github.com/aws/aws-sdk-go-v2/service/cloudwatchlogs/types"
+)
+
+func (c Client) LogGroupExists(logGroupName string) (bool, error) {
+	paginator := cloudwatchlogs.NewDescribeLogGroupsPaginator(c.cwl, &cloudwatchlogs.DescribeLogGroupsInput{
+		LogGroupNamePrefix: aws.String(logGroupName),
+	})
+	for paginator.HasMorePages() {
+		output, err := paginator.NextPage(context.Background())
+		if err != nil {
+			return false, actionableerror.FindSuggestionForError(err, actionableerror.AwsErrorMessageToSuggestedActionMap)
+		}
+		for _, logGroup := range output.LogGroups {
+			if aws.ToString(logGroup.LogGroupName) == logGroupName {
+				return true, nil
+			}
+		}
+	}
+	return false, nil
+}
+
+// DeleteLogGroup deletes a log group and all of its streams. Deleting a log group that no longer exists is not an error
+func (c Client) DeleteLogGroup(logGroupName string) error {
+	_, err := c.cwl.DeleteLogGroup(context.Background(), &cloudwatchlogs.DeleteLogGroupInput{
+		LogGroupName: aws.String(logGroupName),
+	})
+	if err != nil {
+		var notFound *types.ResourceNotFoundException
+		if errors.As(err, &notFound) {
+			return nil
+		}
+		return actionableerror.FindSuggestionForError(err, actionableerror.AwsErrorMessageToSuggestedActionMap)
+	}
+	return nil
+}
@@ -0,0 +1,112 @@
+package cwl
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/cloudwatchlogs"
+	"github.com/aws/aws-sdk-go-v2/service/cloudwatchlogs/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func (m *CwlMock) DescribeLogGroups(ctx context.Context, input *cloudwatchlogs.DescribeLogGroupsInput, _ ...func(options *cloudwatchlogs.Options)) (*cloudwatchlogs.DescribeLogGroupsOutput, error) {
+	args := m.Called(ctx, input)
+	output := args.Get(0)
+	err := args.Error(1)
+
+	if output != nil {
+		return output.(*cloudwatchlogs.DescribeLogGroupsOutput), err
+	}
+	return nil, err
+}
+
+func (m *CwlMock) DeleteLogGroup(ctx context.Context, input *cloudwatchlogs.DeleteLogGroupInput, _ ...func(options *cloudwatchlogs.Options)) (*cloudwatchlogs.DeleteLogGroupOutput, error) {
+	args := m.Called(ctx, input)
+	output := args.Get(0)
+	err := args.Error(1)
+
+	if output != nil {
+		return output.(*cloudwatchlogs.DeleteLogGroupOutput), err
+	}
+	return nil, err
+}
+
+func TestClient_LogGroupExists(t *testing.T) {
+	testCases := map[string]struct {
+		logGroups []types.LogGroup
+		err       error
+		expected  bool
+	}{
+		"exact match": {
+			logGroups: []types.LogGroup{{LogGroupName: aws.String(testLogGroupName + "-other")}, {LogGroupName: aws.String(testLogGroupName)}},
+			expected:  true,
+		},
+		"prefix only": {
+			logGroups: []types.LogGroup{{LogGroupName: aws.String(testLogGroupName + "-other")}},
+		},
+		"describe error": {
+			err: fmt.Errorf("some describe error"),
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			client := NewMockClient()
+			var output *cloudwatchlogs.DescribeLogGroupsOutput
+			if tc.err == nil {
+				output = &cloudwatchlogs.DescribeLogGroupsOutput{LogGroups: tc.logGroups}
+			}
+			client.cwl.(*CwlMock).On("DescribeLogGroups", context.Background(), &cloudwatchlogs.DescribeLogGroupsInput{
+				LogGroupNamePrefix: aws.String(testLogGroupName),
+			}).Return(output, tc.err)
+
+			exists, err := client.LogGroupExists(testLogGroupName)
+
+			if tc.err != nil {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+			assert.Equal(t, tc.expected, exists)
+		})
+	}
+}
+
+func TestClient_DeleteLogGroup(t *testing.T) {
+	testCases := map[string]struct {
+		err         error
+		expectedErr bool
+	}{
+		"deleted": {},
+		"already gone": {
+			err: &types.ResourceNotFoundException{},
+		},
+		"delete error": {
+			err:         fmt.Errorf("some delete error"),
+			expectedErr: true,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			client := NewMockClient()
+			var output *cloudwatchlogs.DeleteLogGroupOutput
+			if tc.err == nil {
+				output = &cloudwatchlogs.DeleteLogGroupOutput{}
+			}
+			client.cwl.(*CwlMock).On("DeleteLogGroup", context.Background(), &cloudwatchlogs.DeleteLogGroupInput{
+				LogGroupName: aws.String(testLogGroupName),
+			}).Return(output, tc.err)
+
+			err := client.DeleteLogGroup(testLogGroupName)
+
+			if tc.expectedErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,144 @@
+package ddb
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/aws/amazon-genomics-cli/internal/pkg/aws/ecr"
+	"github.com/aws/amazon-genomics-cli/internal/pkg/cli/clierror/actionableerror"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/feature/dynamodb/attributevalue"
+	exp "github.com/aws/aws-sdk-go-v2/feature/dynamodb/expression"
+	"github.com/aws/aws-sdk-go-v2/service/dynamodb"
+	"github.com/aws/aws-sdk-go-v2/service/dynamodb/types"
+)
+
+// deploymentIdLayout identifies deployments by the millisecond they were recorded at. The ids sort in the order the
+// deployments were recorded, including the ids of second precision recorded by earlier versions.
+const deploymentIdLayout = "20060102T150405.000Z"
+
+// maxDeploymentWriteAttempts bounds the attempts to record a deployment under an id that is not yet taken
+const maxDeploymentWriteAttempts = 5
+
+// ContextDeployment records the configuration a context was deployed with. OwnerId is the id the context's
+// resources are deployed under, while UserId identifies the user that ran the deployment.
+type ContextDeployment struct {
+	DeploymentId string
+	ProjectName  string
+	ContextName  string
+	OwnerId      string
+	UserId       string
+	UserEmail    string
+	AgcVersion   string
+	CreatedTime  string
+	RollbackOf   string
+	Environment  map[string]string
+	ImageRefs    map[string]ecr.ImageReference
+}
+
+// WriteContextDeployment records a deployment of a context. The record is only written if no deployment of the context
+// has the same id, so that deployments recorded at the same time, for example by users sharing a context, are all kept.
+// A taken id is retried with the next millisecond.
+func (c *Client) WriteContextDeployment(ctx context.Context, deployment ContextDeployment) error {
+	createdTime := now().UTC()
+	for attempt := 1; ; attempt++ {
+		err := c.putContextDeployment(ctx, deployment, createdTime)
+		var conditionErr *types.ConditionalCheckFailedException
+		if !errors.As(err, &conditionErr) {
+			return actionableerror.FindSuggestionForError(err, actionableerror.AwsErrorMessageToSuggestedActionMap)
+		}
+		if attempt == maxDeploymentWriteAttempts {
+			return fmt.Errorf("unable to record the deployment of context '%s': %w", deployment.ContextName, err)
+		}
+		createdTime = createdTime.Add(time.Millisecond)
+	}
+}
+
+func (c *Client) putContextDeployment(ctx context.Context, deployment ContextDeployment, createdTime time.Time) error {
+	deployment.CreatedTime = createdTime.Format(time.RFC3339)
+	deployment.DeploymentId = createdTime.Format(deploymentIdLayout)
+	record, err := attributevalue.MarshalMap(deployment)
+	if err != nil {
+		return err
+	}
+	record[pkAttrName] = &types.AttributeValueMemberS{Value: renderPartitionKey(deployment.ProjectName, deployment.OwnerId)}
+	record[skAttrName] = &types.AttributeValueMemberS{Value: renderDeploymentSortKey(deployment.ContextName, deployment.DeploymentId)}
+	condition, err := exp.NewBuilder().WithCondition(exp.AttributeNotExists(exp.Name(skAttrName))).Build()
+	if err != nil {
+		return err
+	}
+	input := &dynamodb.PutItemInput{
+		Item:                      record,
+		TableName:                 aws.String(TableName),
+		ConditionExpression:       condition.Condition(),
+		ExpressionAttributeNames:  condition.Names(),
+		ExpressionAttributeValues: condition.Values(),
+	}
+	_, err = c.svc.PutItem(ctx, input)
+	return err
+}
+
+// ListContextDeployments returns the recorded deployments of a context, most recent first
+func (c *Client) ListContextDeployments(ctx context.Context, project, owner, contextName string) ([]ContextDeployment, error) {
+	pk := exp.Value(renderPartitionKey(project, owner))
+	skPref := renderDeploymentPrefix(contextName)
+	keyCondition := exp.Key(pkAttrName).Equal(pk).And(exp.Key(skAttrName).BeginsWith(skPref))
+	expression, err := exp.NewBuilder().WithKeyCondition(keyCondition).Build()
+	if err != nil {
+		return nil, err
+	}
+
+	input := &dynamodb.QueryInput{
+		TableName:                 aws.String(TableName),
+		KeyConditionExpression:    expression.KeyCondition(),
+		ExpressionAttributeNames:  expression.Names(),
+		ExpressionAttributeValues: expression.Values(),
+		ScanIndexForward:          aws.Bool(false),
+	}
+	p := dynamodb.NewQueryPaginator(c.svc, input)
+	var deployments []ContextDeployment
+	for p.HasMorePages() {
+		output, err := p.NextPage(ctx)
+		if err != nil {
+			return nil, actionableerror.FindSuggestionForError(err, actionableerror.AwsErrorMessageToSuggestedActionMap)
+		}
+		var records []ContextDeployment
+		if err := attributevalue.UnmarshalListOfMaps(output.Items, &records); err != nil {
+			return nil, err
+		}
+		deployments = append(deployments, records...)
+	}
+	return deployments, nil
+}
+
+func (c *Client) GetContextDeployment(ctx context.Context, project, owner, contextName, deploymentId string) (ContextDeployment, error) {
+	input := &dynamodb.GetItemInput{
+		Key: map[string]types.AttributeValue{
+			pkAttrName: &types.AttributeValueMemberS{Value: renderPartitionKey(project, owner)},
+			skAttrName: &types.AttributeValueMemberS{Value: renderDeploymentSortKey(contextName, deploymentId)},
+		},
+		TableName: aws.String(TableName),
+	}
+	output, err := c.svc.GetItem(ctx, input)
+	if err != nil {
+		return ContextDeployment{}, actionableerror.FindSuggestionForError(err, actionableerror.AwsErrorMessageToSuggestedActionMap)
+	}
+	if output.Item == nil {
+		return ContextDeployment{}, fmt.Errorf("deployment '%s' of context '%s' does not exist", deploymentId, contextName)
+	}
+	var deployment ContextDeployment
+	if err := attributevalue.UnmarshalMap(output.Item, &deployment); err != nil {
+		return ContextDeployment{}, err
+	}
+	return deployment, nil
+}
+
+func renderDeploymentPrefix(contextName string) string {
+	return fmt.Sprintf("DEPLOYMENT#CONTEXT#%s#", contextName)
+}
+
+func renderDeploymentSortKey(contextName, deploymentId string) string {
+	return renderDeploymentPrefix(contextName) + deploymentId
+}
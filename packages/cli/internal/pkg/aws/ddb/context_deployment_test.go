@@ -0,0 +1,61 @@
+package ddb
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/service/dynamodb"
+	"github.com/aws/aws-sdk-go-v2/service/dynamodb/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// fakeTable stores the items put into it and rejects conditional puts of sort keys it already holds
+type fakeTable struct {
+	ApiInterface
+	items map[string]map[string]types.AttributeValue
+}
+
+func (f *fakeTable) PutItem(_ context.Context, params *dynamodb.PutItemInput, _ ...func(*dynamodb.Options)) (*dynamodb.PutItemOutput, error) {
+	sk := params.Item[skAttrName].(*types.AttributeValueMemberS).Value
+	if _, exists := f.items[sk]; exists && params.ConditionExpression != nil {
+		return nil, &types.ConditionalCheckFailedException{Message: &sk}
+	}
+	f.items[sk] = params.Item
+	return &dynamodb.PutItemOutput{}, nil
+}
+
+func TestClient_WriteContextDeployment_SameMillisecond(t *testing.T) {
+	origNow := now
+	now = func() time.Time { return time.Date(2022, 10, 19, 11, 39, 48, 123456789, time.UTC) }
+	defer func() { now = origNow }()
+	table := &fakeTable{items: make(map[string]map[string]types.AttributeValue)}
+	client := &Client{svc: table}
+
+	deployment := ContextDeployment{ProjectName: "demo", ContextName: "ctx1", OwnerId: "owner"}
+	require.NoError(t, client.WriteContextDeployment(context.Background(), deployment))
+	require.NoError(t, client.WriteContextDeployment(context.Background(), deployment))
+
+	assert.Len(t, table.items, 2)
+	assert.Contains(t, table.items, "DEPLOYMENT#CONTEXT#ctx1#20221019T113948.123Z")
+	assert.Contains(t, table.items, "DEPLOYMENT#CONTEXT#ctx1#20221019T113948.124Z")
+}
+
+func TestClient_WriteContextDeployment_IdsTaken(t *testing.T) {
+	origNow := now
+	now = func() time.Time { return time.Date(2022, 10, 19, 11, 39, 48, 0, time.UTC) }
+	defer func() { now = origNow }()
+	table := &fakeTable{items: make(map[string]map[string]types.AttributeValue)}
+	client := &Client{svc: table}
+
+	deployment := ContextDeployment{ProjectName: "demo", ContextName: "ctx1", OwnerId: "owner"}
+	for i := 0; i < maxDeploymentWriteAttempts; i++ {
+		require.NoError(t, client.WriteContextDeployment(context.Background(), deployment))
+	}
+	err := client.WriteContextDeployment(context.Background(), deployment)
+
+	var conditionErr *types.ConditionalCheckFailedException
+	assert.ErrorAs(t, err, &conditionErr)
+	assert.Len(t, table.items, maxDeploymentWriteAttempts)
+}
@@ -13,6 +13,9 @@ type Interface interface {
 	ListWorkflowInstancesByContext(ctx context.Context, project, user, contextName string, limit int) ([]WorkflowInstance, error)
 	ListWorkflowInstances(ctx context.Context, project, user string, limit int) ([]WorkflowInstance, error)
 	GetWorkflowInstanceById(ctx context.Context, project, user, runId string) (WorkflowInstance, error)
+	WriteContextDeployment(ctx context.Context, deployment ContextDeployment) error
+	ListContextDeployments(ctx context.Context, project, owner, contextName string) ([]ContextDeployment, error)
+	GetContextDeployment(ctx context.Context, project, owner, contextName, deploymentId string) (ContextDeployment, error)
 }
 
 type ApiInterface interface {
@@ -0,0 +1,16 @@
+package ec2
+
+import (
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+)
+
+type Client struct {
+	ec2 ec2Interface
+}
+
+func New(cfg aws.Config) *Client {
+	return &Client{
+		ec2: ec2.NewFromConfig(cfg),
+	}
+}
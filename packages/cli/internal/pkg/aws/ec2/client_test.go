@@ -0,0 +1,66 @@
+package ec2
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	"github.com/stretchr/testify/mock"
+)
+
+type ec2MockClient struct {
+	mock.Mock
+}
+
+func (m *ec2MockClient) DescribeVpcs(ctx context.Context, input *ec2.DescribeVpcsInput, _ ...func(*ec2.Options)) (*ec2.DescribeVpcsOutput, error) {
+	args := m.Called(ctx, input)
+	output := args.Get(0)
+	if output != nil {
+		return output.(*ec2.DescribeVpcsOutput), args.Error(1)
+	}
+	return nil, args.Error(1)
+}
+
+func (m *ec2MockClient) DescribeSubnets(ctx context.Context, input *ec2.DescribeSubnetsInput, _ ...func(*ec2.Options)) (*ec2.DescribeSubnetsOutput, error) {
+	args := m.Called(ctx, input)
+	output := args.Get(0)
+	if output != nil {
+		return output.(*ec2.DescribeSubnetsOutput), args.Error(1)
+	}
+	return nil, args.Error(1)
+}
+
+func (m *ec2MockClient) DescribeRouteTables(ctx context.Context, input *ec2.DescribeRouteTablesInput, _ ...func(*ec2.Options)) (*ec2.DescribeRouteTablesOutput, error) {
+	args := m.Called(ctx, input)
+	output := args.Get(0)
+	if output != nil {
+		return output.(*ec2.DescribeRouteTablesOutput), args.Error(1)
+	}
+	return nil, args.Error(1)
+}
+
+func (m *ec2MockClient) DescribeVpcEndpoints(ctx context.Context, input *ec2.DescribeVpcEndpointsInput, _ ...func(*ec2.Options)) (*ec2.DescribeVpcEndpointsOutput, error) {
+	args := m.Called(ctx, input)
+	output := args.Get(0)
+	if output != nil {
+		return output.(*ec2.DescribeVpcEndpointsOutput), args.Error(1)
+	}
+	return nil, args.Error(1)
+}
+
+func (m *ec2MockClient) DescribeAddresses(ctx context.Context, input *ec2.DescribeAddressesInput, _ ...func(*ec2.Options)) (*ec2.DescribeAddressesOutput, error) {
+	args := m.Called(ctx, input)
+	output := args.Get(0)
+	if output != nil {
+		return output.(*ec2.DescribeAddressesOutput), args.Error(1)
+	}
+	return nil, args.Error(1)
+}
+
+func (m *ec2MockClient) DescribeImages(ctx context.Context, input *ec2.DescribeImagesInput, _ ...func(*ec2.Options)) (*ec2.DescribeImagesOutput, error) {
+	args := m.Called(ctx, input)
+	output := args.Get(0)
+	if output != nil {
+		return output.(*ec2.DescribeImagesOutput), args.Error(1)
+	}
+	return nil, args.Error(1)
+}
@@ -0,0 +1,22 @@
+package ec2
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/amazon-genomics-cli/internal/pkg/cli/clierror/actionableerror"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+)
+
+// GetImageOwnerId returns the id of the account that owns an AMI
+func (c *Client) GetImageOwnerId(imageId string) (string, error) {
+	output, err := c.ec2.DescribeImages(context.Background(), &ec2.DescribeImagesInput{ImageIds: []string{imageId}})
+	if err != nil {
+		return "", actionableerror.FindSuggestionForError(err, actionableerror.AwsErrorMessageToSuggestedActionMap)
+	}
+	if len(output.Images) == 0 {
+		return "", fmt.Errorf("image '%s' does not exist", imageId)
+	}
+	return aws.ToString(output.Images[0].OwnerId), nil
+}
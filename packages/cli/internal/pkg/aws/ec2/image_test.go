@@ -0,0 +1,35 @@
+package ec2
+
+import (
+	"context"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	"github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const testImageId = "ami-1234"
+
+func TestClient_GetImageOwnerId(t *testing.T) {
+	mockClient := new(ec2MockClient)
+	client := &Client{mockClient}
+	mockClient.On("DescribeImages", context.Background(), &ec2.DescribeImagesInput{ImageIds: []string{testImageId}}).
+		Return(&ec2.DescribeImagesOutput{Images: []types.Image{{OwnerId: aws.String("123456789012")}}}, nil)
+
+	ownerId, err := client.GetImageOwnerId(testImageId)
+	require.NoError(t, err)
+	assert.Equal(t, "123456789012", ownerId)
+}
+
+func TestClient_GetImageOwnerId_NotFound(t *testing.T) {
+	mockClient := new(ec2MockClient)
+	client := &Client{mockClient}
+	mockClient.On("DescribeImages", context.Background(), &ec2.DescribeImagesInput{ImageIds: []string{testImageId}}).
+		Return(&ec2.DescribeImagesOutput{}, nil)
+
+	_, err := client.GetImageOwnerId(testImageId)
+	assert.EqualError(t, err, "image 'ami-1234' does not exist")
+}
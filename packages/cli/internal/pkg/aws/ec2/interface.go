@@ -0,0 +1,25 @@
+package ec2
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+)
+
+type Interface interface {
+	GetVpcSubnets(vpcId string) ([]Subnet, error)
+	GetVpcEndpointServiceNames(vpcId string) ([]string, error)
+	CountVpcs() (int, error)
+	CountElasticIps() (int, error)
+	GetVpcTags(vpcId string) (map[string]string, error)
+	GetImageOwnerId(imageId string) (string, error)
+}
+
+type ec2Interface interface {
+	ec2.DescribeVpcsAPIClient
+	ec2.DescribeSubnetsAPIClient
+	ec2.DescribeRouteTablesAPIClient
+	ec2.DescribeVpcEndpointsAPIClient
+	ec2.DescribeImagesAPIClient
+	DescribeAddresses(ctx context.Context, params *ec2.DescribeAddressesInput, optFns ...func(*ec2.Options)) (*ec2.DescribeAddressesOutput, error)
+}
@@ -0,0 +1,185 @@
+package ec2
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/aws/amazon-genomics-cli/internal/pkg/cli/clierror/actionableerror"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	"github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	"github.com/aws/smithy-go"
+)
+
+const (
+	vpcNotFoundErrorCode    = "InvalidVpcID.NotFound"
+	internetGatewayIdPrefix = "igw-"
+)
+
+// ErrVpcNotFound is the cause of the actionable error returned when a VPC does not exist
+var ErrVpcNotFound = errors.New("VPC does not exist")
+
+type Subnet struct {
+	Id                      string
+	VpcId                   string
+	AvailabilityZone        string
+	HasInternetGatewayRoute bool
+	HasNatRoute             bool
+}
+
+// IsPrivate returns true when instances in the subnet cannot reach the internet directly through an internet gateway
+func (s Subnet) IsPrivate() bool {
+	return !s.HasInternetGatewayRoute
+}
+
+// GetVpcSubnets returns the subnets of a VPC along with the kind of default routes of their route tables
+func (c *Client) GetVpcSubnets(vpcId string) ([]Subnet, error) {
+	if _, err := c.describeVpc(vpcId); err != nil {
+		return nil, err
+	}
+
+	vpcFilter := []types.Filter{{Name: aws.String("vpc-id"), Values: []string{vpcId}}}
+	var awsSubnets []types.Subnet
+	subnetPaginator := ec2.NewDescribeSubnetsPaginator(c.ec2, &ec2.DescribeSubnetsInput{Filters: vpcFilter})
+	for subnetPaginator.HasMorePages() {
+		output, err := subnetPaginator.NextPage(context.Background())
+		if err != nil {
+			return nil, actionableerror.FindSuggestionForError(err, actionableerror.AwsErrorMessageToSuggestedActionMap)
+		}
+		awsSubnets = append(awsSubnets, output.Subnets...)
+	}
+
+	var routeTables []types.RouteTable
+	routeTablePaginator := ec2.NewDescribeRouteTablesPaginator(c.ec2, &ec2.DescribeRouteTablesInput{Filters: vpcFilter})
+	for routeTablePaginator.HasMorePages() {
+		output, err := routeTablePaginator.NextPage(context.Background())
+		if err != nil {
+			return nil, actionableerror.FindSuggestionForError(err, actionableerror.AwsErrorMessageToSuggestedActionMap)
+		}
+		routeTables = append(routeTables, output.RouteTables...)
+	}
+
+	var mainRouteTable *types.RouteTable
+	subnetRouteTables := make(map[string]types.RouteTable)
+	for i, routeTable := range routeTables {
+		for _, association := range routeTable.Associations {
+			if aws.ToBool(association.Main) {
+				mainRouteTable = &routeTables[i]
+			}
+			if association.SubnetId != nil {
+				subnetRouteTables[*association.SubnetId] = routeTable
+			}
+		}
+	}
+
+	subnets := make([]Subnet, len(awsSubnets))
+	for i, awsSubnet := range awsSubnets {
+		subnet := Subnet{
+			Id:               aws.ToString(awsSubnet.SubnetId),
+			VpcId:            aws.ToString(awsSubnet.VpcId),
+			AvailabilityZone: aws.ToString(awsSubnet.AvailabilityZone),
+		}
+		routeTable, ok := subnetRouteTables[subnet.Id]
+		if !ok && mainRouteTable != nil {
+			routeTable, ok = *mainRouteTable, true
+		}
+		if ok {
+			subnet.HasInternetGatewayRoute, subnet.HasNatRoute = classifyRoutes(routeTable.Routes)
+		}
+		subnets[i] = subnet
+	}
+	return subnets, nil
+}
+
+// GetVpcEndpointServiceNames returns the service names of the VPC endpoints present in a VPC
+func (c *Client) GetVpcEndpointServiceNames(vpcId string) ([]string, error) {
+	var serviceNames []string
+	paginator := ec2.NewDescribeVpcEndpointsPaginator(c.ec2, &ec2.DescribeVpcEndpointsInput{
+		Filters: []types.Filter{{Name: aws.String("vpc-id"), Values: []string{vpcId}}},
+	})
+	for paginator.HasMorePages() {
+		output, err := paginator.NextPage(context.Background())
+		if err != nil {
+			return nil, actionableerror.FindSuggestionForError(err, actionableerror.AwsErrorMessageToSuggestedActionMap)
+		}
+		for _, endpoint := range output.VpcEndpoints {
+			serviceNames = append(serviceNames, aws.ToString(endpoint.ServiceName))
+		}
+	}
+	return serviceNames, nil
+}
+
+// GetVpcTags returns the tags of a VPC
+func (c *Client) GetVpcTags(vpcId string) (map[string]string, error) {
+	vpc, err := c.describeVpc(vpcId)
+	if err != nil {
+		return nil, err
+	}
+	tags := make(map[string]string, len(vpc.Tags))
+	for _, tag := range vpc.Tags {
+		tags[aws.ToString(tag.Key)] = aws.ToString(tag.Value)
+	}
+	return tags, nil
+}
+
+func (c *Client) CountVpcs() (int, error) {
+	count := 0
+	paginator := ec2.NewDescribeVpcsPaginator(c.ec2, &ec2.DescribeVpcsInput{})
+	for paginator.HasMorePages() {
+		output, err := paginator.NextPage(context.Background())
+		if err != nil {
+			return 0, actionableerror.FindSuggestionForError(err, actionableerror.AwsErrorMessageToSuggestedActionMap)
+		}
+		count += len(output.Vpcs)
+	}
+	return count, nil
+}
+
+func (c *Client) CountElasticIps() (int, error) {
+	output, err := c.ec2.DescribeAddresses(context.Background(), &ec2.DescribeAddressesInput{
+		Filters: []types.Filter{{Name: aws.String("domain"), Values: []string{string(types.DomainTypeVpc)}}},
+	})
+	if err != nil {
+		return 0, actionableerror.FindSuggestionForError(err, actionableerror.AwsErrorMessageToSuggestedActionMap)
+	}
+	return len(output.Addresses), nil
+}
+
+func (c *Client) describeVpc(vpcId string) (types.Vpc, error) {
+	output, err := c.ec2.DescribeVpcs(context.Background(), &ec2.DescribeVpcsInput{VpcIds: []string{vpcId}})
+	if err != nil {
+		var apiErr smithy.APIError
+		if errors.As(err, &apiErr) && apiErr.ErrorCode() == vpcNotFoundErrorCode {
+			return types.Vpc{}, vpcNotFoundError(vpcId)
+		}
+		return types.Vpc{}, actionableerror.FindSuggestionForError(err, actionableerror.AwsErrorMessageToSuggestedActionMap)
+	}
+	if len(output.Vpcs) == 0 {
+		return types.Vpc{}, vpcNotFoundError(vpcId)
+	}
+	return output.Vpcs[0], nil
+}
+
+func vpcNotFoundError(vpcId string) error {
+	return actionableerror.New(
+		fmt.Errorf("%w: '%s'", ErrVpcNotFound, vpcId),
+		"Please check the VPC id and make sure your AWS profile targets the region the VPC was created in",
+	)
+}
+
+func classifyRoutes(routes []types.Route) (hasInternetGatewayRoute bool, hasNatRoute bool) {
+	for _, route := range routes {
+		if route.State == types.RouteStateBlackhole {
+			continue
+		}
+		if strings.HasPrefix(aws.ToString(route.GatewayId), internetGatewayIdPrefix) {
+			hasInternetGatewayRoute = true
+		}
+		if route.NatGatewayId != nil || route.TransitGatewayId != nil || route.InstanceId != nil {
+			hasNatRoute = true
+		}
+	}
+	return hasInternetGatewayRoute, hasNatRoute
+}
@@ -0,0 +1,178 @@
+package ec2
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/aws/amazon-genomics-cli/internal/pkg/cli/clierror/actionableerror"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	"github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	"github.com/aws/smithy-go"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	testVpcId           = "vpc-1234"
+	testPublicSubnetId  = "subnet-public"
+	testPrivateSubnetId = "subnet-private"
+	testIsolatedSubnet  = "subnet-isolated"
+)
+
+var testVpcFilter = []types.Filter{{Name: aws.String("vpc-id"), Values: []string{testVpcId}}}
+
+func TestClient_GetVpcSubnets(t *testing.T) {
+	mockClient := new(ec2MockClient)
+	client := &Client{mockClient}
+	mockClient.On("DescribeVpcs", context.Background(), &ec2.DescribeVpcsInput{VpcIds: []string{testVpcId}}).
+		Return(&ec2.DescribeVpcsOutput{Vpcs: []types.Vpc{{VpcId: aws.String(testVpcId)}}}, nil)
+	mockClient.On("DescribeSubnets", context.Background(), &ec2.DescribeSubnetsInput{Filters: testVpcFilter}).
+		Return(&ec2.DescribeSubnetsOutput{Subnets: []types.Subnet{
+			{SubnetId: aws.String(testPublicSubnetId), VpcId: aws.String(testVpcId), AvailabilityZone: aws.String("us-east-1a")},
+			{SubnetId: aws.String(testPrivateSubnetId), VpcId: aws.String(testVpcId), AvailabilityZone: aws.String("us-east-1b")},
+			{SubnetId: aws.String(testIsolatedSubnet), VpcId: aws.String(testVpcId), AvailabilityZone: aws.String("us-east-1c")},
+		}}, nil)
+	mockClient.On("DescribeRouteTables", context.Background(), &ec2.DescribeRouteTablesInput{Filters: testVpcFilter}).
+		Return(&ec2.DescribeRouteTablesOutput{RouteTables: []types.RouteTable{
+			{
+				Associations: []types.RouteTableAssociation{{Main: aws.Bool(true)}},
+				Routes:       []types.Route{{GatewayId: aws.String("local")}},
+			},
+			{
+				Associations: []types.RouteTableAssociation{{SubnetId: aws.String(testPublicSubnetId)}},
+				Routes:       []types.Route{{GatewayId: aws.String("local")}, {GatewayId: aws.String("igw-1234")}},
+			},
+			{
+				Associations: []types.RouteTableAssociation{{SubnetId: aws.String(testPrivateSubnetId)}},
+				Routes:       []types.Route{{GatewayId: aws.String("local")}, {NatGatewayId: aws.String("nat-1234")}},
+			},
+		}}, nil)
+
+	subnets, err := client.GetVpcSubnets(testVpcId)
+	require.NoError(t, err)
+	assert.Equal(t, []Subnet{
+		{Id: testPublicSubnetId, VpcId: testVpcId, AvailabilityZone: "us-east-1a", HasInternetGatewayRoute: true},
+		{Id: testPrivateSubnetId, VpcId: testVpcId, AvailabilityZone: "us-east-1b", HasNatRoute: true},
+		{Id: testIsolatedSubnet, VpcId: testVpcId, AvailabilityZone: "us-east-1c"},
+	}, subnets)
+	assert.False(t, subnets[0].IsPrivate())
+	assert.True(t, subnets[1].IsPrivate())
+	mockClient.AssertExpectations(t)
+}
+
+func TestClient_GetVpcSubnets_IgnoresBlackholeRoutes(t *testing.T) {
+	mockClient := new(ec2MockClient)
+	client := &Client{mockClient}
+	mockClient.On("DescribeVpcs", context.Background(), &ec2.DescribeVpcsInput{VpcIds: []string{testVpcId}}).
+		Return(&ec2.DescribeVpcsOutput{Vpcs: []types.Vpc{{VpcId: aws.String(testVpcId)}}}, nil)
+	mockClient.On("DescribeSubnets", context.Background(), &ec2.DescribeSubnetsInput{Filters: testVpcFilter}).
+		Return(&ec2.DescribeSubnetsOutput{Subnets: []types.Subnet{{SubnetId: aws.String(testPrivateSubnetId), VpcId: aws.String(testVpcId)}}}, nil)
+	mockClient.On("DescribeRouteTables", context.Background(), &ec2.DescribeRouteTablesInput{Filters: testVpcFilter}).
+		Return(&ec2.DescribeRouteTablesOutput{RouteTables: []types.RouteTable{{
+			Associations: []types.RouteTableAssociation{{SubnetId: aws.String(testPrivateSubnetId)}},
+			Routes:       []types.Route{{NatGatewayId: aws.String("nat-1234"), State: types.RouteStateBlackhole}},
+		}}}, nil)
+
+	subnets, err := client.GetVpcSubnets(testVpcId)
+	require.NoError(t, err)
+	require.Len(t, subnets, 1)
+	assert.False(t, subnets[0].HasNatRoute)
+}
+
+func TestClient_GetVpcSubnets_VpcNotFound(t *testing.T) {
+	mockClient := new(ec2MockClient)
+	client := &Client{mockClient}
+	mockClient.On("DescribeVpcs", context.Background(), &ec2.DescribeVpcsInput{VpcIds: []string{testVpcId}}).
+		Return(nil, &smithy.GenericAPIError{Code: vpcNotFoundErrorCode})
+
+	_, err := client.GetVpcSubnets(testVpcId)
+	var actionableErr *actionableerror.Error
+	require.ErrorAs(t, err, &actionableErr)
+	assert.ErrorIs(t, actionableErr.Cause, ErrVpcNotFound)
+}
+
+func TestClient_GetVpcSubnets_Error(t *testing.T) {
+	mockClient := new(ec2MockClient)
+	client := &Client{mockClient}
+	mockClient.On("DescribeVpcs", context.Background(), &ec2.DescribeVpcsInput{VpcIds: []string{testVpcId}}).
+		Return(nil, errors.New("some error"))
+
+	_, err := client.GetVpcSubnets(testVpcId)
+	assert.Error(t, err)
+}
+
+func TestClient_GetVpcEndpointServiceNames(t *testing.T) {
+	mockClient := new(ec2MockClient)
+	client := &Client{mockClient}
+	mockClient.On("DescribeVpcEndpoints", context.Background(), &ec2.DescribeVpcEndpointsInput{Filters: testVpcFilter}).
+		Return(&ec2.DescribeVpcEndpointsOutput{VpcEndpoints: []types.VpcEndpoint{
+			{ServiceName: aws.String("com.amazonaws.us-east-1.s3")},
+			{ServiceName: aws.String("com.amazonaws.us-east-1.ecr.api")},
+		}}, nil)
+
+	serviceNames, err := client.GetVpcEndpointServiceNames(testVpcId)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"com.amazonaws.us-east-1.s3", "com.amazonaws.us-east-1.ecr.api"}, serviceNames)
+}
+
+func TestClient_CountVpcs(t *testing.T) {
+	mockClient := new(ec2MockClient)
+	client := &Client{mockClient}
+	mockClient.On("DescribeVpcs", context.Background(), &ec2.DescribeVpcsInput{}).
+		Return(&ec2.DescribeVpcsOutput{Vpcs: []types.Vpc{{}, {}}}, nil)
+
+	count, err := client.CountVpcs()
+	require.NoError(t, err)
+	assert.Equal(t, 2, count)
+}
+
+func TestClient_CountElasticIps(t *testing.T) {
+	mockClient := new(ec2MockClient)
+	client := &Client{mockClient}
+	mockClient.On("DescribeAddresses", context.Background(), &ec2.DescribeAddressesInput{
+		Filters: []types.Filter{{Name: aws.String("domain"), Values: []string{"vpc"}}},
+	}).Return(&ec2.DescribeAddressesOutput{Addresses: []types.Address{{}, {}, {}}}, nil)
+
+	count, err := client.CountElasticIps()
+	require.NoError(t, err)
+	assert.Equal(t, 3, count)
+}
+
+func TestClient_CountElasticIps_Error(t *testing.T) {
+	mockClient := new(ec2MockClient)
+	client := &Client{mockClient}
+	mockClient.On("DescribeAddresses", context.Background(), &ec2.DescribeAddressesInput{
+		Filters: []types.Filter{{Name: aws.String("domain"), Values: []string{"vpc"}}},
+	}).Return(nil, errors.New("some error"))
+
+	_, err := client.CountElasticIps()
+	assert.Error(t, err)
+}
+
+func TestClient_GetVpcTags(t *testing.T) {
+	mockClient := new(ec2MockClient)
+	client := &Client{mockClient}
+	mockClient.On("DescribeVpcs", context.Background(), &ec2.DescribeVpcsInput{VpcIds: []string{testVpcId}}).
+		Return(&ec2.DescribeVpcsOutput{Vpcs: []types.Vpc{{
+			VpcId: aws.String(testVpcId),
+			Tags:  []types.Tag{{Key: aws.String("aws:cloudformation:stack-name"), Value: aws.String("Agc-Core")}},
+		}}}, nil)
+
+	tags, err := client.GetVpcTags(testVpcId)
+	require.NoError(t, err)
+	assert.Equal(t, map[string]string{"aws:cloudformation:stack-name": "Agc-Core"}, tags)
+}
+
+func TestClient_GetVpcTags_VpcNotFound(t *testing.T) {
+	mockClient := new(ec2MockClient)
+	client := &Client{mockClient}
+	mockClient.On("DescribeVpcs", context.Background(), &ec2.DescribeVpcsInput{VpcIds: []string{testVpcId}}).
+		Return(&ec2.DescribeVpcsOutput{}, nil)
+
+	_, err := client.GetVpcTags(testVpcId)
+	var actionableErr *actionableerror.Error
+	require.ErrorAs(t, err, &actionableErr)
+	assert.ErrorIs(t, actionableErr.Cause, ErrVpcNotFound)
+}
@@ -1,12 +1,20 @@
 package ecr
 
-import "github.com/aws/aws-sdk-go-v2/service/ecr"
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/service/ecr"
+)
 
 type Interface interface {
 	ImageListable(string, string, string, string) (bool, error)
 	VerifyImageExists(reference ImageReference) error
+	RepositoryExists(repositoryName string) (bool, error)
+	DeleteRepository(repositoryName string) error
 }
 
 type ecrInterface interface {
 	ecr.ListImagesAPIClient
+	ecr.DescribeRepositoriesAPIClient
+	DeleteRepository(context.Context, *ecr.DeleteRepositoryInput, ...func(*ecr.Options)) (*ecr.DeleteRepositoryOutput, error)
 }
@@ -8,7 +8,6 @@ import (
 )
 
 type EcrMock struct {
-	ecr.ListImagesAPIClient
 	mock.Mock
 }
 
@@ -28,3 +27,25 @@ func (m *EcrMock) ListImages(ctx context.Context, input *ecr.ListImagesInput, op
 	}
 	return nil, err
 }
+
+func (m *EcrMock) DescribeRepositories(ctx context.Context, input *ecr.DescribeRepositoriesInput, opts ...func(*ecr.Options)) (*ecr.DescribeRepositoriesOutput, error) {
+	args := m.Called(ctx, input)
+	output := args.Get(0)
+	err := args.Error(1)
+
+	if output != nil {
+		return output.(*ecr.DescribeRepositoriesOutput), err
+	}
+	return nil, err
+}
+
+func (m *EcrMock) DeleteRepository(ctx context.Context, input *ecr.DeleteRepositoryInput, opts ...func(*ecr.Options)) (*ecr.DeleteRepositoryOutput, error) {
+	args := m.Called(ctx, input)
+	output := args.Get(0)
+	err := args.Error(1)
+
+	if output != nil {
+		return output.(*ecr.DeleteRepositoryOutput), err
+	}
+	return nil, err
+}
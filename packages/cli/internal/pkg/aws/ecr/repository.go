@@ -0,0 +1,42 @@
+package ecr
+
+import (
+	"context"
+	"errors"
+
+	"github.com/aws/amazon-genomics-cli/internal/pkg/cli/clierror/actionableerror"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ecr"
+	"github.com/aws/aws-sdk-go-v2/service/ecr/types"
+)
+
+func (c *Client) RepositoryExists(repositoryName string) (bool, error) {
+	_, err := c.ecr.DescribeRepositories(context.Background(), &ecr.DescribeRepositoriesInput{
+		RepositoryNames: []string{repositoryName},
+	})
+	if err != nil {
+		var notFound *types.RepositoryNotFoundException
+		if errors.As(err, &notFound) {
+			return false, nil
+		}
+		return false, actionableerror.FindSuggestionForError(err, actionableerror.AwsErrorMessageToSuggestedActionMap)
+	}
+	return true, nil
+}
+
+// DeleteRepository deletes a repository including any images it still contains.
+// Deleting a repository that no longer exists is not an error
+func (c *Client) DeleteRepository(repositoryName string) error {
+	_, err := c.ecr.DeleteRepository(context.Background(), &ecr.DeleteRepositoryInput{
+		RepositoryName: aws.String(repositoryName),
+		Force:          true,
+	})
+	if err != nil {
+		var notFound *types.RepositoryNotFoundException
+		if errors.As(err, &notFound) {
+			return nil
+		}
+		return actionableerror.FindSuggestionForError(err, actionableerror.AwsErrorMessageToSuggestedActionMap)
+	}
+	return nil
+}
@@ -0,0 +1,93 @@
+package ecr
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ecr"
+	"github.com/aws/aws-sdk-go-v2/service/ecr/types"
+	"github.com/stretchr/testify/assert"
+)
+
+const testRepositoryName = "agc/cache"
+
+func TestClient_RepositoryExists(t *testing.T) {
+	testCases := map[string]struct {
+		err         error
+		expected    bool
+		expectedErr bool
+	}{
+		"exists": {
+			expected: true,
+		},
+		"not found": {
+			err: &types.RepositoryNotFoundException{},
+		},
+		"describe error": {
+			err:         fmt.Errorf("some describe error"),
+			expectedErr: true,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			c := NewMockClient()
+			var output *ecr.DescribeRepositoriesOutput
+			if tc.err == nil {
+				output = &ecr.DescribeRepositoriesOutput{Repositories: []types.Repository{{RepositoryName: aws.String(testRepositoryName)}}}
+			}
+			c.ecr.(*EcrMock).On("DescribeRepositories", context.Background(), &ecr.DescribeRepositoriesInput{
+				RepositoryNames: []string{testRepositoryName},
+			}).Return(output, tc.err)
+
+			exists, err := c.RepositoryExists(testRepositoryName)
+
+			if tc.expectedErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+			assert.Equal(t, tc.expected, exists)
+		})
+	}
+}
+
+func TestClient_DeleteRepository(t *testing.T) {
+	testCases := map[string]struct {
+		err         error
+		expectedErr bool
+	}{
+		"deleted": {},
+		"already gone": {
+			err: &types.RepositoryNotFoundException{},
+		},
+		"delete error": {
+			err:         fmt.Errorf("some delete error"),
+			expectedErr: true,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			c := NewMockClient()
+			var output *ecr.DeleteRepositoryOutput
+			if tc.err == nil {
+				output = &ecr.DeleteRepositoryOutput{}
+			}
+			c.ecr.(*EcrMock).On("DeleteRepository", context.Background(), &ecr.DeleteRepositoryInput{
+				RepositoryName: aws.String(testRepositoryName),
+				Force:          true,
+			}).Return(output, tc.err)
+
+			err := c.DeleteRepository(testRepositoryName)
+
+			if tc.expectedErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,16 @@
+package efs
+
+import (
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/efs"
+)
+
+type Client struct {
+	efs efsInterface
+}
+
+func New(cfg aws.Config) *Client {
+	return &Client{
+		efs: efs.NewFromConfig(cfg),
+	}
+}
@@ -0,0 +1,54 @@
+package efs
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/service/efs"
+	"github.com/stretchr/testify/mock"
+)
+
+type EfsMock struct {
+	mock.Mock
+}
+
+func NewMockClient() *Client {
+	return &Client{
+		efs: new(EfsMock),
+	}
+}
+
+func (m *EfsMock) DescribeFileSystems(ctx context.Context, input *efs.DescribeFileSystemsInput, _ ...func(*efs.Options)) (*efs.DescribeFileSystemsOutput, error) {
+	args := m.Called(ctx, input)
+	output := args.Get(0)
+	if output != nil {
+		return output.(*efs.DescribeFileSystemsOutput), args.Error(1)
+	}
+	return nil, args.Error(1)
+}
+
+func (m *EfsMock) DescribeMountTargets(ctx context.Context, input *efs.DescribeMountTargetsInput, _ ...func(*efs.Options)) (*efs.DescribeMountTargetsOutput, error) {
+	args := m.Called(ctx, input)
+	output := args.Get(0)
+	if output != nil {
+		return output.(*efs.DescribeMountTargetsOutput), args.Error(1)
+	}
+	return nil, args.Error(1)
+}
+
+func (m *EfsMock) DeleteMountTarget(ctx context.Context, input *efs.DeleteMountTargetInput, _ ...func(*efs.Options)) (*efs.DeleteMountTargetOutput, error) {
+	args := m.Called(ctx, input)
+	output := args.Get(0)
+	if output != nil {
+		return output.(*efs.DeleteMountTargetOutput), args.Error(1)
+	}
+	return nil, args.Error(1)
+}
+
+func (m *EfsMock) DeleteFileSystem(ctx context.Context, input *efs.DeleteFileSystemInput, _ ...func(*efs.Options)) (*efs.DeleteFileSystemOutput, error) {
+	args := m.Called(ctx, input)
+	output := args.Get(0)
+	if output != nil {
+		return output.(*efs.DeleteFileSystemOutput), args.Error(1)
+	}
+	return nil, args.Error(1)
+}
@@ -0,0 +1,95 @@
+package efs
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/aws/amazon-genomics-cli/internal/pkg/cli/clierror/actionableerror"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/efs"
+	"github.com/aws/aws-sdk-go-v2/service/efs/types"
+)
+
+var (
+	mountTargetPollInterval = 10 * time.Second
+	mountTargetPollAttempts = 30
+)
+
+func (c *Client) FileSystemExists(fileSystemId string) (bool, error) {
+	_, err := c.efs.DescribeFileSystems(context.Background(), &efs.DescribeFileSystemsInput{
+		FileSystemId: aws.String(fileSystemId),
+	})
+	if err != nil {
+		var notFound *types.FileSystemNotFound
+		if errors.As(err, &notFound) {
+			return false, nil
+		}
+		return false, actionableerror.FindSuggestionForError(err, actionableerror.AwsErrorMessageToSuggestedActionMap)
+	}
+	return true, nil
+}
+
+// DeleteFileSystem removes the mount targets of a file system, waits for them to disappear and then deletes
+// the file system itself. Deleting a file system that no longer exists is not an error
+func (c *Client) DeleteFileSystem(fileSystemId string) error {
+	mountTargetIds, err := c.getMountTargetIds(fileSystemId)
+	if err != nil {
+		return err
+	}
+	for _, mountTargetId := range mountTargetIds {
+		_, err := c.efs.DeleteMountTarget(context.Background(), &efs.DeleteMountTargetInput{MountTargetId: aws.String(mountTargetId)})
+		var notFound *types.MountTargetNotFound
+		if err != nil && !errors.As(err, &notFound) {
+			return actionableerror.FindSuggestionForError(err, actionableerror.AwsErrorMessageToSuggestedActionMap)
+		}
+	}
+	if err := c.waitForMountTargetsDeleted(fileSystemId, len(mountTargetIds)); err != nil {
+		return err
+	}
+
+	_, err = c.efs.DeleteFileSystem(context.Background(), &efs.DeleteFileSystemInput{FileSystemId: aws.String(fileSystemId)})
+	if err != nil {
+		var notFound *types.FileSystemNotFound
+		if errors.As(err, &notFound) {
+			return nil
+		}
+		return actionableerror.FindSuggestionForError(err, actionableerror.AwsErrorMessageToSuggestedActionMap)
+	}
+	return nil
+}
+
+func (c *Client) getMountTargetIds(fileSystemId string) ([]string, error) {
+	output, err := c.efs.DescribeMountTargets(context.Background(), &efs.DescribeMountTargetsInput{FileSystemId: aws.String(fileSystemId)})
+	if err != nil {
+		var notFound *types.FileSystemNotFound
+		if errors.As(err, &notFound) {
+			return nil, nil
+		}
+		return nil, actionableerror.FindSuggestionForError(err, actionableerror.AwsErrorMessageToSuggestedActionMap)
+	}
+	var mountTargetIds []string
+	for _, mountTarget := range output.MountTargets {
+		mountTargetIds = append(mountTargetIds, aws.ToString(mountTarget.MountTargetId))
+	}
+	return mountTargetIds, nil
+}
+
+func (c *Client) waitForMountTargetsDeleted(fileSystemId string, remaining int) error {
+	for attempt := 0; remaining > 0; attempt++ {
+		if attempt == mountTargetPollAttempts {
+			return actionableerror.New(
+				fmt.Errorf("mount targets of file system '%s' are still being deleted", fileSystemId),
+				"wait a few minutes and try again",
+			)
+		}
+		time.Sleep(mountTargetPollInterval)
+		mountTargetIds, err := c.getMountTargetIds(fileSystemId)
+		if err != nil {
+			return err
+		}
+		remaining = len(mountTargetIds)
+	}
+	return nil
+}
@@ -0,0 +1,116 @@
+package efs
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/efs"
+	"github.com/aws/aws-sdk-go-v2/service/efs/types"
+	"github.com/stretchr/testify/assert"
+)
+
+const (
+	testFileSystemId  = "fs-0123456789abcdef0"
+	testMountTargetId = "fsmt-0123456789abcdef0"
+)
+
+func TestClient_FileSystemExists(t *testing.T) {
+	testCases := map[string]struct {
+		err         error
+		expected    bool
+		expectedErr bool
+	}{
+		"exists": {
+			expected: true,
+		},
+		"not found": {
+			err: &types.FileSystemNotFound{},
+		},
+		"describe error": {
+			err:         fmt.Errorf("some describe error"),
+			expectedErr: true,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			client := NewMockClient()
+			var output *efs.DescribeFileSystemsOutput
+			if tc.err == nil {
+				output = &efs.DescribeFileSystemsOutput{FileSystems: []types.FileSystemDescription{{FileSystemId: aws.String(testFileSystemId)}}}
+			}
+			client.efs.(*EfsMock).On("DescribeFileSystems", context.Background(), &efs.DescribeFileSystemsInput{
+				FileSystemId: aws.String(testFileSystemId),
+			}).Return(output, tc.err)
+
+			exists, err := client.FileSystemExists(testFileSystemId)
+
+			if tc.expectedErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+			assert.Equal(t, tc.expected, exists)
+		})
+	}
+}
+
+func TestClient_DeleteFileSystem(t *testing.T) {
+	origInterval := mountTargetPollInterval
+	defer func() { mountTargetPollInterval = origInterval }()
+	mountTargetPollInterval = time.Millisecond
+
+	client := NewMockClient()
+	efsMock := client.efs.(*EfsMock)
+	describeInput := &efs.DescribeMountTargetsInput{FileSystemId: aws.String(testFileSystemId)}
+	efsMock.On("DescribeMountTargets", context.Background(), describeInput).Return(&efs.DescribeMountTargetsOutput{
+		MountTargets: []types.MountTargetDescription{{MountTargetId: aws.String(testMountTargetId)}},
+	}, nil).Twice()
+	efsMock.On("DescribeMountTargets", context.Background(), describeInput).Return(&efs.DescribeMountTargetsOutput{}, nil).Once()
+	efsMock.On("DeleteMountTarget", context.Background(), &efs.DeleteMountTargetInput{MountTargetId: aws.String(testMountTargetId)}).
+		Return(&efs.DeleteMountTargetOutput{}, nil)
+	efsMock.On("DeleteFileSystem", context.Background(), &efs.DeleteFileSystemInput{FileSystemId: aws.String(testFileSystemId)}).
+		Return(&efs.DeleteFileSystemOutput{}, nil)
+
+	err := client.DeleteFileSystem(testFileSystemId)
+
+	assert.NoError(t, err)
+	efsMock.AssertExpectations(t)
+}
+
+func TestClient_DeleteFileSystem_AlreadyGone(t *testing.T) {
+	client := NewMockClient()
+	efsMock := client.efs.(*EfsMock)
+	efsMock.On("DescribeMountTargets", context.Background(), &efs.DescribeMountTargetsInput{FileSystemId: aws.String(testFileSystemId)}).
+		Return(nil, &types.FileSystemNotFound{})
+	efsMock.On("DeleteFileSystem", context.Background(), &efs.DeleteFileSystemInput{FileSystemId: aws.String(testFileSystemId)}).
+		Return(nil, &types.FileSystemNotFound{})
+
+	err := client.DeleteFileSystem(testFileSystemId)
+
+	assert.NoError(t, err)
+}
+
+func TestClient_DeleteFileSystem_MountTargetsNotDeleted(t *testing.T) {
+	origInterval, origAttempts := mountTargetPollInterval, mountTargetPollAttempts
+	defer func() { mountTargetPollInterval, mountTargetPollAttempts = origInterval, origAttempts }()
+	mountTargetPollInterval, mountTargetPollAttempts = time.Millisecond, 2
+
+	client := NewMockClient()
+	efsMock := client.efs.(*EfsMock)
+	efsMock.On("DescribeMountTargets", context.Background(), &efs.DescribeMountTargetsInput{FileSystemId: aws.String(testFileSystemId)}).
+		Return(&efs.DescribeMountTargetsOutput{
+			MountTargets: []types.MountTargetDescription{{MountTargetId: aws.String(testMountTargetId)}},
+		}, nil)
+	efsMock.On("DeleteMountTarget", context.Background(), &efs.DeleteMountTargetInput{MountTargetId: aws.String(testMountTargetId)}).
+		Return(&efs.DeleteMountTargetOutput{}, nil)
+
+	err := client.DeleteFileSystem(testFileSystemId)
+
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "are still being deleted")
+	efsMock.AssertNotCalled(t, "DeleteFileSystem", context.Background(), &efs.DeleteFileSystemInput{FileSystemId: aws.String(testFileSystemId)})
+}
@@ -0,0 +1,19 @@
+package efs
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/service/efs"
+)
+
+type Interface interface {
+	FileSystemExists(fileSystemId string) (bool, error)
+	DeleteFileSystem(fileSystemId string) error
+}
+
+type efsInterface interface {
+	efs.DescribeFileSystemsAPIClient
+	DescribeMountTargets(context.Context, *efs.DescribeMountTargetsInput, ...func(*efs.Options)) (*efs.DescribeMountTargetsOutput, error)
+	DeleteMountTarget(context.Context, *efs.DeleteMountTargetInput, ...func(*efs.Options)) (*efs.DeleteMountTargetOutput, error)
+	DeleteFileSystem(context.Context, *efs.DeleteFileSystemInput, ...func(*efs.Options)) (*efs.DeleteFileSystemOutput, error)
+}
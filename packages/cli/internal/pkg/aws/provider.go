@@ -2,24 +2,42 @@ package aws
 
 import (
 	"context"
+	"fmt"
+	"time"
 
 	"github.com/aws/amazon-genomics-cli/internal/pkg/aws/batch"
 	"github.com/aws/amazon-genomics-cli/internal/pkg/aws/cdk"
 	"github.com/aws/amazon-genomics-cli/internal/pkg/aws/cfn"
+	"github.com/aws/amazon-genomics-cli/internal/pkg/aws/costexplorer"
 	"github.com/aws/amazon-genomics-cli/internal/pkg/aws/cwl"
 	"github.com/aws/amazon-genomics-cli/internal/pkg/aws/ddb"
+	"github.com/aws/amazon-genomics-cli/internal/pkg/aws/ec2"
 	"github.com/aws/amazon-genomics-cli/internal/pkg/aws/ecr"
+	"github.com/aws/amazon-genomics-cli/internal/pkg/aws/efs"
 	"github.com/aws/amazon-genomics-cli/internal/pkg/aws/s3"
+	"github.com/aws/amazon-genomics-cli/internal/pkg/aws/secretsmanager"
+	"github.com/aws/amazon-genomics-cli/internal/pkg/aws/servicequotas"
 	"github.com/aws/amazon-genomics-cli/internal/pkg/aws/ssm"
 	"github.com/aws/amazon-genomics-cli/internal/pkg/aws/sts"
+	"github.com/aws/amazon-genomics-cli/internal/pkg/aws/tagging"
+	"github.com/aws/amazon-genomics-cli/internal/pkg/network"
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/config"
 	"github.com/aws/aws-sdk-go-v2/credentials/stscreds"
+	awssts "github.com/aws/aws-sdk-go-v2/service/sts"
 	"github.com/rs/zerolog/log"
 )
 
 type client string
 
+const credentialsExpiryWindow = time.Minute
+
+// defaultAssumeRoleDuration is the duration of the sessions of assumed roles when none is configured. The CDK is
+// handed the credentials of a session when a deployment starts and cannot refresh them, so the default is longer
+// than the 15 minutes of STS to let context deployments complete. It is also the longest session that roles allow
+// unless their maximum session duration is raised.
+const defaultAssumeRoleDuration = time.Hour
+
 const (
 	clientCdk   client = "CDK"
 	clientCfn   client = "CFN"
@@ -30,18 +48,68 @@ const (
 	clientDdb   client = "DDB"
 	clientBatch client = "BATCH"
 	clientEcr   client = "ECR"
+	clientSm    client = "SECRETSMANAGER"
+	clientEc2   client = "EC2"
+	clientSq    client = "SERVICEQUOTAS"
+	clientEfs   client = "EFS"
+	clientTag   client = "TAGGING"
+	clientCe    client = "COSTEXPLORER"
 )
 
 var (
 	profileConfigs = make(map[string]aws.Config)
 	profileClients = make(map[string]map[client]interface{})
+	profileTargets = make(map[string]target)
+	assumeRole     AssumeRoleOptions
 	loadConfig     = config.LoadDefaultConfig
 )
 
+// AssumeRoleOptions describe an IAM role that is assumed with the credentials of every profile
+type AssumeRoleOptions struct {
+	RoleArn     string
+	ExternalId  string
+	SessionName string
+	Duration    time.Duration
+}
+
+// SetAssumeRole sets the role that clients assume. It must be called before any client is created.
+func SetAssumeRole(options AssumeRoleOptions) {
+	assumeRole = options
+}
+
+// target is the account and region that the clients of a target profile operate in
+type target struct {
+	profile string
+	region  string
+	roleArn string
+}
+
+// TargetProfile returns a profile key for clients that operate in the given region with the credentials of the
+// profile or, when roleArn is set, of the role assumed with those credentials. The key can be passed to any of the
+// client functions in place of a profile. Without a region or role the profile itself is returned.
+func TargetProfile(profile, region, roleArn string) string {
+	if region == "" && roleArn == "" {
+		return profile
+	}
+	key := fmt.Sprintf("%s@%s@%s", profile, region, roleArn)
+	profileTargets[key] = target{profile: profile, region: region, roleArn: roleArn}
+	return key
+}
+
 func CdkClient(profile string) *cdk.Client {
 	initClientMap(profile)
 	if _, ok := profileClients[profile][clientCdk]; !ok {
-		profileClients[profile][clientCdk] = cdk.NewClient(profile)
+		target, isTarget := profileTargets[profile]
+		switch {
+		case isTarget && (target.roleArn != "" || assumeRole.RoleArn != ""):
+			profileClients[profile][clientCdk] = cdk.NewTargetClient(target.profile, target.region, GetProfileConfig(profile).Credentials)
+		case isTarget:
+			profileClients[profile][clientCdk] = cdk.NewTargetClient(target.profile, target.region, nil)
+		case assumeRole.RoleArn != "":
+			profileClients[profile][clientCdk] = cdk.NewTargetClient(profile, "", GetProfileConfig(profile).Credentials)
+		default:
+			profileClients[profile][clientCdk] = cdk.NewClient(profile)
+		}
 	}
 
 	client := profileClients[profile][clientCdk].(cdk.Client)
@@ -132,12 +200,78 @@ func EcrClient(profile string) *ecr.Client {
 	return client
 }
 
+func SecretsManagerClient(profile string) *secretsmanager.Client {
+	initClientMap(profile)
+	if _, ok := profileClients[profile][clientSm]; !ok {
+		cfg := GetProfileConfig(profile)
+		profileClients[profile][clientSm] = secretsmanager.New(cfg)
+	}
+
+	return profileClients[profile][clientSm].(*secretsmanager.Client)
+}
+
+func Ec2Client(profile string) *ec2.Client {
+	initClientMap(profile)
+	if _, ok := profileClients[profile][clientEc2]; !ok {
+		cfg := GetProfileConfig(profile)
+		profileClients[profile][clientEc2] = ec2.New(cfg)
+	}
+
+	return profileClients[profile][clientEc2].(*ec2.Client)
+}
+
+func ServiceQuotasClient(profile string) *servicequotas.Client {
+	initClientMap(profile)
+	if _, ok := profileClients[profile][clientSq]; !ok {
+		cfg := GetProfileConfig(profile)
+		profileClients[profile][clientSq] = servicequotas.New(cfg)
+	}
+
+	return profileClients[profile][clientSq].(*servicequotas.Client)
+}
+
+func EfsClient(profile string) *efs.Client {
+	initClientMap(profile)
+	if _, ok := profileClients[profile][clientEfs]; !ok {
+		cfg := GetProfileConfig(profile)
+		profileClients[profile][clientEfs] = efs.New(cfg)
+	}
+
+	return profileClients[profile][clientEfs].(*efs.Client)
+}
+
+func CostExplorerClient(profile string) *costexplorer.Client {
+	initClientMap(profile)
+	if _, ok := profileClients[profile][clientCe]; !ok {
+		cfg := GetProfileConfig(profile)
+		profileClients[profile][clientCe] = costexplorer.New(cfg)
+	}
+
+	return profileClients[profile][clientCe].(*costexplorer.Client)
+}
+
+func TaggingClient(profile string) *tagging.Client {
+	initClientMap(profile)
+	if _, ok := profileClients[profile][clientTag]; !ok {
+		cfg := GetProfileConfig(profile)
+		profileClients[profile][clientTag] = tagging.New(cfg)
+	}
+
+	return profileClients[profile][clientTag].(*tagging.Client)
+}
+
 func Region(profile string) string {
 	initClientMap(profile)
 	cfg := GetProfileConfig(profile)
 	return cfg.Region
 }
 
+// ValidateProfile returns an error when the profile is in neither the shared AWS config file nor the shared credentials file
+func ValidateProfile(profile string) error {
+	_, err := config.LoadSharedConfigProfile(context.Background(), profile)
+	return err
+}
+
 func initClientMap(profile string) {
 	if _, ok := profileClients[profile]; !ok {
 		profileClients[profile] = make(map[client]interface{})
@@ -145,9 +279,16 @@ func initClientMap(profile string) {
 }
 
 func GetProfileConfig(profile string) aws.Config {
+	if target, ok := profileTargets[profile]; ok {
+		if _, ok := profileConfigs[profile]; !ok {
+			profileConfigs[profile] = targetConfig(target)
+		}
+		return profileConfigs[profile]
+	}
 	if _, ok := profileConfigs[profile]; !ok {
 		cfg, err := loadConfig(context.Background(),
 			config.WithSharedConfigProfile(profile),
+			config.WithHTTPClient(network.HttpClient()),
 			config.WithAssumeRoleCredentialOptions(func(o *stscreds.AssumeRoleOptions) {
 				o.TokenProvider = stscreds.StdinTokenProvider
 			}),
@@ -155,8 +296,46 @@ func GetProfileConfig(profile string) aws.Config {
 		if err != nil {
 			log.Fatal().Err(err).Send()
 		}
+		if assumeRole.RoleArn != "" {
+			cfg.Credentials = assumeRoleCredentials(cfg, assumeRole)
+		}
 		profileConfigs[profile] = cfg
 	}
 
 	return profileConfigs[profile]
 }
+
+func targetConfig(target target) aws.Config {
+	cfg := GetProfileConfig(target.profile).Copy()
+	if target.region != "" {
+		cfg.Region = target.region
+	}
+	if target.roleArn != "" {
+		cfg.Credentials = assumeRoleCredentials(cfg, AssumeRoleOptions{RoleArn: target.roleArn})
+	}
+	return cfg
+}
+
+// assumeRoleCredentials returns credentials of a role assumed with the credentials of a config. They are cached
+// and refreshed shortly before they expire.
+func assumeRoleCredentials(cfg aws.Config, options AssumeRoleOptions) aws.CredentialsProvider {
+	provider := stscreds.NewAssumeRoleProvider(awssts.NewFromConfig(cfg), options.RoleArn, func(o *stscreds.AssumeRoleOptions) {
+		if options.ExternalId != "" {
+			o.ExternalID = aws.String(options.ExternalId)
+		}
+		if options.SessionName != "" {
+			o.RoleSessionName = options.SessionName
+		}
+		o.Duration = assumeRoleDuration(options)
+	})
+	return aws.NewCredentialsCache(provider, func(o *aws.CredentialsCacheOptions) {
+		o.ExpiryWindow = credentialsExpiryWindow
+	})
+}
+
+func assumeRoleDuration(options AssumeRoleOptions) time.Duration {
+	if options.Duration != 0 {
+		return options.Duration
+	}
+	return defaultAssumeRoleDuration
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"reflect"
 	"testing"
+	"time"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/config"
@@ -60,6 +61,30 @@ func TestClient_UsesCache(t *testing.T) {
 			testFunction: func() interface{} { return EcrClient(testProfile1) },
 			expectedType: "*ecr.Client",
 		},
+		"SecretsManager": {
+			testFunction: func() interface{} { return SecretsManagerClient(testProfile1) },
+			expectedType: "*secretsmanager.Client",
+		},
+		"Ec2": {
+			testFunction: func() interface{} { return Ec2Client(testProfile1) },
+			expectedType: "*ec2.Client",
+		},
+		"ServiceQuotas": {
+			testFunction: func() interface{} { return ServiceQuotasClient(testProfile1) },
+			expectedType: "*servicequotas.Client",
+		},
+		"Efs": {
+			testFunction: func() interface{} { return EfsClient(testProfile1) },
+			expectedType: "*efs.Client",
+		},
+		"Tagging": {
+			testFunction: func() interface{} { return TaggingClient(testProfile1) },
+			expectedType: "*tagging.Client",
+		},
+		"CostExplorer": {
+			testFunction: func() interface{} { return CostExplorerClient(testProfile1) },
+			expectedType: "*costexplorer.Client",
+		},
 	}
 
 	for name, tc := range testCases {
@@ -119,6 +144,10 @@ func TestClient_DoesNotUseCache(t *testing.T) {
 			testFunction: func(profile string) interface{} { return EcrClient(profile) },
 			expectedType: "*ecr.Client",
 		},
+		"SecretsManager": {
+			testFunction: func(profile string) interface{} { return SecretsManagerClient(profile) },
+			expectedType: "*secretsmanager.Client",
+		},
 	}
 
 	for name, tc := range testCases {
@@ -137,3 +166,49 @@ func TestClient_DoesNotUseCache(t *testing.T) {
 func mockLoadConfig(_ context.Context, _ ...func(*config.LoadOptions) error) (cfg aws.Config, err error) {
 	return aws.Config{}, nil
 }
+
+func TestTargetProfile_NoTarget(t *testing.T) {
+	assert.Equal(t, testProfile1, TargetProfile(testProfile1, "", ""))
+}
+
+func TestTargetProfile_Region(t *testing.T) {
+	origLoadConfig := loadConfig
+	loadConfig = mockLoadConfig
+	defer func() { loadConfig = origLoadConfig }()
+
+	key := TargetProfile(testProfile1, "eu-west-1", "")
+	assert.NotEqual(t, testProfile1, key)
+	cfg := GetProfileConfig(key)
+	assert.Equal(t, "eu-west-1", cfg.Region)
+	assert.Nil(t, cfg.Credentials)
+	assert.NotEqual(t, "eu-west-1", GetProfileConfig(testProfile1).Region)
+	assert.NotSame(t, CfnClient(testProfile1), CfnClient(key))
+}
+
+func TestTargetProfile_Role(t *testing.T) {
+	origLoadConfig := loadConfig
+	loadConfig = mockLoadConfig
+	defer func() { loadConfig = origLoadConfig }()
+
+	key := TargetProfile(testProfile2, "", "arn:aws:iam::111122223333:role/agc")
+	cfg := GetProfileConfig(key)
+	assert.IsType(t, &aws.CredentialsCache{}, cfg.Credentials)
+	assert.Equal(t, "*cdk.Client", reflect.TypeOf(CdkClient(key)).String())
+}
+
+func TestGetProfileConfig_AssumeRole(t *testing.T) {
+	origLoadConfig := loadConfig
+	loadConfig = mockLoadConfig
+	defer func() { loadConfig = origLoadConfig }()
+	SetAssumeRole(AssumeRoleOptions{RoleArn: "arn:aws:iam::111122223333:role/agc", ExternalId: "external-id", Duration: time.Hour})
+	defer SetAssumeRole(AssumeRoleOptions{})
+
+	cfg := GetProfileConfig("assume-role-profile")
+	assert.IsType(t, &aws.CredentialsCache{}, cfg.Credentials)
+	assert.Equal(t, "*cdk.Client", reflect.TypeOf(CdkClient("assume-role-profile")).String())
+}
+
+func TestAssumeRoleDuration(t *testing.T) {
+	assert.Equal(t, time.Hour, assumeRoleDuration(AssumeRoleOptions{RoleArn: "arn:aws:iam::111122223333:role/Test"}))
+	assert.Equal(t, 2*time.Hour, assumeRoleDuration(AssumeRoleOptions{RoleArn: "arn:aws:iam::111122223333:role/Test", Duration: 2 * time.Hour}))
+}
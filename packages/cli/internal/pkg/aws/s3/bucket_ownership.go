@@ -0,0 +1,50 @@
+package s3
+
+import (
+	"context"
+	"errors"
+	"net/http"
+
+	"github.com/aws/amazon-genomics-cli/internal/pkg/cli/clierror/actionableerror"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awshttp "github.com/aws/aws-sdk-go-v2/aws/transport/http"
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+	"github.com/aws/aws-sdk-go-v2/service/s3/types"
+)
+
+const (
+	defaultBucketRegion = "us-east-1"
+	legacyEuRegion      = "eu-west-1"
+)
+
+// GetBucketRegion returns the region a bucket was created in
+func (c *Client) GetBucketRegion(bucketName string) (string, error) {
+	output, err := c.s3.GetBucketLocation(context.Background(), &s3.GetBucketLocationInput{Bucket: aws.String(bucketName)})
+	if err != nil {
+		return "", actionableerror.FindSuggestionForError(err, actionableerror.AwsErrorMessageToSuggestedActionMap)
+	}
+	switch output.LocationConstraint {
+	case "":
+		return defaultBucketRegion, nil
+	case types.BucketLocationConstraintEu:
+		return legacyEuRegion, nil
+	default:
+		return string(output.LocationConstraint), nil
+	}
+}
+
+// IsBucketOwner returns true if the bucket is owned by the given account
+func (c *Client) IsBucketOwner(bucketName, accountId string) (bool, error) {
+	_, err := c.s3.HeadBucket(context.Background(), &s3.HeadBucketInput{
+		Bucket:              aws.String(bucketName),
+		ExpectedBucketOwner: aws.String(accountId),
+	})
+	if err != nil {
+		var responseError *awshttp.ResponseError
+		if errors.As(err, &responseError) && responseError.HTTPStatusCode() == http.StatusForbidden {
+			return false, nil
+		}
+		return false, actionableerror.FindSuggestionForError(err, actionableerror.AwsErrorMessageToSuggestedActionMap)
+	}
+	return true, nil
+}
@@ -0,0 +1,99 @@
+package s3
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awshttp "github.com/aws/aws-sdk-go-v2/aws/transport/http"
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+	"github.com/aws/aws-sdk-go-v2/service/s3/types"
+	smithyhttp "github.com/aws/smithy-go/transport/http"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const testAccountId = "123456789012"
+
+func (m *S3Mock) GetBucketLocation(ctx context.Context, input *s3.GetBucketLocationInput, opts ...func(*s3.Options)) (*s3.GetBucketLocationOutput, error) {
+	args := m.Called(ctx, input)
+	output := args.Get(0)
+	err := args.Error(1)
+
+	if output != nil {
+		return output.(*s3.GetBucketLocationOutput), err
+	}
+	return nil, err
+}
+
+func TestClient_GetBucketRegion(t *testing.T) {
+	testCases := map[string]struct {
+		constraint     types.BucketLocationConstraint
+		expectedRegion string
+	}{
+		"us-east-1": {
+			constraint:     "",
+			expectedRegion: "us-east-1",
+		},
+		"legacy EU": {
+			constraint:     types.BucketLocationConstraintEu,
+			expectedRegion: "eu-west-1",
+		},
+		"other region": {
+			constraint:     types.BucketLocationConstraintUsWest2,
+			expectedRegion: "us-west-2",
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			client := NewMockClient()
+			client.s3.(*S3Mock).On("GetBucketLocation", context.Background(), &s3.GetBucketLocationInput{Bucket: aws.String(testBucketName)}).
+				Return(&s3.GetBucketLocationOutput{LocationConstraint: tc.constraint}, nil)
+			region, err := client.GetBucketRegion(testBucketName)
+			require.NoError(t, err)
+			assert.Equal(t, tc.expectedRegion, region)
+		})
+	}
+}
+
+func TestClient_GetBucketRegion_WithError(t *testing.T) {
+	client := NewMockClient()
+	client.s3.(*S3Mock).On("GetBucketLocation", context.Background(), &s3.GetBucketLocationInput{Bucket: aws.String(testBucketName)}).
+		Return(nil, fmt.Errorf(testErrorMessage))
+	_, err := client.GetBucketRegion(testBucketName)
+	assert.Error(t, err)
+}
+
+func TestClient_IsBucketOwner_WithOwner(t *testing.T) {
+	client := NewMockClient()
+	client.s3.(*S3Mock).On("HeadBucket", context.Background(), &s3.HeadBucketInput{Bucket: aws.String(testBucketName), ExpectedBucketOwner: aws.String(testAccountId)}).
+		Return(&s3.HeadBucketOutput{}, nil)
+	isOwner, err := client.IsBucketOwner(testBucketName, testAccountId)
+	require.NoError(t, err)
+	assert.True(t, isOwner)
+}
+
+func TestClient_IsBucketOwner_WithOtherOwner(t *testing.T) {
+	client := NewMockClient()
+	forbidden := &awshttp.ResponseError{
+		ResponseError: &smithyhttp.ResponseError{
+			Response: &smithyhttp.Response{Response: &http.Response{StatusCode: http.StatusForbidden}},
+			Err:      fmt.Errorf(testErrorMessage),
+		},
+	}
+	client.s3.(*S3Mock).On("HeadBucket", context.Background(), &s3.HeadBucketInput{Bucket: aws.String(testBucketName), ExpectedBucketOwner: aws.String(testAccountId)}).
+		Return(nil, forbidden)
+	isOwner, err := client.IsBucketOwner(testBucketName, testAccountId)
+	require.NoError(t, err)
+	assert.False(t, isOwner)
+}
+
+func TestClient_IsBucketOwner_WithError(t *testing.T) {
+	client := NewMockClient()
+	client.s3.(*S3Mock).On("HeadBucket", context.Background(), &s3.HeadBucketInput{Bucket: aws.String(testBucketName), ExpectedBucketOwner: aws.String(testAccountId)}).
+		Return(nil, fmt.Errorf(testErrorMessage))
+	_, err := client.IsBucketOwner(testBucketName, testAccountId)
+	assert.Error(t, err)
+}
@@ -0,0 +1,68 @@
+package s3
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/aws/amazon-genomics-cli/internal/pkg/cli/clierror/actionableerror"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+	"github.com/rs/zerolog/log"
+)
+
+// DownloadPrefix downloads the objects under a prefix into a directory, keeping the path of their keys below the prefix.
+// It returns the number of objects downloaded.
+func (c *Client) DownloadPrefix(bucketName, prefix, directory string) (int, error) {
+	ctx := context.Background()
+	if prefix != "" && !strings.HasSuffix(prefix, "/") {
+		prefix += "/"
+	}
+	input := &s3.ListObjectsV2Input{Bucket: aws.String(bucketName), Prefix: aws.String(prefix)}
+	paginator := s3.NewListObjectsV2Paginator(c.s3, input)
+	count := 0
+	for paginator.HasMorePages() {
+		page, err := paginator.NextPage(ctx)
+		if err != nil {
+			return count, actionableerror.FindSuggestionForError(err, actionableerror.AwsErrorMessageToSuggestedActionMap)
+		}
+		for _, object := range page.Contents {
+			key := aws.ToString(object.Key)
+			relativePath := strings.TrimPrefix(key, prefix)
+			if relativePath == "" || strings.HasSuffix(relativePath, "/") {
+				continue
+			}
+			filePath := filepath.Join(directory, filepath.FromSlash(relativePath))
+			if !strings.HasPrefix(filePath, filepath.Clean(directory)+string(filepath.Separator)) {
+				return count, fmt.Errorf("object '%s' would be written outside of '%s'", RenderS3Uri(bucketName, key), directory)
+			}
+			log.Debug().Msgf("Downloading '%s' to '%s'", RenderS3Uri(bucketName, key), filePath)
+			if err := c.downloadObject(ctx, bucketName, key, filePath); err != nil {
+				return count, err
+			}
+			count++
+		}
+	}
+	return count, nil
+}
+
+func (c *Client) downloadObject(ctx context.Context, bucketName, key, filePath string) error {
+	output, err := c.s3.GetObject(ctx, &s3.GetObjectInput{Bucket: aws.String(bucketName), Key: aws.String(key)})
+	if err != nil {
+		return actionableerror.FindSuggestionForError(err, actionableerror.AwsErrorMessageToSuggestedActionMap)
+	}
+	defer output.Body.Close()
+	if err := os.MkdirAll(filepath.Dir(filePath), 0755); err != nil {
+		return err
+	}
+	file, err := os.Create(filePath)
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+	_, err = io.Copy(file, output.Body)
+	return err
+}
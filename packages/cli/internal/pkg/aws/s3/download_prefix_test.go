@@ -0,0 +1,97 @@
+package s3
+
+import (
+	"context"
+	"errors"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+	"github.com/aws/aws-sdk-go-v2/service/s3/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func (m *S3Mock) GetObject(ctx context.Context, input *s3.GetObjectInput, _ ...func(*s3.Options)) (*s3.GetObjectOutput, error) {
+	args := m.Called(ctx, input)
+	output := args.Get(0)
+	err := args.Error(1)
+
+	if output != nil {
+		return output.(*s3.GetObjectOutput), err
+	}
+	return nil, err
+}
+
+func mockObjectBody(content string) *s3.GetObjectOutput {
+	return &s3.GetObjectOutput{Body: io.NopCloser(strings.NewReader(content))}
+}
+
+func TestClient_DownloadPrefix(t *testing.T) {
+	client := NewMockClient()
+	directory := t.TempDir()
+	client.s3.(*S3Mock).On("ListObjectsV2", context.Background(), &s3.ListObjectsV2Input{
+		Bucket: aws.String(testBucketName),
+		Prefix: aws.String("templates/wdl/"),
+	}).Return(&s3.ListObjectsV2Output{
+		Contents: []types.Object{
+			{Key: aws.String("templates/wdl/")},
+			{Key: aws.String("templates/wdl/agc-project.yaml")},
+			{Key: aws.String("templates/wdl/workflows/hello.wdl")},
+		},
+	}, nil)
+	client.s3.(*S3Mock).On("GetObject", context.Background(), &s3.GetObjectInput{
+		Bucket: aws.String(testBucketName),
+		Key:    aws.String("templates/wdl/agc-project.yaml"),
+	}).Return(mockObjectBody("name: demo\n"), nil)
+	client.s3.(*S3Mock).On("GetObject", context.Background(), &s3.GetObjectInput{
+		Bucket: aws.String(testBucketName),
+		Key:    aws.String("templates/wdl/workflows/hello.wdl"),
+	}).Return(mockObjectBody("version 1.0\n"), nil)
+
+	count, err := client.DownloadPrefix(testBucketName, "templates/wdl", directory)
+	require.NoError(t, err)
+	assert.Equal(t, 2, count)
+	content, err := os.ReadFile(filepath.Join(directory, "agc-project.yaml"))
+	require.NoError(t, err)
+	assert.Equal(t, "name: demo\n", string(content))
+	content, err = os.ReadFile(filepath.Join(directory, "workflows", "hello.wdl"))
+	require.NoError(t, err)
+	assert.Equal(t, "version 1.0\n", string(content))
+}
+
+func TestClient_DownloadPrefix_OutsideDirectory(t *testing.T) {
+	client := NewMockClient()
+	client.s3.(*S3Mock).On("ListObjectsV2", context.Background(), &s3.ListObjectsV2Input{
+		Bucket: aws.String(testBucketName),
+		Prefix: aws.String("templates/"),
+	}).Return(&s3.ListObjectsV2Output{
+		Contents: []types.Object{{Key: aws.String("templates/../../escape")}},
+	}, nil)
+
+	directory := t.TempDir()
+	_, err := client.DownloadPrefix(testBucketName, "templates/", directory)
+	assert.EqualError(t, err, "object 's3://"+testBucketName+"/templates/../../escape' would be written outside of '"+directory+"'")
+}
+
+func TestClient_DownloadPrefix_GetObjectFailure(t *testing.T) {
+	client := NewMockClient()
+	client.s3.(*S3Mock).On("ListObjectsV2", context.Background(), &s3.ListObjectsV2Input{
+		Bucket: aws.String(testBucketName),
+		Prefix: aws.String("templates/"),
+	}).Return(&s3.ListObjectsV2Output{
+		Contents: []types.Object{{Key: aws.String("templates/agc-project.yaml")}},
+	}, nil)
+	client.s3.(*S3Mock).On("GetObject", context.Background(), &s3.GetObjectInput{
+		Bucket: aws.String(testBucketName),
+		Key:    aws.String("templates/agc-project.yaml"),
+	}).Return(nil, errors.New("access denied"))
+
+	count, err := client.DownloadPrefix(testBucketName, "templates/", t.TempDir())
+	assert.EqualError(t, err, "access denied")
+	assert.Equal(t, 0, count)
+}
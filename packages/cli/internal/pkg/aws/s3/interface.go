@@ -9,19 +9,25 @@ import (
 
 type Interface interface {
 	BucketExists(string) (bool, error)
+	GetBucketRegion(bucketName string) (string, error)
+	IsBucketOwner(bucketName, accountId string) (bool, error)
 	SyncFile(bucketName, key, filePath string) error
 	UploadFile(bucketName, key, filePath string) error
 	DeleteBucket(bucketName string) error
 	EmptyBucket(bucketName string) error
 	DeleteObject(bucketName, key string) error
 	DeleteObjectVersion(bucketName, key, versionId string) error
+	DownloadPrefix(bucketName, prefix, directory string) (int, error)
+	ObjectExists(bucketName, key string, requesterPays bool) (bool, error)
 }
 
 type s3Interface interface {
 	s3.HeadBucketAPIClient
 	s3.HeadObjectAPIClient
 	s3.ListObjectsV2APIClient
+	manager.DownloadAPIClient
 	manager.UploadAPIClient
+	GetBucketLocation(ctx context.Context, params *s3.GetBucketLocationInput, optFns ...func(*s3.Options)) (*s3.GetBucketLocationOutput, error)
 	DeleteBucket(ctx context.Context, params *s3.DeleteBucketInput, optFns ...func(*s3.Options)) (*s3.DeleteBucketOutput, error)
 	DeleteObject(ctx context.Context, params *s3.DeleteObjectInput, optFns ...func(*s3.Options)) (*s3.DeleteObjectOutput, error)
 	ListObjectVersions(ctx context.Context, params *s3.ListObjectVersionsInput, optFns ...func(*s3.Options)) (*s3.ListObjectVersionsOutput, error)
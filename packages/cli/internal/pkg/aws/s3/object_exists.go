@@ -0,0 +1,28 @@
+package s3
+
+import (
+	"context"
+	"errors"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+	"github.com/aws/aws-sdk-go-v2/service/s3/types"
+)
+
+// ObjectExists heads the object with the credentials of the client. It returns false when the object does not exist and
+// an error when the object cannot be accessed. The requester is charged for the request in requester pays buckets.
+func (c *Client) ObjectExists(bucketName, key string, requesterPays bool) (bool, error) {
+	input := &s3.HeadObjectInput{Bucket: aws.String(bucketName), Key: aws.String(key)}
+	if requesterPays {
+		input.RequestPayer = types.RequestPayerRequester
+	}
+	_, err := c.s3.HeadObject(context.Background(), input)
+	if err != nil {
+		var notFound *types.NotFound
+		if errors.As(err, &notFound) {
+			return false, nil
+		}
+		return false, err
+	}
+	return true, nil
+}
@@ -0,0 +1,50 @@
+package s3
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+	"github.com/aws/aws-sdk-go-v2/service/s3/types"
+	"github.com/stretchr/testify/assert"
+)
+
+const testObjectKey = "inputs/sample.bam"
+
+func TestClient_ObjectExists_WithExists(t *testing.T) {
+	client := NewMockClient()
+	client.s3.(*S3Mock).On("HeadObject", context.Background(), &s3.HeadObjectInput{Bucket: aws.String(testBucketName), Key: aws.String(testObjectKey)}).
+		Return(&s3.HeadObjectOutput{}, nil)
+	exists, err := client.ObjectExists(testBucketName, testObjectKey, false)
+	assert.NoError(t, err)
+	assert.True(t, exists)
+}
+
+func TestClient_ObjectExists_WithRequesterPays(t *testing.T) {
+	client := NewMockClient()
+	client.s3.(*S3Mock).On("HeadObject", context.Background(), &s3.HeadObjectInput{Bucket: aws.String(testBucketName), Key: aws.String(testObjectKey), RequestPayer: types.RequestPayerRequester}).
+		Return(&s3.HeadObjectOutput{}, nil)
+	exists, err := client.ObjectExists(testBucketName, testObjectKey, true)
+	assert.NoError(t, err)
+	assert.True(t, exists)
+}
+
+func TestClient_ObjectExists_WithNotExists(t *testing.T) {
+	client := NewMockClient()
+	client.s3.(*S3Mock).On("HeadObject", context.Background(), &s3.HeadObjectInput{Bucket: aws.String(testBucketName), Key: aws.String(testObjectKey)}).
+		Return(nil, &types.NotFound{Message: &testErrorMessage})
+	exists, err := client.ObjectExists(testBucketName, testObjectKey, false)
+	assert.NoError(t, err)
+	assert.False(t, exists)
+}
+
+func TestClient_ObjectExists_WithForbidden(t *testing.T) {
+	client := NewMockClient()
+	client.s3.(*S3Mock).On("HeadObject", context.Background(), &s3.HeadObjectInput{Bucket: aws.String(testBucketName), Key: aws.String(testObjectKey)}).
+		Return(nil, fmt.Errorf(testErrorMessage))
+	exists, err := client.ObjectExists(testBucketName, testObjectKey, false)
+	assert.EqualError(t, err, testErrorMessage)
+	assert.False(t, exists)
+}
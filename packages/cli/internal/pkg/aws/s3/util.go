@@ -3,10 +3,14 @@ package s3
 import (
 	"fmt"
 	"net/url"
+	"regexp"
+	"strings"
 )
 
 const s3Scheme = "s3"
 
+var bucketNameRegexp = regexp.MustCompile(`^[a-z0-9][a-z0-9.-]{1,61}[a-z0-9]$`)
+
 func IsS3Uri(value string) bool {
 	urlParts, err := url.Parse(value)
 	if err != nil {
@@ -15,6 +19,25 @@ func IsS3Uri(value string) bool {
 	return s3Scheme == urlParts.Scheme
 }
 
+// ValidateS3Uri returns an error unless the value is an S3 URI, such as 's3://bucket/prefix', with a valid bucket name
+func ValidateS3Uri(value string) error {
+	urlParts, err := url.Parse(value)
+	if err != nil {
+		return fmt.Errorf("'%s' is not a valid URI: %w", value, err)
+	}
+	if s3Scheme != urlParts.Scheme {
+		return fmt.Errorf("'%s' is not an S3 URI, it must start with 's3://'", value)
+	}
+	bucketName := urlParts.Host
+	if !bucketNameRegexp.MatchString(bucketName) || strings.Contains(bucketName, "..") {
+		return fmt.Errorf("'%s' does not name a valid S3 bucket", value)
+	}
+	if urlParts.RawQuery != "" || urlParts.Fragment != "" || urlParts.User != nil {
+		return fmt.Errorf("'%s' is not an S3 URI, it must not contain credentials, a query or a fragment", value)
+	}
+	return nil
+}
+
 func UriToArn(uri string) (string, error) {
 	urlParts, err := url.Parse(uri)
 	if err != nil {
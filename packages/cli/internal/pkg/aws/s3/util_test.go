@@ -24,6 +24,17 @@ func TestIsS3Uri_WithBadUri(t *testing.T) {
 	assert.False(t, IsS3Uri(string(rune(0x7f))))
 }
 
+func TestValidateS3Uri(t *testing.T) {
+	assert.NoError(t, ValidateS3Uri("s3://my-bucket"))
+	assert.NoError(t, ValidateS3Uri("s3://my.bucket/path/to/data/"))
+	assert.Error(t, ValidateS3Uri("https://my-bucket/path"))
+	assert.Error(t, ValidateS3Uri("my-bucket/path"))
+	assert.Error(t, ValidateS3Uri("s3://My_Bucket/path"))
+	assert.Error(t, ValidateS3Uri("s3://my..bucket/path"))
+	assert.Error(t, ValidateS3Uri("s3:///path"))
+	assert.Error(t, ValidateS3Uri("s3://my-bucket/path?versionId=1"))
+}
+
 func TestUriToArn_Success(t *testing.T) {
 	arn, err := UriToArn(testS3Uri)
 	assert.NoError(t, err)
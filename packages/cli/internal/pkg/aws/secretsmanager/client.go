@@ -0,0 +1,16 @@
+package secretsmanager
+
+import (
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/secretsmanager"
+)
+
+type Client struct {
+	secretsManager secretsManagerInterface
+}
+
+func New(cfg aws.Config) *Client {
+	return &Client{
+		secretsManager: secretsmanager.NewFromConfig(cfg),
+	}
+}
@@ -0,0 +1,21 @@
+package secretsmanager
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/service/secretsmanager"
+	"github.com/stretchr/testify/mock"
+)
+
+type secretsManagerMockClient struct {
+	mock.Mock
+}
+
+func (m *secretsManagerMockClient) DescribeSecret(ctx context.Context, input *secretsmanager.DescribeSecretInput, _ ...func(*secretsmanager.Options)) (*secretsmanager.DescribeSecretOutput, error) {
+	args := m.Called(ctx, input)
+	output := args.Get(0)
+	if output != nil {
+		return output.(*secretsmanager.DescribeSecretOutput), args.Error(1)
+	}
+	return nil, args.Error(1)
+}
@@ -0,0 +1,48 @@
+package secretsmanager
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/secretsmanager"
+	"github.com/aws/aws-sdk-go-v2/service/secretsmanager/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const testSecretId = "my-license-key"
+
+func TestClient_SecretExists_Exists(t *testing.T) {
+	mockClient := new(secretsManagerMockClient)
+	client := &Client{mockClient}
+	mockClient.On("DescribeSecret", context.Background(), &secretsmanager.DescribeSecretInput{SecretId: aws.String(testSecretId)}).
+		Return(&secretsmanager.DescribeSecretOutput{Name: aws.String(testSecretId)}, nil)
+
+	exists, err := client.SecretExists(testSecretId)
+	require.NoError(t, err)
+	assert.True(t, exists)
+}
+
+func TestClient_SecretExists_NotFound(t *testing.T) {
+	mockClient := new(secretsManagerMockClient)
+	client := &Client{mockClient}
+	mockClient.On("DescribeSecret", context.Background(), &secretsmanager.DescribeSecretInput{SecretId: aws.String(testSecretId)}).
+		Return(nil, &types.ResourceNotFoundException{})
+
+	exists, err := client.SecretExists(testSecretId)
+	require.NoError(t, err)
+	assert.False(t, exists)
+}
+
+func TestClient_SecretExists_Error(t *testing.T) {
+	mockClient := new(secretsManagerMockClient)
+	client := &Client{mockClient}
+	mockClient.On("DescribeSecret", context.Background(), &secretsmanager.DescribeSecretInput{SecretId: aws.String(testSecretId)}).
+		Return(nil, errors.New("some error"))
+
+	exists, err := client.SecretExists(testSecretId)
+	assert.Error(t, err)
+	assert.False(t, exists)
+}
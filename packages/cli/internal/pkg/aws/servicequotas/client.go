@@ -0,0 +1,16 @@
+package servicequotas
+
+import (
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/servicequotas"
+)
+
+type Client struct {
+	serviceQuotas serviceQuotasInterface
+}
+
+func New(cfg aws.Config) *Client {
+	return &Client{
+		serviceQuotas: servicequotas.NewFromConfig(cfg),
+	}
+}
@@ -0,0 +1,30 @@
+package servicequotas
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/service/servicequotas"
+	"github.com/stretchr/testify/mock"
+)
+
+type serviceQuotasMockClient struct {
+	mock.Mock
+}
+
+func (m *serviceQuotasMockClient) GetServiceQuota(ctx context.Context, input *servicequotas.GetServiceQuotaInput, _ ...func(*servicequotas.Options)) (*servicequotas.GetServiceQuotaOutput, error) {
+	args := m.Called(ctx, input)
+	output := args.Get(0)
+	if output != nil {
+		return output.(*servicequotas.GetServiceQuotaOutput), args.Error(1)
+	}
+	return nil, args.Error(1)
+}
+
+func (m *serviceQuotasMockClient) GetAWSDefaultServiceQuota(ctx context.Context, input *servicequotas.GetAWSDefaultServiceQuotaInput, _ ...func(*servicequotas.Options)) (*servicequotas.GetAWSDefaultServiceQuotaOutput, error) {
+	args := m.Called(ctx, input)
+	output := args.Get(0)
+	if output != nil {
+		return output.(*servicequotas.GetAWSDefaultServiceQuotaOutput), args.Error(1)
+	}
+	return nil, args.Error(1)
+}
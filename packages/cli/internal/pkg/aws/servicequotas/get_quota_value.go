@@ -0,0 +1,44 @@
+package servicequotas
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/aws/amazon-genomics-cli/internal/pkg/cli/clierror/actionableerror"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/servicequotas"
+	"github.com/aws/aws-sdk-go-v2/service/servicequotas/types"
+)
+
+// GetQuotaValue returns the applied value of a quota, falling back to the AWS default
+// when the quota has never been adjusted for the account
+func (c *Client) GetQuotaValue(serviceCode, quotaCode string) (float64, error) {
+	output, err := c.serviceQuotas.GetServiceQuota(context.Background(), &servicequotas.GetServiceQuotaInput{
+		ServiceCode: aws.String(serviceCode),
+		QuotaCode:   aws.String(quotaCode),
+	})
+	if err == nil {
+		return quotaValue(output.Quota, serviceCode, quotaCode)
+	}
+	var noSuchResource *types.NoSuchResourceException
+	if !errors.As(err, &noSuchResource) {
+		return 0, actionableerror.FindSuggestionForError(err, actionableerror.AwsErrorMessageToSuggestedActionMap)
+	}
+
+	defaultOutput, err := c.serviceQuotas.GetAWSDefaultServiceQuota(context.Background(), &servicequotas.GetAWSDefaultServiceQuotaInput{
+		ServiceCode: aws.String(serviceCode),
+		QuotaCode:   aws.String(quotaCode),
+	})
+	if err != nil {
+		return 0, actionableerror.FindSuggestionForError(err, actionableerror.AwsErrorMessageToSuggestedActionMap)
+	}
+	return quotaValue(defaultOutput.Quota, serviceCode, quotaCode)
+}
+
+func quotaValue(quota *types.ServiceQuota, serviceCode, quotaCode string) (float64, error) {
+	if quota == nil || quota.Value == nil {
+		return 0, fmt.Errorf("no value returned for quota '%s' of service '%s'", quotaCode, serviceCode)
+	}
+	return *quota.Value, nil
+}
@@ -0,0 +1,75 @@
+package servicequotas
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/servicequotas"
+	"github.com/aws/aws-sdk-go-v2/service/servicequotas/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	testServiceCode = "vpc"
+	testQuotaCode   = "L-F678F1CE"
+)
+
+var (
+	testGetServiceQuotaInput = &servicequotas.GetServiceQuotaInput{
+		ServiceCode: aws.String(testServiceCode),
+		QuotaCode:   aws.String(testQuotaCode),
+	}
+	testGetDefaultServiceQuotaInput = &servicequotas.GetAWSDefaultServiceQuotaInput{
+		ServiceCode: aws.String(testServiceCode),
+		QuotaCode:   aws.String(testQuotaCode),
+	}
+)
+
+func TestClient_GetQuotaValue_Applied(t *testing.T) {
+	mockClient := new(serviceQuotasMockClient)
+	client := &Client{mockClient}
+	mockClient.On("GetServiceQuota", context.Background(), testGetServiceQuotaInput).
+		Return(&servicequotas.GetServiceQuotaOutput{Quota: &types.ServiceQuota{Value: aws.Float64(10)}}, nil)
+
+	value, err := client.GetQuotaValue(testServiceCode, testQuotaCode)
+	require.NoError(t, err)
+	assert.Equal(t, float64(10), value)
+	mockClient.AssertExpectations(t)
+}
+
+func TestClient_GetQuotaValue_FallsBackToDefault(t *testing.T) {
+	mockClient := new(serviceQuotasMockClient)
+	client := &Client{mockClient}
+	mockClient.On("GetServiceQuota", context.Background(), testGetServiceQuotaInput).
+		Return(nil, &types.NoSuchResourceException{})
+	mockClient.On("GetAWSDefaultServiceQuota", context.Background(), testGetDefaultServiceQuotaInput).
+		Return(&servicequotas.GetAWSDefaultServiceQuotaOutput{Quota: &types.ServiceQuota{Value: aws.Float64(5)}}, nil)
+
+	value, err := client.GetQuotaValue(testServiceCode, testQuotaCode)
+	require.NoError(t, err)
+	assert.Equal(t, float64(5), value)
+	mockClient.AssertExpectations(t)
+}
+
+func TestClient_GetQuotaValue_Error(t *testing.T) {
+	mockClient := new(serviceQuotasMockClient)
+	client := &Client{mockClient}
+	mockClient.On("GetServiceQuota", context.Background(), testGetServiceQuotaInput).
+		Return(nil, errors.New("some error"))
+
+	_, err := client.GetQuotaValue(testServiceCode, testQuotaCode)
+	assert.Error(t, err)
+}
+
+func TestClient_GetQuotaValue_MissingValue(t *testing.T) {
+	mockClient := new(serviceQuotasMockClient)
+	client := &Client{mockClient}
+	mockClient.On("GetServiceQuota", context.Background(), testGetServiceQuotaInput).
+		Return(&servicequotas.GetServiceQuotaOutput{Quota: &types.ServiceQuota{}}, nil)
+
+	_, err := client.GetQuotaValue(testServiceCode, testQuotaCode)
+	assert.Error(t, err)
+}
@@ -10,6 +10,7 @@ type Interface interface {
 	GetOutputBucket() (string, error)
 	GetCommonParameter(parameterSuffix string) (string, error)
 	GetCustomTags() string
+	ParameterExists(parameterName string) (bool, error)
 }
 
 type ssmInterface interface {
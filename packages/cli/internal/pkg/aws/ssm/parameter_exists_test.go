@@ -0,0 +1,48 @@
+package ssm
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ssm"
+	"github.com/aws/aws-sdk-go-v2/service/ssm/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const testParameterName = "/my/license/server"
+
+func TestClient_ParameterExists_Exists(t *testing.T) {
+	mockSsm := new(ssmMockClient)
+	client := &Client{mockSsm}
+	mockSsm.On("GetParameter", context.Background(), &ssm.GetParameterInput{Name: aws.String(testParameterName)}).
+		Return(&ssm.GetParameterOutput{Parameter: &types.Parameter{Value: aws.String("value")}}, nil)
+
+	exists, err := client.ParameterExists(testParameterName)
+	require.NoError(t, err)
+	assert.True(t, exists)
+}
+
+func TestClient_ParameterExists_NotFound(t *testing.T) {
+	mockSsm := new(ssmMockClient)
+	client := &Client{mockSsm}
+	mockSsm.On("GetParameter", context.Background(), &ssm.GetParameterInput{Name: aws.String(testParameterName)}).
+		Return((*ssm.GetParameterOutput)(nil), &types.ParameterNotFound{})
+
+	exists, err := client.ParameterExists(testParameterName)
+	require.NoError(t, err)
+	assert.False(t, exists)
+}
+
+func TestClient_ParameterExists_Error(t *testing.T) {
+	mockSsm := new(ssmMockClient)
+	client := &Client{mockSsm}
+	mockSsm.On("GetParameter", context.Background(), &ssm.GetParameterInput{Name: aws.String(testParameterName)}).
+		Return((*ssm.GetParameterOutput)(nil), errors.New("some error"))
+
+	exists, err := client.ParameterExists(testParameterName)
+	assert.Error(t, err)
+	assert.False(t, exists)
+}
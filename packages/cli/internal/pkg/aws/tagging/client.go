@@ -0,0 +1,16 @@
+package tagging
+
+import (
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/resourcegroupstaggingapi"
+)
+
+type Client struct {
+	tagging taggingInterface
+}
+
+func New(cfg aws.Config) *Client {
+	return &Client{
+		tagging: resourcegroupstaggingapi.NewFromConfig(cfg),
+	}
+}
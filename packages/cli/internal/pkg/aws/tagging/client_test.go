@@ -0,0 +1,29 @@
+package tagging
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/service/resourcegroupstaggingapi"
+	"github.com/stretchr/testify/mock"
+)
+
+type TaggingMock struct {
+	mock.Mock
+}
+
+func NewMockClient() *Client {
+	return &Client{
+		tagging: new(TaggingMock),
+	}
+}
+
+func (m *TaggingMock) GetResources(ctx context.Context, input *resourcegroupstaggingapi.GetResourcesInput, _ ...func(*resourcegroupstaggingapi.Options)) (*resourcegroupstaggingapi.GetResourcesOutput, error) {
+	args := m.Called(ctx, input)
+	output := args.Get(0)
+	err := args.Error(1)
+
+	if output != nil {
+		return output.(*resourcegroupstaggingapi.GetResourcesOutput), err
+	}
+	return nil, err
+}
@@ -0,0 +1,64 @@
+package tagging
+
+import (
+	"context"
+	"strings"
+
+	"github.com/aws/amazon-genomics-cli/internal/pkg/cli/clierror/actionableerror"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/resourcegroupstaggingapi"
+	"github.com/aws/aws-sdk-go-v2/service/resourcegroupstaggingapi/types"
+)
+
+// Resource is a tagged resource identified by its ARN. Service, Type and Id are parsed from the ARN,
+// e.g. 'arn:aws:elasticfilesystem:us-east-1:111122223333:file-system/fs-0123' has service
+// 'elasticfilesystem', type 'file-system' and id 'fs-0123'.
+type Resource struct {
+	Arn     string
+	Service string
+	Type    string
+	Id      string
+	Tags    map[string]string
+}
+
+// GetTaggedResources lists the resources in the client's region that carry the given tag
+func (c *Client) GetTaggedResources(tagKey, tagValue string) ([]Resource, error) {
+	input := &resourcegroupstaggingapi.GetResourcesInput{
+		TagFilters: []types.TagFilter{{Key: aws.String(tagKey), Values: []string{tagValue}}},
+	}
+	paginator := resourcegroupstaggingapi.NewGetResourcesPaginator(c.tagging, input)
+	var resources []Resource
+	for paginator.HasMorePages() {
+		output, err := paginator.NextPage(context.Background())
+		if err != nil {
+			return nil, actionableerror.FindSuggestionForError(err, actionableerror.AwsErrorMessageToSuggestedActionMap)
+		}
+		for _, mapping := range output.ResourceTagMappingList {
+			resource := parseArn(aws.ToString(mapping.ResourceARN))
+			resource.Tags = make(map[string]string, len(mapping.Tags))
+			for _, tag := range mapping.Tags {
+				resource.Tags[aws.ToString(tag.Key)] = aws.ToString(tag.Value)
+			}
+			resources = append(resources, resource)
+		}
+	}
+	return resources, nil
+}
+
+func parseArn(arn string) Resource {
+	resource := Resource{Arn: arn}
+	// arn:partition:service:region:account-id:resource
+	parts := strings.SplitN(arn, ":", 6)
+	if len(parts) < 6 {
+		return resource
+	}
+	resource.Service = parts[2]
+	resourcePart := parts[5]
+	if separator := strings.IndexAny(resourcePart, "/:"); separator >= 0 {
+		resource.Type = resourcePart[:separator]
+		resource.Id = resourcePart[separator+1:]
+	} else {
+		resource.Id = resourcePart
+	}
+	return resource
+}
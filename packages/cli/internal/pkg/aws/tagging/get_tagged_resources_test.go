@@ -0,0 +1,58 @@
+package tagging
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/resourcegroupstaggingapi"
+	"github.com/aws/aws-sdk-go-v2/service/resourcegroupstaggingapi/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+const (
+	testTagKey   = "application-name"
+	testTagValue = "agc"
+)
+
+func TestClient_GetTaggedResources(t *testing.T) {
+	client := NewMockClient()
+	input := &resourcegroupstaggingapi.GetResourcesInput{
+		TagFilters: []types.TagFilter{{Key: aws.String(testTagKey), Values: []string{testTagValue}}},
+	}
+	client.tagging.(*TaggingMock).On("GetResources", context.Background(), input).Return(&resourcegroupstaggingapi.GetResourcesOutput{
+		ResourceTagMappingList: []types.ResourceTagMapping{
+			{ResourceARN: aws.String("arn:aws:s3:::agc-111122223333-us-east-1"), Tags: []types.Tag{{Key: aws.String(testTagKey), Value: aws.String(testTagValue)}}},
+			{ResourceARN: aws.String("arn:aws:logs:us-east-1:111122223333:log-group:/aws/batch/job")},
+		},
+		PaginationToken: aws.String("next"),
+	}, nil).Once()
+	client.tagging.(*TaggingMock).On("GetResources", context.Background(), mock.MatchedBy(func(in *resourcegroupstaggingapi.GetResourcesInput) bool {
+		return aws.ToString(in.PaginationToken) == "next"
+	})).Return(&resourcegroupstaggingapi.GetResourcesOutput{
+		ResourceTagMappingList: []types.ResourceTagMapping{
+			{ResourceARN: aws.String("arn:aws:elasticfilesystem:us-east-1:111122223333:file-system/fs-0123")},
+		},
+	}, nil).Once()
+
+	resources, err := client.GetTaggedResources(testTagKey, testTagValue)
+
+	assert.NoError(t, err)
+	assert.Equal(t, []Resource{
+		{Arn: "arn:aws:s3:::agc-111122223333-us-east-1", Service: "s3", Id: "agc-111122223333-us-east-1", Tags: map[string]string{testTagKey: testTagValue}},
+		{Arn: "arn:aws:logs:us-east-1:111122223333:log-group:/aws/batch/job", Service: "logs", Type: "log-group", Id: "/aws/batch/job", Tags: map[string]string{}},
+		{Arn: "arn:aws:elasticfilesystem:us-east-1:111122223333:file-system/fs-0123", Service: "elasticfilesystem", Type: "file-system", Id: "fs-0123", Tags: map[string]string{}},
+	}, resources)
+}
+
+func TestClient_GetTaggedResources_Error(t *testing.T) {
+	client := NewMockClient()
+	client.tagging.(*TaggingMock).On("GetResources", context.Background(), mock.Anything).Return(nil, fmt.Errorf("some tagging error"))
+
+	_, err := client.GetTaggedResources(testTagKey, testTagValue)
+
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "some tagging error")
+}
@@ -0,0 +1,11 @@
+package tagging
+
+import "github.com/aws/aws-sdk-go-v2/service/resourcegroupstaggingapi"
+
+type Interface interface {
+	GetTaggedResources(tagKey, tagValue string) ([]Resource, error)
+}
+
+type taggingInterface interface {
+	resourcegroupstaggingapi.GetResourcesAPIClient
+}
@@ -22,6 +22,9 @@ These commands should be used to install or remove AGC from your AWS account.`,
 
 	cmd.AddCommand(BuildAccountActivateCommand())
 	cmd.AddCommand(BuildAccountDeactivateCommand())
+	cmd.AddCommand(BuildAccountDoctorCommand())
+	cmd.AddCommand(BuildAccountDescribeCommand())
+	cmd.AddCommand(BuildAccountUpgradeCommand())
 
 	cmd.SetUsageTemplate(template.Usage)
 	cmd.Annotations = map[string]string{
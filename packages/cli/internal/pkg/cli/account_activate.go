@@ -16,6 +16,7 @@ import (
 	"github.com/aws/amazon-genomics-cli/internal/pkg/aws/sts"
 	"github.com/aws/amazon-genomics-cli/internal/pkg/cli/clierror"
 	"github.com/aws/amazon-genomics-cli/internal/pkg/cli/clierror/actionableerror"
+	"github.com/aws/amazon-genomics-cli/internal/pkg/cli/format"
 	"github.com/aws/amazon-genomics-cli/internal/pkg/constants"
 	"github.com/aws/amazon-genomics-cli/internal/pkg/logging"
 	"github.com/aws/amazon-genomics-cli/internal/pkg/osutils"
@@ -69,20 +70,34 @@ type accountActivateOpts struct {
 	ecrClient ecr.Interface
 	imageRefs map[string]ecr.ImageReference
 	region    string
+	doctor    *accountDoctorOpts
 }
 
 func newAccountActivateOpts(vars accountActivateVars) (*accountActivateOpts, error) {
+	doctor, err := newAccountDoctorOpts(accountDoctorVars{
+		bucketName:    vars.bucketName,
+		vpcId:         vars.vpcId,
+		publicSubnets: vars.publicSubnets,
+		subnets:       vars.subnets,
+	})
+	if err != nil {
+		return nil, err
+	}
 	return &accountActivateOpts{
 		accountActivateVars: vars,
 		stsClient:           aws.StsClient(profile),
 		s3Client:            aws.S3Client(profile),
 		cdkClient:           cdk.NewClient(profile),
 		region:              aws.Region(profile),
+		doctor:              doctor,
 	}, nil
 }
 
 // Execute activates AGC.
 func (o *accountActivateOpts) Execute() error {
+	if err := o.runPreflightChecks(); err != nil {
+		return err
+	}
 
 	environmentVars, err := o.generateEnvVars()
 	if err != nil {
@@ -103,6 +118,18 @@ func (o *accountActivateOpts) Execute() error {
 	return o.deployCoreInfrastructure(cdkAppPath, environmentVars)
 }
 
+func (o accountActivateOpts) runPreflightChecks() error {
+	if o.doctor == nil {
+		return nil
+	}
+	checks, err := o.doctor.Execute()
+	if err != nil {
+		format.Default.Write(checks)
+		return err
+	}
+	return nil
+}
+
 func (o accountActivateOpts) generateDefaultBucket() (string, error) {
 	account, err := o.stsClient.GetAccount()
 	if err != nil {
@@ -215,7 +242,8 @@ func BuildAccountActivateCommand() *cobra.Command {
 		Short: "Activate AGC in an AWS account.",
 		Long: `Activate AGC in an AWS account.
 AGC will use your default AWS credentials to deploy all AWS resources
-it needs to that account and region.`,
+it needs to that account and region. The checks of 'agc account doctor'
+are run first and activation stops if any of them fail.`,
 		Example: `
 Activate AGC in your AWS account with a custom S3 bucket and VPC.
 /code $ agc account activate --bucket my-custom-bucket --vpc my-vpc-id`,
@@ -338,3 +338,25 @@ func Test_accountActivateOpts_validate(t *testing.T) {
 		})
 	}
 }
+
+func TestAccountActivateOpts_Execute_PreflightChecksFail(t *testing.T) {
+	mocks := createMocks(t)
+	defer mocks.ctrl.Finish()
+	mocks.stsMock.EXPECT().GetAccount().Return("", fmt.Errorf("some account error"))
+	opts := &accountActivateOpts{
+		accountActivateVars: accountActivateVars{bucketName: testAccountBucketName},
+		stsClient:           mocks.stsMock,
+		s3Client:            mocks.s3Mock,
+		cdkClient:           mocks.cdkMock,
+		region:              testAccountRegion,
+		doctor: &accountDoctorOpts{
+			accountDoctorVars: accountDoctorVars{bucketName: testAccountBucketName},
+			stsClient:         mocks.stsMock,
+			region:            testAccountRegion,
+		},
+	}
+
+	err := opts.Execute()
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "1 account readiness check(s) failed")
+}
@@ -7,14 +7,22 @@ import (
 	"errors"
 	"fmt"
 	"regexp"
+	"sort"
+	"strings"
 
 	"github.com/aws/amazon-genomics-cli/internal/pkg/aws"
 	"github.com/aws/amazon-genomics-cli/internal/pkg/aws/cfn"
+	"github.com/aws/amazon-genomics-cli/internal/pkg/aws/cwl"
+	"github.com/aws/amazon-genomics-cli/internal/pkg/aws/ecr"
+	"github.com/aws/amazon-genomics-cli/internal/pkg/aws/efs"
 	"github.com/aws/amazon-genomics-cli/internal/pkg/aws/s3"
 	"github.com/aws/amazon-genomics-cli/internal/pkg/aws/sts"
+	"github.com/aws/amazon-genomics-cli/internal/pkg/aws/tagging"
 	"github.com/aws/amazon-genomics-cli/internal/pkg/cli/awsresources"
 	"github.com/aws/amazon-genomics-cli/internal/pkg/cli/clierror"
 	"github.com/aws/amazon-genomics-cli/internal/pkg/cli/clierror/actionableerror"
+	"github.com/aws/amazon-genomics-cli/internal/pkg/cli/format"
+	"github.com/aws/amazon-genomics-cli/internal/pkg/cli/types"
 	"github.com/aws/amazon-genomics-cli/internal/pkg/constants"
 	"github.com/rs/zerolog/log"
 	"github.com/spf13/cobra"
@@ -25,21 +33,41 @@ const (
 	deactivateForceShortFlag       = "f"
 	deactivateForceFlagDescription = `Force account deactivation by removing all resources associated with AGC.
 This includes project and context resources, even if they are running workflows.
-If not specified, only the core resources will be deleted if possible.`
-	accountDeactivateCommand = "account deactivate"
+If not specified, only the core resources will be deleted if possible.
+Buckets, log groups, file systems and repositories tagged by AGC are deleted as well unless --retain-data is specified.`
+	deactivateRetainDataFlag            = "retain-data"
+	deactivateRetainDataFlagDescription = `Keep buckets, log groups, file systems and repositories tagged by AGC when deactivating with --force.`
+	accountDeactivateCommand            = "account deactivate"
+
+	resourceTypeStack      = "STACK"
+	resourceTypeBucket     = "BUCKET"
+	resourceTypeLogGroup   = "LOG_GROUP"
+	resourceTypeFileSystem = "FILE_SYSTEM"
+	resourceTypeRepository = "REPOSITORY"
+
+	resourceActionDelete = "DELETE"
+	resourceActionRetain = "RETAIN"
+
+	remainingResourcesErrorFmt = "%d resource(s) are still present after deactivation"
 )
 
 type accountDeactivateVars struct {
-	force bool
+	force      bool
+	retainData bool
 }
 
 type accountDeactivateOpts struct {
 	accountDeactivateVars
-	stacks    []cfn.Stack
-	cfnClient cfn.Interface
-	s3Client  s3.Interface
-	stsClient sts.Interface
-	region    string
+	stacks        []cfn.Stack
+	resources     []types.AccountResource
+	cfnClient     cfn.Interface
+	s3Client      s3.Interface
+	stsClient     sts.Interface
+	cwlClient     cwl.Interface
+	efsClient     efs.Interface
+	ecrClient     ecr.Interface
+	taggingClient tagging.Interface
+	region        string
 }
 
 func newAccountDeactivateOpts(vars accountDeactivateVars) (*accountDeactivateOpts, error) {
@@ -48,9 +76,14 @@ func newAccountDeactivateOpts(vars accountDeactivateVars) (*accountDeactivateOpt
 		cfnClient:             aws.CfnClient(profile),
 		s3Client:              aws.S3Client(profile),
 		stsClient:             aws.StsClient(profile),
+		cwlClient:             aws.CwlClient(profile),
+		efsClient:             aws.EfsClient(profile),
+		ecrClient:             aws.EcrClient(profile),
+		taggingClient:         aws.TaggingClient(profile),
 		region:                aws.Region(profile),
 	}, nil
 }
+
 func (o *accountDeactivateOpts) LoadStacks() error {
 	stacks, err := o.getApplicationStacks()
 	if err != nil {
@@ -60,6 +93,118 @@ func (o *accountDeactivateOpts) LoadStacks() error {
 	return nil
 }
 
+// LoadResources builds the inventory of everything AGC has tagged in the region and decides what
+// deactivation will delete or retain. It must be called after LoadStacks.
+func (o *accountDeactivateOpts) LoadResources() error {
+	stackNames := make(map[string]bool, len(o.stacks))
+	o.resources = nil
+	for _, stack := range o.stacks {
+		stackNames[stack.Name] = true
+		o.resources = append(o.resources, types.AccountResource{
+			Type:   resourceTypeStack,
+			Name:   stack.Name,
+			Action: resourceActionDelete,
+			Reason: "AGC stack",
+		})
+	}
+
+	taggedResources, err := o.taggingClient.GetTaggedResources(constants.AppTagKey, constants.AppTagValue)
+	if err != nil {
+		return err
+	}
+	var dataResources []types.AccountResource
+	for _, taggedResource := range taggedResources {
+		resource, ok := o.classifyResource(taggedResource, stackNames)
+		if ok {
+			dataResources = append(dataResources, resource)
+		}
+	}
+	sort.SliceStable(dataResources, func(i, j int) bool {
+		if dataResources[i].Type != dataResources[j].Type {
+			return dataResources[i].Type < dataResources[j].Type
+		}
+		return dataResources[i].Name < dataResources[j].Name
+	})
+	o.resources = append(o.resources, dataResources...)
+	return nil
+}
+
+func (o *accountDeactivateOpts) classifyResource(resource tagging.Resource, stackNames map[string]bool) (types.AccountResource, bool) {
+	owningStack := resource.Tags[cfnStackNameTagKey]
+	var resourceType string
+	switch {
+	case resource.Service == "cloudformation":
+		// stacks are inventoried from CloudFormation directly
+		return types.AccountResource{}, false
+	case resource.Service == "s3" && resource.Type == "":
+		resourceType = resourceTypeBucket
+	case resource.Service == "logs" && resource.Type == "log-group":
+		resourceType = resourceTypeLogGroup
+	case resource.Service == "elasticfilesystem" && resource.Type == "file-system":
+		resourceType = resourceTypeFileSystem
+	case resource.Service == "ecr" && resource.Type == "repository":
+		resourceType = resourceTypeRepository
+	}
+
+	if stackNames[owningStack] && !retainedByStack(resourceType) {
+		if resourceType == "" {
+			// removed together with the stack that owns it
+			return types.AccountResource{}, false
+		}
+		return types.AccountResource{
+			Type:   resourceType,
+			Name:   resourceName(resource),
+			Action: resourceActionDelete,
+			Reason: fmt.Sprintf("deleted with stack '%s'", owningStack),
+		}, true
+	}
+	if resourceType == "" {
+		otherType := resource.Service
+		if resource.Type != "" {
+			otherType = fmt.Sprintf("%s:%s", resource.Service, resource.Type)
+		}
+		return types.AccountResource{
+			Type:   otherType,
+			Name:   resource.Arn,
+			Action: resourceActionRetain,
+			Reason: "not managed by deactivation, delete it manually",
+		}, true
+	}
+
+	accountResource := types.AccountResource{Type: resourceType, Name: resourceName(resource)}
+	switch {
+	case resourceType == resourceTypeBucket && owningStack == awsresources.RenderBootstrapStackName():
+		accountResource.Action, accountResource.Reason = resourceActionDelete, "CDK bootstrap assets"
+	case o.retainData:
+		accountResource.Action, accountResource.Reason = resourceActionRetain, "--retain-data was specified"
+	case !o.force:
+		accountResource.Action, accountResource.Reason = resourceActionRetain, "data is only deleted with --force"
+	default:
+		accountResource.Action, accountResource.Reason = resourceActionDelete, "--force was specified"
+	}
+	return accountResource, true
+}
+
+// retainedByStack tells whether resources of a type outlive the deletion of the stack that created them.
+// AGC stacks create their buckets and log groups with the default RETAIN removal policy and their
+// file systems with the DESTROY removal policy. Repositories are created by engines, not by stacks.
+func retainedByStack(resourceType string) bool {
+	return resourceType == resourceTypeBucket || resourceType == resourceTypeLogGroup || resourceType == resourceTypeRepository
+}
+
+func resourceName(resource tagging.Resource) string {
+	if resource.Service == "logs" {
+		// log group ARNs may carry a trailing ':*' stream wildcard
+		return strings.TrimSuffix(resource.Id, ":*")
+	}
+	return resource.Id
+}
+
+// Resources returns the inventory built by LoadResources
+func (o *accountDeactivateOpts) Resources() []types.AccountResource {
+	return o.resources
+}
+
 func (o *accountDeactivateOpts) Validate() error {
 	// core and bootstrap stacks are expected
 	if !o.force && len(o.stacks) > 2 {
@@ -114,9 +259,101 @@ func (o *accountDeactivateOpts) Execute() error {
 		return fmt.Errorf("failed to delete bootstrap asset bucket: %w", err)
 	}
 
+	o.removeDataResources()
+	return nil
+}
+
+// removeDataResources deletes the non-stack resources marked for deletion. Failures are logged rather than
+// returned so that every resource gets a chance to be removed; Verify reports whatever is left behind.
+func (o *accountDeactivateOpts) removeDataResources() {
+	for _, resource := range o.resources {
+		if resource.Type == resourceTypeStack || resource.Action != resourceActionDelete {
+			continue
+		}
+		log.Debug().Msgf("Deleting %s '%s'", resource.Type, resource.Name)
+		if err := o.removeDataResource(resource); err != nil {
+			log.Warn().Msgf("Failed to delete %s '%s': %v", resource.Type, resource.Name, err)
+		}
+	}
+}
+
+func (o *accountDeactivateOpts) removeDataResource(resource types.AccountResource) error {
+	switch resource.Type {
+	case resourceTypeBucket:
+		exists, err := o.s3Client.BucketExists(resource.Name)
+		if err != nil || !exists {
+			return err
+		}
+		if err := o.s3Client.EmptyBucket(resource.Name); err != nil {
+			return err
+		}
+		return o.s3Client.DeleteBucket(resource.Name)
+	case resourceTypeLogGroup:
+		return o.cwlClient.DeleteLogGroup(resource.Name)
+	case resourceTypeFileSystem:
+		// file systems owned by a stack are already gone with it
+		exists, err := o.efsClient.FileSystemExists(resource.Name)
+		if err != nil || !exists {
+			return err
+		}
+		return o.efsClient.DeleteFileSystem(resource.Name)
+	case resourceTypeRepository:
+		return o.ecrClient.DeleteRepository(resource.Name)
+	}
 	return nil
 }
 
+// Verify checks that every resource marked for deletion is gone and returns those that are still present
+func (o *accountDeactivateOpts) Verify() ([]types.AccountResource, error) {
+	remainingStacks, err := o.getApplicationStacks()
+	if err != nil {
+		return nil, err
+	}
+	var remaining []types.AccountResource
+	for _, stack := range remainingStacks {
+		remaining = append(remaining, types.AccountResource{
+			Type:   resourceTypeStack,
+			Name:   stack.Name,
+			Action: resourceActionDelete,
+			Reason: "stack still exists",
+		})
+	}
+	for _, resource := range o.resources {
+		if resource.Type == resourceTypeStack || resource.Action != resourceActionDelete {
+			continue
+		}
+		exists, err := o.resourceExists(resource)
+		if err != nil {
+			return nil, err
+		}
+		if exists {
+			resource.Reason = fmt.Sprintf("%s still exists", strings.ToLower(strings.ReplaceAll(resource.Type, "_", " ")))
+			remaining = append(remaining, resource)
+		}
+	}
+	if len(remaining) > 0 {
+		return remaining, actionableerror.New(
+			fmt.Errorf(remainingResourcesErrorFmt, len(remaining)),
+			"run 'agc account deactivate --force' again or delete the remaining resources manually",
+		)
+	}
+	return nil, nil
+}
+
+func (o *accountDeactivateOpts) resourceExists(resource types.AccountResource) (bool, error) {
+	switch resource.Type {
+	case resourceTypeBucket:
+		return o.s3Client.BucketExists(resource.Name)
+	case resourceTypeLogGroup:
+		return o.cwlClient.LogGroupExists(resource.Name)
+	case resourceTypeFileSystem:
+		return o.efsClient.FileSystemExists(resource.Name)
+	case resourceTypeRepository:
+		return o.ecrClient.RepositoryExists(resource.Name)
+	}
+	return false, nil
+}
+
 func (o *accountDeactivateOpts) removeBootstrapBucket() error {
 	accountId, err := o.stsClient.GetAccount()
 	if err != nil {
@@ -169,10 +406,20 @@ func BuildAccountDeactivateCommand() *cobra.Command {
 		Long: `Deactivate AGC in an AWS account.
 AGC will use your default AWS credentials to remove all core AWS resources
 it has created in that account and region. Deactivation may take up to 5 minutes to complete and return.
-Buckets and logs will be preserved.`,
+Before deleting anything, AGC lists every resource it has tagged and whether it will be deleted or retained.
+Buckets, log groups, file systems and repositories are retained unless --force is specified,
+and with --force they are retained if --retain-data is specified. File systems created by a context
+are always deleted with the context stack.
+Once deactivation completes, AGC verifies that the deleted resources are gone and reports any that remain.
+
+Output of the command has following format:
+` + DescribeOutput(types.AccountResource{}),
 		Example: fmt.Sprintf(`
 Deactivate AGC in your AWS account.
-/code $ agc %s`, accountDeactivateCommand),
+/code $ agc %s
+
+Remove everything AGC created in your AWS account except for data.
+/code $ agc %s --force --retain-data`, accountDeactivateCommand, accountDeactivateCommand),
 		Args: cobra.NoArgs,
 		RunE: runCmdE(func(cmd *cobra.Command, args []string) error {
 			opts, err := newAccountDeactivateOpts(vars)
@@ -182,6 +429,10 @@ Deactivate AGC in your AWS account.
 			if err := opts.LoadStacks(); err != nil {
 				return clierror.New(accountDeactivateCommand, vars, err)
 			}
+			if err := opts.LoadResources(); err != nil {
+				return clierror.New(accountDeactivateCommand, vars, err)
+			}
+			format.Default.Write(opts.Resources())
 			if err := opts.Validate(); err != nil {
 				return clierror.New(accountDeactivateCommand, vars, err)
 			}
@@ -189,9 +440,18 @@ Deactivate AGC in your AWS account.
 			if err := opts.Execute(); err != nil {
 				return clierror.New(accountDeactivateCommand, vars, err)
 			}
+			remaining, err := opts.Verify()
+			if err != nil {
+				if len(remaining) > 0 {
+					format.Default.Write(remaining)
+				}
+				return clierror.New(accountDeactivateCommand, vars, err)
+			}
+			log.Info().Msgf("Verified that all deleted resources are gone.")
 			return nil
 		}),
 	}
 	cmd.Flags().BoolVarP(&vars.force, deactivateForceFlag, deactivateForceShortFlag, false, deactivateForceFlagDescription)
+	cmd.Flags().BoolVar(&vars.retainData, deactivateRetainDataFlag, false, deactivateRetainDataFlagDescription)
 	return cmd
 }
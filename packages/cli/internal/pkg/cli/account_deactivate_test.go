@@ -6,7 +6,10 @@ import (
 	"testing"
 
 	"github.com/aws/amazon-genomics-cli/internal/pkg/aws/cfn"
+	"github.com/aws/amazon-genomics-cli/internal/pkg/aws/tagging"
 	"github.com/aws/amazon-genomics-cli/internal/pkg/cli/awsresources"
+	"github.com/aws/amazon-genomics-cli/internal/pkg/cli/types"
+	"github.com/aws/amazon-genomics-cli/internal/pkg/constants"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -17,6 +20,12 @@ const (
 	testDeactivateStackId2   = "test-deactivate-stack-id-2"
 	testDeactivateStackName3 = "test-deactivate-stack-name-3"
 	testDeactivateStackId3   = "test-deactivate-stack-id-3"
+
+	testDeactivateBucketName        = "agc-test-account-id-test-account-region"
+	testDeactivateLogGroupName      = "/aws/batch/job"
+	testDeactivateFileSystemId      = "fs-0123456789abcdef0"
+	testDeactivateOwnedFileSystemId = "fs-0fedcba9876543210"
+	testDeactivateRepositoryName    = "agc/cache"
 )
 
 var (
@@ -90,6 +99,83 @@ func TestAccountDeactivateOpts_Load(t *testing.T) {
 		})
 	}
 }
+
+func TestAccountDeactivateOpts_LoadResources(t *testing.T) {
+	bootstrapBucketName := awsresources.RenderBootstrapAssetBucketName(testAccountId, testAccountRegion)
+	taggedResources := []tagging.Resource{
+		{Arn: "arn:aws:cloudformation:test-account-region:test-account-id:stack/test-deactivate-stack-name-1/id", Service: "cloudformation", Type: "stack", Id: "test-deactivate-stack-name-1/id"},
+		{Arn: "arn:aws:s3:::" + testDeactivateBucketName, Service: "s3", Id: testDeactivateBucketName},
+		{Arn: "arn:aws:s3:::" + bootstrapBucketName, Service: "s3", Id: bootstrapBucketName, Tags: map[string]string{cfnStackNameTagKey: awsresources.RenderBootstrapStackName()}},
+		{Arn: "arn:aws:logs:test-account-region:test-account-id:log-group:" + testDeactivateLogGroupName + ":*", Service: "logs", Type: "log-group", Id: testDeactivateLogGroupName + ":*"},
+		{Arn: "arn:aws:elasticfilesystem:test-account-region:test-account-id:file-system/" + testDeactivateFileSystemId, Service: "elasticfilesystem", Type: "file-system", Id: testDeactivateFileSystemId},
+		{Arn: "arn:aws:ecr:test-account-region:test-account-id:repository/" + testDeactivateRepositoryName, Service: "ecr", Type: "repository", Id: testDeactivateRepositoryName},
+		{Arn: "arn:aws:elasticfilesystem:test-account-region:test-account-id:file-system/" + testDeactivateOwnedFileSystemId, Service: "elasticfilesystem", Type: "file-system", Id: testDeactivateOwnedFileSystemId, Tags: map[string]string{cfnStackNameTagKey: testDeactivateStackName1}},
+		{Arn: "arn:aws:batch:test-account-region:test-account-id:job-queue/owned", Service: "batch", Type: "job-queue", Id: "owned", Tags: map[string]string{cfnStackNameTagKey: testDeactivateStackName1}},
+		{Arn: "arn:aws:sns:test-account-region:test-account-id:orphan", Service: "sns", Id: "orphan"},
+	}
+	stackResource := types.AccountResource{Type: resourceTypeStack, Name: testDeactivateStackName1, Action: resourceActionDelete, Reason: "AGC stack"}
+	bootstrapBucket := types.AccountResource{Type: resourceTypeBucket, Name: bootstrapBucketName, Action: resourceActionDelete, Reason: "CDK bootstrap assets"}
+	ownedFileSystem := types.AccountResource{Type: resourceTypeFileSystem, Name: testDeactivateOwnedFileSystemId, Action: resourceActionDelete, Reason: "deleted with stack 'test-deactivate-stack-name-1'"}
+	orphan := types.AccountResource{Type: "sns", Name: "arn:aws:sns:test-account-region:test-account-id:orphan", Action: resourceActionRetain, Reason: "not managed by deactivation, delete it manually"}
+	dataResources := func(action, reason string) []types.AccountResource {
+		return []types.AccountResource{
+			{Type: resourceTypeBucket, Name: testDeactivateBucketName, Action: action, Reason: reason},
+			{Type: resourceTypeFileSystem, Name: testDeactivateFileSystemId, Action: action, Reason: reason},
+			{Type: resourceTypeLogGroup, Name: testDeactivateLogGroupName, Action: action, Reason: reason},
+			{Type: resourceTypeRepository, Name: testDeactivateRepositoryName, Action: action, Reason: reason},
+		}
+	}
+	inventory := func(data []types.AccountResource) []types.AccountResource {
+		return []types.AccountResource{stackResource, data[0], bootstrapBucket, data[1], ownedFileSystem, data[2], data[3], orphan}
+	}
+
+	testCases := map[string]struct {
+		vars     accountDeactivateVars
+		expected []types.AccountResource
+	}{
+		"without force data is retained": {
+			expected: inventory(dataResources(resourceActionRetain, "data is only deleted with --force")),
+		},
+		"force deletes data": {
+			vars:     accountDeactivateVars{force: true},
+			expected: inventory(dataResources(resourceActionDelete, "--force was specified")),
+		},
+		"force with retain data": {
+			vars:     accountDeactivateVars{force: true, retainData: true},
+			expected: inventory(dataResources(resourceActionRetain, "--retain-data was specified")),
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			mocks := createMocks(t)
+			defer mocks.ctrl.Finish()
+			mocks.taggingMock.EXPECT().GetTaggedResources(constants.AppTagKey, constants.AppTagValue).Return(taggedResources, nil)
+			opts := &accountDeactivateOpts{
+				accountDeactivateVars: tc.vars,
+				stacks:                []cfn.Stack{testDeactivateStack1},
+				taggingClient:         mocks.taggingMock,
+			}
+
+			err := opts.LoadResources()
+
+			assert.NoError(t, err)
+			assert.Equal(t, tc.expected, opts.Resources())
+		})
+	}
+}
+
+func TestAccountDeactivateOpts_LoadResources_Error(t *testing.T) {
+	mocks := createMocks(t)
+	defer mocks.ctrl.Finish()
+	mocks.taggingMock.EXPECT().GetTaggedResources(constants.AppTagKey, constants.AppTagValue).Return(nil, fmt.Errorf("some tagging error"))
+	opts := &accountDeactivateOpts{taggingClient: mocks.taggingMock}
+
+	err := opts.LoadResources()
+
+	assert.EqualError(t, err, "some tagging error")
+}
+
 func TestAccountDeactivateOpts_Validate(t *testing.T) {
 	testCases := map[string]struct {
 		force       bool
@@ -211,3 +297,108 @@ func TestAccountDeactivateOpts_Execute(t *testing.T) {
 		})
 	}
 }
+
+func TestAccountDeactivateOpts_Execute_RemovesData(t *testing.T) {
+	mocks := createMocks(t)
+	defer mocks.ctrl.Finish()
+	mocks.stsMock.EXPECT().GetAccount().Return(testAccountId, nil)
+	bootstrapBucketName := awsresources.RenderBootstrapAssetBucketName(testAccountId, testAccountRegion)
+	mocks.s3Mock.EXPECT().BucketExists(bootstrapBucketName).Return(false, nil)
+	mocks.s3Mock.EXPECT().BucketExists(testDeactivateBucketName).Return(true, nil)
+	mocks.s3Mock.EXPECT().EmptyBucket(testDeactivateBucketName).Return(nil)
+	mocks.s3Mock.EXPECT().DeleteBucket(testDeactivateBucketName).Return(nil)
+	mocks.cwlMock.EXPECT().DeleteLogGroup(testDeactivateLogGroupName).Return(fmt.Errorf("some delete error"))
+	mocks.efsMock.EXPECT().FileSystemExists(testDeactivateFileSystemId).Return(true, nil)
+	mocks.efsMock.EXPECT().DeleteFileSystem(testDeactivateFileSystemId).Return(nil)
+	mocks.efsMock.EXPECT().FileSystemExists(testDeactivateOwnedFileSystemId).Return(false, nil)
+	opts := &accountDeactivateOpts{
+		resources: []types.AccountResource{
+			{Type: resourceTypeBucket, Name: testDeactivateBucketName, Action: resourceActionDelete},
+			{Type: resourceTypeLogGroup, Name: testDeactivateLogGroupName, Action: resourceActionDelete},
+			{Type: resourceTypeFileSystem, Name: testDeactivateFileSystemId, Action: resourceActionDelete},
+			{Type: resourceTypeFileSystem, Name: testDeactivateOwnedFileSystemId, Action: resourceActionDelete},
+			{Type: resourceTypeRepository, Name: testDeactivateRepositoryName, Action: resourceActionRetain},
+		},
+		s3Client:  mocks.s3Mock,
+		stsClient: mocks.stsMock,
+		cwlClient: mocks.cwlMock,
+		efsClient: mocks.efsMock,
+		ecrClient: mocks.ecrMock,
+		region:    testAccountRegion,
+	}
+
+	err := opts.Execute()
+
+	assert.NoError(t, err)
+}
+
+func TestAccountDeactivateOpts_Verify(t *testing.T) {
+	resources := []types.AccountResource{
+		{Type: resourceTypeStack, Name: testDeactivateStackName1, Action: resourceActionDelete},
+		{Type: resourceTypeBucket, Name: testDeactivateBucketName, Action: resourceActionDelete},
+		{Type: resourceTypeLogGroup, Name: testDeactivateLogGroupName, Action: resourceActionDelete},
+		{Type: resourceTypeFileSystem, Name: testDeactivateFileSystemId, Action: resourceActionDelete},
+		{Type: resourceTypeRepository, Name: testDeactivateRepositoryName, Action: resourceActionRetain},
+	}
+	testCases := map[string]struct {
+		setupMocks  func(mocks mockClients)
+		expected    []types.AccountResource
+		expectedErr string
+	}{
+		"everything removed": {
+			setupMocks: func(mocks mockClients) {
+				mocks.cfnMock.EXPECT().ListStacks(regexp.MustCompile(`^Agc-.*$`), cfn.ActiveStacksFilter).Return(nil, nil)
+				mocks.s3Mock.EXPECT().BucketExists(testDeactivateBucketName).Return(false, nil)
+				mocks.cwlMock.EXPECT().LogGroupExists(testDeactivateLogGroupName).Return(false, nil)
+				mocks.efsMock.EXPECT().FileSystemExists(testDeactivateFileSystemId).Return(false, nil)
+			},
+		},
+		"resources remain": {
+			setupMocks: func(mocks mockClients) {
+				mocks.cfnMock.EXPECT().ListStacks(regexp.MustCompile(`^Agc-.*$`), cfn.ActiveStacksFilter).Return([]cfn.Stack{testDeactivateStack1}, nil)
+				mocks.cfnMock.EXPECT().GetStackTags(testDeactivateStackId1).Return(map[string]string{"application-name": "agc"}, nil)
+				mocks.s3Mock.EXPECT().BucketExists(testDeactivateBucketName).Return(false, nil)
+				mocks.cwlMock.EXPECT().LogGroupExists(testDeactivateLogGroupName).Return(true, nil)
+				mocks.efsMock.EXPECT().FileSystemExists(testDeactivateFileSystemId).Return(false, nil)
+			},
+			expected: []types.AccountResource{
+				{Type: resourceTypeStack, Name: testDeactivateStackName1, Action: resourceActionDelete, Reason: "stack still exists"},
+				{Type: resourceTypeLogGroup, Name: testDeactivateLogGroupName, Action: resourceActionDelete, Reason: "log group still exists"},
+			},
+			expectedErr: "2 resource(s) are still present after deactivation",
+		},
+		"existence check error": {
+			setupMocks: func(mocks mockClients) {
+				mocks.cfnMock.EXPECT().ListStacks(regexp.MustCompile(`^Agc-.*$`), cfn.ActiveStacksFilter).Return(nil, nil)
+				mocks.s3Mock.EXPECT().BucketExists(testDeactivateBucketName).Return(false, fmt.Errorf("some head error"))
+			},
+			expectedErr: "some head error",
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			mocks := createMocks(t)
+			defer mocks.ctrl.Finish()
+			tc.setupMocks(mocks)
+			opts := &accountDeactivateOpts{
+				resources: resources,
+				cfnClient: mocks.cfnMock,
+				s3Client:  mocks.s3Mock,
+				cwlClient: mocks.cwlMock,
+				efsClient: mocks.efsMock,
+				ecrClient: mocks.ecrMock,
+			}
+
+			remaining, err := opts.Verify()
+
+			if tc.expectedErr != "" {
+				assert.Error(t, err)
+				assert.Contains(t, err.Error(), tc.expectedErr)
+			} else {
+				assert.NoError(t, err)
+			}
+			assert.Equal(t, tc.expected, remaining)
+		})
+	}
+}
@@ -0,0 +1,134 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+
+	"github.com/aws/amazon-genomics-cli/internal/pkg/aws"
+	"github.com/aws/amazon-genomics-cli/internal/pkg/aws/cfn"
+	"github.com/aws/amazon-genomics-cli/internal/pkg/aws/ssm"
+	"github.com/aws/amazon-genomics-cli/internal/pkg/cli/awsresources"
+	"github.com/aws/amazon-genomics-cli/internal/pkg/cli/clierror"
+	"github.com/aws/amazon-genomics-cli/internal/pkg/cli/clierror/actionableerror"
+	"github.com/aws/amazon-genomics-cli/internal/pkg/cli/format"
+	"github.com/aws/amazon-genomics-cli/internal/pkg/cli/types"
+	"github.com/aws/amazon-genomics-cli/internal/pkg/constants"
+	"github.com/aws/amazon-genomics-cli/internal/pkg/version"
+	"github.com/rs/zerolog/log"
+	"github.com/spf13/cobra"
+)
+
+// Names of the common SSM parameters written by the core stack
+const (
+	bucketParameterName  = "bucket"
+	vpcParameterName     = "vpc"
+	subnetsParameterName = "InfraSubnets"
+	amiParameterName     = "ComputeEnvImage"
+)
+
+type accountDescribeOpts struct {
+	cfnClient cfn.Interface
+	ssmClient ssm.Interface
+	region    string
+}
+
+func newAccountDescribeOpts() (*accountDescribeOpts, error) {
+	return &accountDescribeOpts{
+		cfnClient: aws.CfnClient(profile),
+		ssmClient: aws.SsmClient(profile),
+		region:    aws.Region(profile),
+	}, nil
+}
+
+// Execute returns the settings the core infrastructure of the current region was activated with.
+func (o *accountDescribeOpts) Execute() (types.Account, error) {
+	stackInfo, err := o.cfnClient.GetStackInfo(awsresources.RenderCoreStackName())
+	if err != nil {
+		if errors.Is(err, cfn.StackDoesNotExistError) {
+			return types.Account{}, actionableerror.New(
+				fmt.Errorf("AGC is not activated in region '%s'", o.region),
+				"Run 'agc account activate' to activate AGC in this region, or select another region with your AWS profile",
+			)
+		}
+		return types.Account{}, err
+	}
+
+	deployedVersion := stackInfo.Tags[constants.AgcVersionKey]
+	account := types.Account{
+		Region:          o.region,
+		StackStatus:     string(stackInfo.Status),
+		AgcVersion:      deployedVersion,
+		CliVersion:      version.Version,
+		VersionMismatch: deployedVersion != version.Version,
+		Tags:            customStackTags(stackInfo.Tags),
+	}
+
+	parameters := map[string]*string{
+		bucketParameterName:  &account.Bucket,
+		vpcParameterName:     &account.VpcId,
+		subnetsParameterName: &account.Subnets,
+		amiParameterName:     &account.AmiId,
+	}
+	for parameterName, value := range parameters {
+		if *value, err = o.ssmClient.GetCommonParameter(parameterName); err != nil {
+			return types.Account{}, err
+		}
+	}
+
+	if account.VersionMismatch {
+		log.Warn().Msgf("AGC version '%s' is deployed in region '%s' but this CLI is version '%s'. Run 'agc account activate' to update the core infrastructure",
+			deployedVersion, o.region, version.Version)
+	}
+	return account, nil
+}
+
+// customStackTags returns the tags of the core stack other than those AGC always applies
+func customStackTags(stackTags map[string]string) []types.AccountTag {
+	keys := make([]string, 0, len(stackTags))
+	for key := range stackTags {
+		if key == constants.AppTagKey || key == constants.AgcVersionKey {
+			continue
+		}
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	tags := make([]types.AccountTag, len(keys))
+	for i, key := range keys {
+		tags[i] = types.AccountTag{Key: key, Value: stackTags[key]}
+	}
+	return tags
+}
+
+// BuildAccountDescribeCommand builds the command for describing the activation of AGC in an AWS account.
+func BuildAccountDescribeCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "describe",
+		Short: "Show the settings AGC was activated with in an AWS account.",
+		Long: `describe shows the bucket, VPC, subnets, AMI, tags and AGC version of the core
+infrastructure in the current account and region, and whether the deployed version
+differs from the version of this CLI.
+
+` + DescribeOutput(types.Account{}),
+		Example: `
+/code $ agc account describe`,
+		Args: cobra.NoArgs,
+		RunE: runCmdE(func(cmd *cobra.Command, args []string) error {
+			opts, err := newAccountDescribeOpts()
+			if err != nil {
+				return err
+			}
+			log.Info().Msgf("Describing AGC activation in region '%s'", opts.region)
+			account, err := opts.Execute()
+			if err != nil {
+				return clierror.New("account describe", nil, err)
+			}
+			format.Default.Write(account)
+			return nil
+		}),
+	}
+	return cmd
+}
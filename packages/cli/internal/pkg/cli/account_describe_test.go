@@ -0,0 +1,97 @@
+package cli
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/amazon-genomics-cli/internal/pkg/aws/cfn"
+	"github.com/aws/amazon-genomics-cli/internal/pkg/cli/types"
+	"github.com/aws/amazon-genomics-cli/internal/pkg/constants"
+	"github.com/aws/amazon-genomics-cli/internal/pkg/version"
+	cfntypes "github.com/aws/aws-sdk-go-v2/service/cloudformation/types"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	testCoreStackName = "Agc-Core"
+	testAmiId         = "ami-1234"
+)
+
+func expectCoreParameters(mocks mockClients) {
+	mocks.ssmMock.EXPECT().GetCommonParameter(bucketParameterName).Return(testAccountBucketName, nil)
+	mocks.ssmMock.EXPECT().GetCommonParameter(vpcParameterName).Return(testAccountVpcId, nil)
+	mocks.ssmMock.EXPECT().GetCommonParameter(subnetsParameterName).Return(testAccountSubnetId1+","+testAccountSubnetId2, nil)
+	mocks.ssmMock.EXPECT().GetCommonParameter(amiParameterName).Return(testAmiId, nil)
+}
+
+func TestAccountDescribeOpts_Execute(t *testing.T) {
+	testCases := map[string]struct {
+		deployedVersion         string
+		expectedVersionMismatch bool
+	}{
+		"same version": {
+			deployedVersion: version.Version,
+		},
+		"different version": {
+			deployedVersion:         "0.0.1",
+			expectedVersionMismatch: true,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			mocks := createMocks(t)
+			defer mocks.ctrl.Finish()
+			mocks.cfnMock.EXPECT().GetStackInfo(testCoreStackName).Return(cfn.StackInfo{
+				Status: cfntypes.StackStatusUpdateComplete,
+				Tags: map[string]string{
+					constants.AppTagKey:     constants.AppTagValue,
+					constants.AgcVersionKey: tc.deployedVersion,
+					"team":                  "genomics",
+					"cost-center":           "1234",
+				},
+			}, nil)
+			expectCoreParameters(mocks)
+			opts := &accountDescribeOpts{cfnClient: mocks.cfnMock, ssmClient: mocks.ssmMock, region: testAccountRegion}
+
+			account, err := opts.Execute()
+			require.NoError(t, err)
+			assert.Equal(t, types.Account{
+				Region:          testAccountRegion,
+				StackStatus:     "UPDATE_COMPLETE",
+				AgcVersion:      tc.deployedVersion,
+				CliVersion:      version.Version,
+				VersionMismatch: tc.expectedVersionMismatch,
+				Bucket:          testAccountBucketName,
+				VpcId:           testAccountVpcId,
+				Subnets:         testAccountSubnetId1 + "," + testAccountSubnetId2,
+				AmiId:           testAmiId,
+				Tags:            []types.AccountTag{{Key: "cost-center", Value: "1234"}, {Key: "team", Value: "genomics"}},
+			}, account)
+		})
+	}
+}
+
+func TestAccountDescribeOpts_Execute_NotActivated(t *testing.T) {
+	mocks := createMocks(t)
+	defer mocks.ctrl.Finish()
+	mocks.cfnMock.EXPECT().GetStackInfo(testCoreStackName).Return(cfn.StackInfo{}, cfn.StackDoesNotExistError)
+	opts := &accountDescribeOpts{cfnClient: mocks.cfnMock, ssmClient: mocks.ssmMock, region: testAccountRegion}
+
+	_, err := opts.Execute()
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "AGC is not activated in region 'test-account-region'")
+}
+
+func TestAccountDescribeOpts_Execute_ParameterError(t *testing.T) {
+	mocks := createMocks(t)
+	defer mocks.ctrl.Finish()
+	mocks.cfnMock.EXPECT().GetStackInfo(testCoreStackName).Return(cfn.StackInfo{Tags: map[string]string{}}, nil)
+	mocks.ssmMock.EXPECT().GetCommonParameter(gomock.Any()).Return("", fmt.Errorf("some parameter error"))
+	opts := &accountDescribeOpts{cfnClient: mocks.cfnMock, ssmClient: mocks.ssmMock, region: testAccountRegion}
+
+	_, err := opts.Execute()
+	assert.EqualError(t, err, "some parameter error")
+}
@@ -0,0 +1,400 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/aws/amazon-genomics-cli/internal/pkg/aws"
+	"github.com/aws/amazon-genomics-cli/internal/pkg/aws/cfn"
+	"github.com/aws/amazon-genomics-cli/internal/pkg/aws/ec2"
+	"github.com/aws/amazon-genomics-cli/internal/pkg/aws/ecr"
+	"github.com/aws/amazon-genomics-cli/internal/pkg/aws/s3"
+	"github.com/aws/amazon-genomics-cli/internal/pkg/aws/servicequotas"
+	"github.com/aws/amazon-genomics-cli/internal/pkg/aws/sts"
+	"github.com/aws/amazon-genomics-cli/internal/pkg/cli/awsresources"
+	"github.com/aws/amazon-genomics-cli/internal/pkg/cli/clierror"
+	"github.com/aws/amazon-genomics-cli/internal/pkg/cli/clierror/actionableerror"
+	"github.com/aws/amazon-genomics-cli/internal/pkg/cli/format"
+	"github.com/aws/amazon-genomics-cli/internal/pkg/cli/types"
+	"github.com/aws/amazon-genomics-cli/internal/pkg/environment"
+	"github.com/rs/zerolog/log"
+	"github.com/spf13/cobra"
+)
+
+const (
+	checkStatusPass = "PASS"
+	checkStatusWarn = "WARN"
+	checkStatusFail = "FAIL"
+
+	vpcServiceCode       = "vpc"
+	vpcsPerRegionQuota   = "L-F678F1CE"
+	ec2ServiceCode       = "ec2"
+	elasticIpsQuota      = "L-0263D0A3"
+	requiredElasticIps   = 3 // a NAT gateway is created in each of up to three availability zones
+	requiredVpcs         = 1
+	serviceEndpointFmt   = "com.amazonaws.%s.%s"
+	unverifiedCheckHint  = "Make sure your credentials allow read-only access to the service, or verify this manually"
+	failedChecksErrorFmt = "%d account readiness check(s) failed"
+)
+
+// requiredServiceEndpoints are the services that compute in private subnets without a NAT route must reach
+// through VPC endpoints. They match the endpoints AGC creates when it creates its own VPC.
+var requiredServiceEndpoints = []string{"s3", "dynamodb", "logs", "ecr.dkr", "ecr.api", "ecs-agent", "ecs-telemetry", "ecs", "ec2"}
+
+type accountDoctorVars struct {
+	bucketName    string
+	vpcId         string
+	publicSubnets bool
+	subnets       []string
+}
+
+type accountDoctorOpts struct {
+	accountDoctorVars
+	cfnClient    cfn.Interface
+	stsClient    sts.Interface
+	s3Client     s3.Interface
+	ec2Client    ec2.Interface
+	ecrClient    ecr.Interface
+	quotasClient servicequotas.Interface
+	imageRefs    map[string]ecr.ImageReference
+	region       string
+	checks       []types.AccountCheck
+}
+
+func newAccountDoctorOpts(vars accountDoctorVars) (*accountDoctorOpts, error) {
+	return &accountDoctorOpts{
+		accountDoctorVars: vars,
+		cfnClient:         aws.CfnClient(profile),
+		stsClient:         aws.StsClient(profile),
+		s3Client:          aws.S3Client(profile),
+		ec2Client:         aws.Ec2Client(profile),
+		ecrClient:         aws.EcrClient(profile),
+		quotasClient:      aws.ServiceQuotasClient(profile),
+		imageRefs:         environment.CommonImages,
+		region:            aws.Region(profile),
+	}, nil
+}
+
+// Execute checks that the account, region and network are ready for AGC to be activated.
+// All checks are returned, and an error is returned if any of them failed.
+func (o *accountDoctorOpts) Execute() ([]types.AccountCheck, error) {
+	o.checks = nil
+	account, ok := o.checkCredentials()
+	if ok {
+		o.checkBucket(account)
+		o.checkNetwork()
+		o.checkImages()
+		o.checkQuotas()
+	}
+	return o.checks, failedChecksError(o.checks)
+}
+
+func (o *accountDoctorOpts) checkCredentials() (string, bool) {
+	const check = "AWS credentials"
+	account, err := o.stsClient.GetAccount()
+	if err != nil {
+		o.fail(check, err)
+		return "", false
+	}
+	o.pass(check, fmt.Sprintf("Using account '%s' in region '%s'", account, o.region))
+	return account, true
+}
+
+func (o *accountDoctorOpts) checkBucket(account string) {
+	const check = "S3 bucket"
+	bucketName := o.bucketName
+	if bucketName == "" {
+		bucketName = generateBucketName(account, o.region)
+	}
+	exists, err := o.s3Client.BucketExists(bucketName)
+	if err != nil {
+		o.fail(check, err)
+		return
+	}
+	if !exists {
+		o.pass(check, fmt.Sprintf("Bucket '%s' does not exist and will be created", bucketName))
+		return
+	}
+	isOwner, err := o.s3Client.IsBucketOwner(bucketName, account)
+	if err != nil {
+		o.fail(check, err)
+		return
+	}
+	if !isOwner {
+		o.add(check, checkStatusFail, fmt.Sprintf("Bucket '%s' is not owned by account '%s'", bucketName, account),
+			fmt.Sprintf("Use the %q flag to supply a bucket owned by this account", accountBucketFlag))
+		return
+	}
+	bucketRegion, err := o.s3Client.GetBucketRegion(bucketName)
+	if err != nil {
+		o.fail(check, err)
+		return
+	}
+	if bucketRegion != o.region {
+		o.add(check, checkStatusFail, fmt.Sprintf("Bucket '%s' is in region '%s' but AGC is being activated in region '%s'", bucketName, bucketRegion, o.region),
+			fmt.Sprintf("Use the %q flag to supply a bucket in region '%s', or activate AGC in region '%s'", accountBucketFlag, o.region, bucketRegion))
+		return
+	}
+	o.pass(check, fmt.Sprintf("Bucket '%s' exists in region '%s' and is owned by this account", bucketName, bucketRegion))
+}
+
+func (o *accountDoctorOpts) checkNetwork() {
+	const check = "VPC and subnets"
+	if o.vpcId == "" {
+		o.pass(check, "A new VPC will be created")
+		return
+	}
+	vpcSubnets, err := o.ec2Client.GetVpcSubnets(o.vpcId)
+	if err != nil {
+		var actionableErr *actionableerror.Error
+		if errors.As(err, &actionableErr) && errors.Is(actionableErr.Cause, ec2.ErrVpcNotFound) {
+			o.fail(check, err)
+		} else {
+			o.warn(check, fmt.Sprintf("Unable to inspect VPC '%s': %s", o.vpcId, err), unverifiedCheckHint)
+		}
+		return
+	}
+
+	subnets, ok := o.selectSubnets(check, vpcSubnets)
+	if !ok {
+		return
+	}
+
+	var isolatedSubnets []string
+	for _, subnet := range subnets {
+		if subnet.IsPrivate() && !subnet.HasNatRoute {
+			isolatedSubnets = append(isolatedSubnets, subnet.Id)
+		}
+	}
+	if len(isolatedSubnets) == 0 {
+		o.pass(check, fmt.Sprintf("%d subnet(s) of VPC '%s' can reach AWS service endpoints", len(subnets), o.vpcId))
+		return
+	}
+
+	endpoints, err := o.ec2Client.GetVpcEndpointServiceNames(o.vpcId)
+	if err != nil {
+		o.warn(check, fmt.Sprintf("Unable to list the VPC endpoints of VPC '%s': %s", o.vpcId, err), unverifiedCheckHint)
+		return
+	}
+	missingEndpoints := o.missingServiceEndpoints(endpoints)
+	if len(missingEndpoints) > 0 {
+		o.add(check, checkStatusFail,
+			fmt.Sprintf("Subnet(s) %s have no NAT route and VPC '%s' has no endpoints for: %s",
+				strings.Join(isolatedSubnets, ", "), o.vpcId, strings.Join(missingEndpoints, ", ")),
+			"Add a route to a NAT gateway to these subnets, or create VPC endpoints for the listed services")
+		return
+	}
+	o.pass(check, fmt.Sprintf("%d subnet(s) of VPC '%s' can reach AWS service endpoints", len(subnets), o.vpcId))
+}
+
+func (o *accountDoctorOpts) selectSubnets(check string, vpcSubnets []ec2.Subnet) ([]ec2.Subnet, bool) {
+	if len(o.subnets) == 0 {
+		var privateSubnets []ec2.Subnet
+		for _, subnet := range vpcSubnets {
+			if subnet.IsPrivate() {
+				privateSubnets = append(privateSubnets, subnet)
+			}
+		}
+		if len(privateSubnets) == 0 {
+			o.add(check, checkStatusFail, fmt.Sprintf("VPC '%s' has no private subnets", o.vpcId),
+				fmt.Sprintf("Add private subnets to the VPC, or use the %q flag to choose the subnets AGC will use", subnetsFlag))
+			return nil, false
+		}
+		return privateSubnets, true
+	}
+
+	subnetsById := make(map[string]ec2.Subnet, len(vpcSubnets))
+	for _, subnet := range vpcSubnets {
+		subnetsById[subnet.Id] = subnet
+	}
+	var selected []ec2.Subnet
+	var unknownSubnets, publicSubnets []string
+	for _, subnetId := range o.subnets {
+		subnet, ok := subnetsById[subnetId]
+		if !ok {
+			unknownSubnets = append(unknownSubnets, subnetId)
+			continue
+		}
+		if !subnet.IsPrivate() {
+			publicSubnets = append(publicSubnets, subnetId)
+		}
+		selected = append(selected, subnet)
+	}
+	if len(unknownSubnets) > 0 {
+		o.add(check, checkStatusFail, fmt.Sprintf("Subnet(s) %s do not belong to VPC '%s'", strings.Join(unknownSubnets, ", "), o.vpcId),
+			fmt.Sprintf("Only supply subnets of VPC '%s' with the %q flag", o.vpcId, subnetsFlag))
+		return nil, false
+	}
+	if len(publicSubnets) > 0 {
+		o.warn(check, fmt.Sprintf("Subnet(s) %s route to an internet gateway and are not private", strings.Join(publicSubnets, ", ")),
+			"Use private subnets for AGC compute resources")
+	}
+	return selected, true
+}
+
+func (o *accountDoctorOpts) missingServiceEndpoints(endpointServiceNames []string) []string {
+	present := make(map[string]bool, len(endpointServiceNames))
+	for _, serviceName := range endpointServiceNames {
+		present[serviceName] = true
+	}
+	var missing []string
+	for _, service := range requiredServiceEndpoints {
+		if !present[fmt.Sprintf(serviceEndpointFmt, o.region, service)] {
+			missing = append(missing, service)
+		}
+	}
+	return missing
+}
+
+func (o *accountDoctorOpts) checkImages() {
+	components := make([]string, 0, len(o.imageRefs))
+	for component := range o.imageRefs {
+		components = append(components, component)
+	}
+	sort.Strings(components)
+	for _, component := range components {
+		check := fmt.Sprintf("ECR image '%s'", component)
+		imageRef := o.imageRefs[component]
+		imageRef.Region = o.region
+		if err := o.ecrClient.VerifyImageExists(imageRef); err != nil {
+			o.fail(check, err)
+			continue
+		}
+		o.pass(check, fmt.Sprintf("Image '%s:%s' is reachable", imageRef.RepositoryName, imageRef.ImageTag))
+	}
+}
+
+func (o *accountDoctorOpts) checkQuotas() {
+	if o.vpcId != "" {
+		return
+	}
+	if o.coreStackExists() {
+		// re-activating or upgrading reuses the VPC, and the NAT gateways, of the core stack
+		const details = "The VPC of the existing core stack is reused"
+		o.pass("VPCs per region", details)
+		if !o.publicSubnets {
+			o.pass("Elastic IP addresses", details)
+		}
+		return
+	}
+	o.checkQuota("VPCs per region", vpcServiceCode, vpcsPerRegionQuota, requiredVpcs, o.ec2Client.CountVpcs)
+	if !o.publicSubnets {
+		o.checkQuota("Elastic IP addresses", ec2ServiceCode, elasticIpsQuota, requiredElasticIps, o.ec2Client.CountElasticIps)
+	}
+}
+
+func (o *accountDoctorOpts) coreStackExists() bool {
+	_, err := o.cfnClient.GetStackInfo(awsresources.RenderCoreStackName())
+	if err != nil && !errors.Is(err, cfn.StackDoesNotExistError) {
+		log.Debug().Msgf("Unable to read the core stack, checking quotas for a new VPC: %v", err)
+	}
+	return err == nil
+}
+
+func (o *accountDoctorOpts) checkQuota(check, serviceCode, quotaCode string, required int, countUsed func() (int, error)) {
+	quota, err := o.quotasClient.GetQuotaValue(serviceCode, quotaCode)
+	if err != nil {
+		o.warn(check, fmt.Sprintf("Unable to read service quota '%s': %s", quotaCode, err), unverifiedCheckHint)
+		return
+	}
+	used, err := countUsed()
+	if err != nil {
+		o.warn(check, fmt.Sprintf("Unable to count used resources: %s", err), unverifiedCheckHint)
+		return
+	}
+	available := int(quota) - used
+	if available < required {
+		o.add(check, checkStatusFail, fmt.Sprintf("%d of %d used, AGC needs %d more", used, int(quota), required),
+			fmt.Sprintf("Release unused resources or request a quota increase for '%s' in the Service Quotas console", quotaCode))
+		return
+	}
+	o.pass(check, fmt.Sprintf("%d of %d used", used, int(quota)))
+}
+
+func (o *accountDoctorOpts) pass(check, details string) {
+	o.add(check, checkStatusPass, details, "")
+}
+
+func (o *accountDoctorOpts) warn(check, details, suggestedAction string) {
+	o.add(check, checkStatusWarn, details, suggestedAction)
+}
+
+func (o *accountDoctorOpts) fail(check string, err error) {
+	var actionableErr *actionableerror.Error
+	if errors.As(err, &actionableErr) {
+		o.add(check, checkStatusFail, actionableErr.Cause.Error(), actionableErr.SuggestedAction)
+		return
+	}
+	o.add(check, checkStatusFail, err.Error(), "")
+}
+
+func (o *accountDoctorOpts) add(check, status, details, suggestedAction string) {
+	log.Debug().Msgf("account check '%s': %s %s", check, status, details)
+	o.checks = append(o.checks, types.AccountCheck{
+		Check:           check,
+		Status:          status,
+		Details:         details,
+		SuggestedAction: suggestedAction,
+	})
+}
+
+func failedChecksError(checks []types.AccountCheck) error {
+	var failed int
+	var suggestions []string
+	for _, check := range checks {
+		if check.Status != checkStatusFail {
+			continue
+		}
+		failed++
+		if check.SuggestedAction != "" {
+			suggestions = append(suggestions, fmt.Sprintf("%s: %s", check.Check, check.SuggestedAction))
+		}
+	}
+	if failed == 0 {
+		return nil
+	}
+	return actionableerror.New(fmt.Errorf(failedChecksErrorFmt, failed), strings.Join(suggestions, "\n"))
+}
+
+// BuildAccountDoctorCommand builds the command for checking that an AWS account is ready for AGC.
+func BuildAccountDoctorCommand() *cobra.Command {
+	vars := accountDoctorVars{}
+	cmd := &cobra.Command{
+		Use:   "doctor",
+		Short: "Check that an AWS account is ready for AGC to be activated.",
+		Long: `doctor checks your credentials, the S3 bucket, the VPC and subnets, the reachability of the
+AGC container images and the service quotas that 'agc account activate' depends on.
+Supply the same flags you intend to pass to 'agc account activate'. The same checks
+are run automatically at the start of 'agc account activate'. IAM permissions to create
+the core infrastructure are not checked and are reported by 'agc account activate'.
+
+` + DescribeOutput([]types.AccountCheck{}),
+		Example: `
+Check that AGC can be activated in an existing VPC.
+/code $ agc account doctor --vpc my-vpc-id --subnets subnet-1234,subnet-2345`,
+		Args: cobra.NoArgs,
+		RunE: runCmdE(func(cmd *cobra.Command, args []string) error {
+			opts, err := newAccountDoctorOpts(vars)
+			if err != nil {
+				return err
+			}
+			log.Info().Msgf("Checking account readiness for AGC")
+			checks, err := opts.Execute()
+			format.Default.Write(checks)
+			if err != nil {
+				return clierror.New("account doctor", vars, err)
+			}
+			return nil
+		}),
+	}
+	cmd.Flags().StringVar(&vars.bucketName, accountBucketFlag, "", accountBucketFlagDescription)
+	cmd.Flags().StringVar(&vars.vpcId, accountVpcFlag, "", accountVpcFlagDescription)
+	cmd.Flags().BoolVar(&vars.publicSubnets, publicSubnetsFlag, false, publicSubnetsFlagDescription)
+	cmd.Flags().StringSliceVar(&vars.subnets, subnetsFlag, nil, subnetFlagDescription)
+	return cmd
+}
@@ -0,0 +1,352 @@
+package cli
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/amazon-genomics-cli/internal/pkg/aws/cfn"
+	"github.com/aws/amazon-genomics-cli/internal/pkg/aws/ec2"
+	"github.com/aws/amazon-genomics-cli/internal/pkg/aws/ecr"
+	"github.com/aws/amazon-genomics-cli/internal/pkg/cli/awsresources"
+	"github.com/aws/amazon-genomics-cli/internal/pkg/cli/clierror/actionableerror"
+	"github.com/aws/amazon-genomics-cli/internal/pkg/cli/types"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const testDefaultAccountBucketName = "agc-test-account-id-test-account-region"
+
+var testDoctorImageRefs = map[string]ecr.ImageReference{
+	"WES": {
+		RegistryId:     testAccountId,
+		Region:         "us-east-1",
+		RepositoryName: testWesRepository,
+		ImageTag:       testImageTag,
+	},
+}
+
+func newTestAccountDoctorOpts(mocks mockClients, vars accountDoctorVars) *accountDoctorOpts {
+	return &accountDoctorOpts{
+		accountDoctorVars: vars,
+		cfnClient:         mocks.cfnMock,
+		stsClient:         mocks.stsMock,
+		s3Client:          mocks.s3Mock,
+		ec2Client:         mocks.ec2Mock,
+		ecrClient:         mocks.ecrMock,
+		quotasClient:      mocks.quotasMock,
+		imageRefs:         testDoctorImageRefs,
+		region:            testAccountRegion,
+	}
+}
+
+func expectImageChecks(mocks mockClients) {
+	mocks.ecrMock.EXPECT().VerifyImageExists(ecr.ImageReference{
+		RegistryId:     testAccountId,
+		Region:         testAccountRegion,
+		RepositoryName: testWesRepository,
+		ImageTag:       testImageTag,
+	}).Return(nil)
+}
+
+func expectQuotaChecks(mocks mockClients) {
+	mocks.cfnMock.EXPECT().GetStackInfo(awsresources.RenderCoreStackName()).Return(cfn.StackInfo{}, cfn.StackDoesNotExistError)
+	mocks.quotasMock.EXPECT().GetQuotaValue(vpcServiceCode, vpcsPerRegionQuota).Return(float64(5), nil)
+	mocks.ec2Mock.EXPECT().CountVpcs().Return(1, nil)
+	mocks.quotasMock.EXPECT().GetQuotaValue(ec2ServiceCode, elasticIpsQuota).Return(float64(5), nil)
+	mocks.ec2Mock.EXPECT().CountElasticIps().Return(0, nil)
+}
+
+func testEndpointServiceNames(services ...string) []string {
+	names := make([]string, len(services))
+	for i, service := range services {
+		names[i] = fmt.Sprintf(serviceEndpointFmt, testAccountRegion, service)
+	}
+	return names
+}
+
+func checkStatuses(checks []types.AccountCheck) map[string]string {
+	statuses := make(map[string]string, len(checks))
+	for _, check := range checks {
+		statuses[check.Check] = check.Status
+	}
+	return statuses
+}
+
+func TestAccountDoctorOpts_Execute_AllChecksPass(t *testing.T) {
+	mocks := createMocks(t)
+	defer mocks.ctrl.Finish()
+	mocks.stsMock.EXPECT().GetAccount().Return(testAccountId, nil)
+	mocks.s3Mock.EXPECT().BucketExists(testDefaultAccountBucketName).Return(false, nil)
+	expectImageChecks(mocks)
+	expectQuotaChecks(mocks)
+
+	checks, err := newTestAccountDoctorOpts(mocks, accountDoctorVars{}).Execute()
+	require.NoError(t, err)
+	assert.Equal(t, map[string]string{
+		"AWS credentials":      checkStatusPass,
+		"S3 bucket":            checkStatusPass,
+		"VPC and subnets":      checkStatusPass,
+		"ECR image 'WES'":      checkStatusPass,
+		"VPCs per region":      checkStatusPass,
+		"Elastic IP addresses": checkStatusPass,
+	}, checkStatuses(checks))
+}
+
+func TestAccountDoctorOpts_Execute_CredentialsFailStopsChecks(t *testing.T) {
+	mocks := createMocks(t)
+	defer mocks.ctrl.Finish()
+	mocks.stsMock.EXPECT().GetAccount().Return("", actionableerror.New(fmt.Errorf("expired token"), "refresh your credentials"))
+
+	checks, err := newTestAccountDoctorOpts(mocks, accountDoctorVars{}).Execute()
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "1 account readiness check(s) failed")
+	assert.Contains(t, err.Error(), "AWS credentials: refresh your credentials")
+	assert.Equal(t, []types.AccountCheck{{
+		Check:           "AWS credentials",
+		Status:          checkStatusFail,
+		Details:         "expired token",
+		SuggestedAction: "refresh your credentials",
+	}}, checks)
+}
+
+func TestAccountDoctorOpts_Execute_Bucket(t *testing.T) {
+	testCases := map[string]struct {
+		setupMocks      func(mockClients)
+		expectedStatus  string
+		expectedDetails string
+	}{
+		"owned bucket in region": {
+			setupMocks: func(mocks mockClients) {
+				mocks.s3Mock.EXPECT().BucketExists(testAccountBucketName).Return(true, nil)
+				mocks.s3Mock.EXPECT().IsBucketOwner(testAccountBucketName, testAccountId).Return(true, nil)
+				mocks.s3Mock.EXPECT().GetBucketRegion(testAccountBucketName).Return(testAccountRegion, nil)
+			},
+			expectedStatus: checkStatusPass,
+		},
+		"bucket owned by another account": {
+			setupMocks: func(mocks mockClients) {
+				mocks.s3Mock.EXPECT().BucketExists(testAccountBucketName).Return(true, nil)
+				mocks.s3Mock.EXPECT().IsBucketOwner(testAccountBucketName, testAccountId).Return(false, nil)
+			},
+			expectedStatus:  checkStatusFail,
+			expectedDetails: "Bucket 'test-account-bucket' is not owned by account 'test-account-id'",
+		},
+		"bucket in another region": {
+			setupMocks: func(mocks mockClients) {
+				mocks.s3Mock.EXPECT().BucketExists(testAccountBucketName).Return(true, nil)
+				mocks.s3Mock.EXPECT().IsBucketOwner(testAccountBucketName, testAccountId).Return(true, nil)
+				mocks.s3Mock.EXPECT().GetBucketRegion(testAccountBucketName).Return("eu-west-1", nil)
+			},
+			expectedStatus:  checkStatusFail,
+			expectedDetails: "Bucket 'test-account-bucket' is in region 'eu-west-1' but AGC is being activated in region 'test-account-region'",
+		},
+		"bucket access error": {
+			setupMocks: func(mocks mockClients) {
+				mocks.s3Mock.EXPECT().BucketExists(testAccountBucketName).Return(false, fmt.Errorf("access denied"))
+			},
+			expectedStatus:  checkStatusFail,
+			expectedDetails: "access denied",
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			mocks := createMocks(t)
+			defer mocks.ctrl.Finish()
+			mocks.stsMock.EXPECT().GetAccount().Return(testAccountId, nil)
+			tc.setupMocks(mocks)
+			expectImageChecks(mocks)
+			expectQuotaChecks(mocks)
+
+			checks, _ := newTestAccountDoctorOpts(mocks, accountDoctorVars{bucketName: testAccountBucketName}).Execute()
+			require.Greater(t, len(checks), 1)
+			assert.Equal(t, "S3 bucket", checks[1].Check)
+			assert.Equal(t, tc.expectedStatus, checks[1].Status)
+			if tc.expectedDetails != "" {
+				assert.Equal(t, tc.expectedDetails, checks[1].Details)
+			}
+		})
+	}
+}
+
+func TestAccountDoctorOpts_Execute_Network(t *testing.T) {
+	publicSubnet := ec2.Subnet{Id: testAccountSubnetId1, VpcId: testAccountVpcId, HasInternetGatewayRoute: true}
+	natSubnet := ec2.Subnet{Id: testAccountSubnetId2, VpcId: testAccountVpcId, HasNatRoute: true}
+	isolatedSubnet := ec2.Subnet{Id: "test-account-subnet-id-3", VpcId: testAccountVpcId}
+
+	testCases := map[string]struct {
+		subnets         []string
+		setupMocks      func(mockClients)
+		expectedStatus  string
+		expectedDetails string
+	}{
+		"private subnets with NAT": {
+			setupMocks: func(mocks mockClients) {
+				mocks.ec2Mock.EXPECT().GetVpcSubnets(testAccountVpcId).Return([]ec2.Subnet{publicSubnet, natSubnet}, nil)
+			},
+			expectedStatus: checkStatusPass,
+		},
+		"no private subnets": {
+			setupMocks: func(mocks mockClients) {
+				mocks.ec2Mock.EXPECT().GetVpcSubnets(testAccountVpcId).Return([]ec2.Subnet{publicSubnet}, nil)
+			},
+			expectedStatus:  checkStatusFail,
+			expectedDetails: "VPC 'test-account-vpc-id' has no private subnets",
+		},
+		"isolated subnets with all endpoints": {
+			setupMocks: func(mocks mockClients) {
+				mocks.ec2Mock.EXPECT().GetVpcSubnets(testAccountVpcId).Return([]ec2.Subnet{isolatedSubnet}, nil)
+				mocks.ec2Mock.EXPECT().GetVpcEndpointServiceNames(testAccountVpcId).Return(testEndpointServiceNames(requiredServiceEndpoints...), nil)
+			},
+			expectedStatus: checkStatusPass,
+		},
+		"isolated subnets with missing endpoints": {
+			setupMocks: func(mocks mockClients) {
+				mocks.ec2Mock.EXPECT().GetVpcSubnets(testAccountVpcId).Return([]ec2.Subnet{isolatedSubnet}, nil)
+				mocks.ec2Mock.EXPECT().GetVpcEndpointServiceNames(testAccountVpcId).Return(testEndpointServiceNames("s3", "dynamodb"), nil)
+			},
+			expectedStatus:  checkStatusFail,
+			expectedDetails: "Subnet(s) test-account-subnet-id-3 have no NAT route and VPC 'test-account-vpc-id' has no endpoints for: logs, ecr.dkr, ecr.api, ecs-agent, ecs-telemetry, ecs, ec2",
+		},
+		"subnet outside of VPC": {
+			subnets: []string{testAccountSubnetId2, "subnet-elsewhere"},
+			setupMocks: func(mocks mockClients) {
+				mocks.ec2Mock.EXPECT().GetVpcSubnets(testAccountVpcId).Return([]ec2.Subnet{natSubnet}, nil)
+			},
+			expectedStatus:  checkStatusFail,
+			expectedDetails: "Subnet(s) subnet-elsewhere do not belong to VPC 'test-account-vpc-id'",
+		},
+		"public subnet supplied": {
+			subnets: []string{testAccountSubnetId1},
+			setupMocks: func(mocks mockClients) {
+				mocks.ec2Mock.EXPECT().GetVpcSubnets(testAccountVpcId).Return([]ec2.Subnet{publicSubnet}, nil)
+			},
+			expectedStatus:  checkStatusWarn,
+			expectedDetails: "Subnet(s) test-account-subnet-id-1 route to an internet gateway and are not private",
+		},
+		"VPC not found": {
+			setupMocks: func(mocks mockClients) {
+				mocks.ec2Mock.EXPECT().GetVpcSubnets(testAccountVpcId).
+					Return(nil, actionableerror.New(fmt.Errorf("%w: '%s'", ec2.ErrVpcNotFound, testAccountVpcId), "check the VPC id"))
+			},
+			expectedStatus:  checkStatusFail,
+			expectedDetails: "VPC does not exist: 'test-account-vpc-id'",
+		},
+		"VPC not readable": {
+			setupMocks: func(mocks mockClients) {
+				mocks.ec2Mock.EXPECT().GetVpcSubnets(testAccountVpcId).Return(nil, fmt.Errorf("unauthorized"))
+			},
+			expectedStatus:  checkStatusWarn,
+			expectedDetails: "Unable to inspect VPC 'test-account-vpc-id': unauthorized",
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			mocks := createMocks(t)
+			defer mocks.ctrl.Finish()
+			mocks.stsMock.EXPECT().GetAccount().Return(testAccountId, nil)
+			mocks.s3Mock.EXPECT().BucketExists(testDefaultAccountBucketName).Return(false, nil)
+			tc.setupMocks(mocks)
+			expectImageChecks(mocks)
+
+			checks, err := newTestAccountDoctorOpts(mocks, accountDoctorVars{vpcId: testAccountVpcId, subnets: tc.subnets}).Execute()
+			require.Greater(t, len(checks), 2)
+			assert.Equal(t, "VPC and subnets", checks[2].Check)
+			assert.Equal(t, tc.expectedStatus, checks[2].Status)
+			if tc.expectedDetails != "" {
+				assert.Equal(t, tc.expectedDetails, checks[2].Details)
+			}
+			if tc.expectedStatus == checkStatusFail {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestAccountDoctorOpts_Execute_ImageNotReachable(t *testing.T) {
+	mocks := createMocks(t)
+	defer mocks.ctrl.Finish()
+	mocks.stsMock.EXPECT().GetAccount().Return(testAccountId, nil)
+	mocks.s3Mock.EXPECT().BucketExists(testDefaultAccountBucketName).Return(false, nil)
+	mocks.ecrMock.EXPECT().VerifyImageExists(gomock.Any()).Return(fmt.Errorf("image not found"))
+	expectQuotaChecks(mocks)
+
+	checks, err := newTestAccountDoctorOpts(mocks, accountDoctorVars{}).Execute()
+	require.Error(t, err)
+	assert.Equal(t, checkStatusFail, checkStatuses(checks)["ECR image 'WES'"])
+}
+
+func TestAccountDoctorOpts_Execute_Quotas(t *testing.T) {
+	testCases := map[string]struct {
+		publicSubnets    bool
+		coreStackExists  bool
+		setupMocks       func(mockClients)
+		expectedStatuses map[string]string
+	}{
+		"VPC quota exhausted": {
+			setupMocks: func(mocks mockClients) {
+				mocks.quotasMock.EXPECT().GetQuotaValue(vpcServiceCode, vpcsPerRegionQuota).Return(float64(5), nil)
+				mocks.ec2Mock.EXPECT().CountVpcs().Return(5, nil)
+				mocks.quotasMock.EXPECT().GetQuotaValue(ec2ServiceCode, elasticIpsQuota).Return(float64(5), nil)
+				mocks.ec2Mock.EXPECT().CountElasticIps().Return(2, nil)
+			},
+			expectedStatuses: map[string]string{"VPCs per region": checkStatusFail, "Elastic IP addresses": checkStatusPass},
+		},
+		"not enough elastic IPs": {
+			setupMocks: func(mocks mockClients) {
+				mocks.quotasMock.EXPECT().GetQuotaValue(vpcServiceCode, vpcsPerRegionQuota).Return(float64(5), nil)
+				mocks.ec2Mock.EXPECT().CountVpcs().Return(1, nil)
+				mocks.quotasMock.EXPECT().GetQuotaValue(ec2ServiceCode, elasticIpsQuota).Return(float64(5), nil)
+				mocks.ec2Mock.EXPECT().CountElasticIps().Return(3, nil)
+			},
+			expectedStatuses: map[string]string{"VPCs per region": checkStatusPass, "Elastic IP addresses": checkStatusFail},
+		},
+		"public subnets skip elastic IPs": {
+			publicSubnets: true,
+			setupMocks: func(mocks mockClients) {
+				mocks.quotasMock.EXPECT().GetQuotaValue(vpcServiceCode, vpcsPerRegionQuota).Return(float64(5), nil)
+				mocks.ec2Mock.EXPECT().CountVpcs().Return(1, nil)
+			},
+			expectedStatuses: map[string]string{"VPCs per region": checkStatusPass, "Elastic IP addresses": ""},
+		},
+		"quota lookup error warns": {
+			setupMocks: func(mocks mockClients) {
+				mocks.quotasMock.EXPECT().GetQuotaValue(vpcServiceCode, vpcsPerRegionQuota).Return(float64(0), fmt.Errorf("access denied"))
+				mocks.quotasMock.EXPECT().GetQuotaValue(ec2ServiceCode, elasticIpsQuota).Return(float64(5), nil)
+				mocks.ec2Mock.EXPECT().CountElasticIps().Return(0, nil)
+			},
+			expectedStatuses: map[string]string{"VPCs per region": checkStatusWarn, "Elastic IP addresses": checkStatusPass},
+		},
+		"existing core stack skips quotas": {
+			coreStackExists:  true,
+			setupMocks:       func(mocks mockClients) {},
+			expectedStatuses: map[string]string{"VPCs per region": checkStatusPass, "Elastic IP addresses": checkStatusPass},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			mocks := createMocks(t)
+			defer mocks.ctrl.Finish()
+			mocks.stsMock.EXPECT().GetAccount().Return(testAccountId, nil)
+			mocks.s3Mock.EXPECT().BucketExists(testDefaultAccountBucketName).Return(false, nil)
+			expectImageChecks(mocks)
+			if tc.coreStackExists {
+				mocks.cfnMock.EXPECT().GetStackInfo(awsresources.RenderCoreStackName()).Return(cfn.StackInfo{}, nil)
+			} else {
+				mocks.cfnMock.EXPECT().GetStackInfo(awsresources.RenderCoreStackName()).Return(cfn.StackInfo{}, cfn.StackDoesNotExistError)
+			}
+			tc.setupMocks(mocks)
+
+			checks, _ := newTestAccountDoctorOpts(mocks, accountDoctorVars{publicSubnets: tc.publicSubnets}).Execute()
+			statuses := checkStatuses(checks)
+			for check, expectedStatus := range tc.expectedStatuses {
+				assert.Equal(t, expectedStatus, statuses[check], check)
+			}
+		})
+	}
+}
@@ -0,0 +1,432 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	ctx "context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/aws/amazon-genomics-cli/internal/pkg/aws"
+	"github.com/aws/amazon-genomics-cli/internal/pkg/aws/cdk"
+	"github.com/aws/amazon-genomics-cli/internal/pkg/aws/cfn"
+	"github.com/aws/amazon-genomics-cli/internal/pkg/aws/ddb"
+	"github.com/aws/amazon-genomics-cli/internal/pkg/aws/ec2"
+	"github.com/aws/amazon-genomics-cli/internal/pkg/aws/s3"
+	"github.com/aws/amazon-genomics-cli/internal/pkg/aws/ssm"
+	"github.com/aws/amazon-genomics-cli/internal/pkg/aws/sts"
+	"github.com/aws/amazon-genomics-cli/internal/pkg/cli/awsresources"
+	"github.com/aws/amazon-genomics-cli/internal/pkg/cli/clierror"
+	"github.com/aws/amazon-genomics-cli/internal/pkg/cli/clierror/actionableerror"
+	"github.com/aws/amazon-genomics-cli/internal/pkg/cli/config"
+	"github.com/aws/amazon-genomics-cli/internal/pkg/cli/context"
+	"github.com/aws/amazon-genomics-cli/internal/pkg/cli/format"
+	"github.com/aws/amazon-genomics-cli/internal/pkg/cli/types"
+	"github.com/aws/amazon-genomics-cli/internal/pkg/constants"
+	"github.com/aws/amazon-genomics-cli/internal/pkg/storage"
+	"github.com/aws/amazon-genomics-cli/internal/pkg/version"
+	"github.com/rs/zerolog/log"
+	"github.com/spf13/cobra"
+)
+
+const (
+	upgradePlanFlag            = "plan"
+	upgradePlanFlagDescription = "Only show what would be upgraded, without deploying anything."
+	accountUpgradeCommand      = "account upgrade"
+
+	upgradeActionUpgrade = "UPGRADE"
+	upgradeActionNone    = "NONE"
+	upgradeActionManual  = "MANUAL"
+	upgradeResultDone    = "UPGRADED"
+	upgradeResultFailed  = "FAILED"
+	upgradeResultSkipped = "SKIPPED"
+
+	cfnStackNameTagKey = "aws:cloudformation:stack-name"
+)
+
+type accountUpgradeVars struct {
+	plan bool
+}
+
+type accountUpgradeOpts struct {
+	accountUpgradeVars
+	cfnClient    cfn.Interface
+	ssmClient    ssm.Interface
+	ec2Client    ec2.Interface
+	stsClient    sts.Interface
+	s3Client     s3.Interface
+	cdkClient    cdk.Interface
+	ddbClient    ddb.Interface
+	configClient storage.ConfigClient
+	ctxManager   context.Interface
+	region       string
+
+	// newDoctor creates the pre-flight checks run before the core infrastructure is redeployed
+	newDoctor func(vars accountDoctorVars) (*accountDoctorOpts, error)
+}
+
+func newAccountUpgradeOpts(vars accountUpgradeVars) (*accountUpgradeOpts, error) {
+	configClient, err := config.NewConfigClient()
+	if err != nil {
+		return nil, err
+	}
+	return &accountUpgradeOpts{
+		accountUpgradeVars: vars,
+		cfnClient:          aws.CfnClient(profile),
+		ssmClient:          aws.SsmClient(profile),
+		ec2Client:          aws.Ec2Client(profile),
+		stsClient:          aws.StsClient(profile),
+		s3Client:           aws.S3Client(profile),
+		cdkClient:          cdk.NewClient(profile),
+		ddbClient:          aws.DdbClient(profile),
+		configClient:       configClient,
+		ctxManager:         context.NewManager(profile),
+		region:             aws.Region(profile),
+		newDoctor:          newAccountDoctorOpts,
+	}, nil
+}
+
+// Execute upgrades the core infrastructure and the contexts of the current user to the version of this CLI.
+// In plan mode only the steps that would be taken are returned.
+func (o *accountUpgradeOpts) Execute() ([]types.AccountUpgradeStep, error) {
+	activateVars, coreStep, err := o.planCoreUpgrade()
+	if err != nil {
+		return nil, err
+	}
+	contextSteps, err := o.planContextUpgrades()
+	if err != nil {
+		return nil, err
+	}
+	if o.plan {
+		return append([]types.AccountUpgradeStep{coreStep}, contextSteps...), nil
+	}
+
+	if coreStep.Action == upgradeActionUpgrade {
+		if err := o.activateCore(activateVars); err != nil {
+			coreStep.Result = upgradeResultFailed
+			coreStep.Details = err.Error()
+			for i := range contextSteps {
+				if contextSteps[i].Action == upgradeActionUpgrade {
+					contextSteps[i].Result = upgradeResultSkipped
+				}
+			}
+			return append([]types.AccountUpgradeStep{coreStep}, contextSteps...), upgradeFailedError(1)
+		}
+		coreStep.Result = upgradeResultDone
+	}
+
+	failures := o.upgradeContexts(contextSteps)
+	steps := append([]types.AccountUpgradeStep{coreStep}, contextSteps...)
+	if failures > 0 {
+		return steps, upgradeFailedError(failures)
+	}
+	return steps, nil
+}
+
+// planCoreUpgrade reads the settings the core infrastructure was activated with so that it can be redeployed with them
+func (o *accountUpgradeOpts) planCoreUpgrade() (accountActivateVars, types.AccountUpgradeStep, error) {
+	coreStackName := awsresources.RenderCoreStackName()
+	stackInfo, err := o.cfnClient.GetStackInfo(coreStackName)
+	if err != nil {
+		if errors.Is(err, cfn.StackDoesNotExistError) {
+			return accountActivateVars{}, types.AccountUpgradeStep{}, actionableerror.New(
+				fmt.Errorf("AGC is not activated in region '%s'", o.region),
+				"Run 'agc account activate' to activate AGC in this region",
+			)
+		}
+		return accountActivateVars{}, types.AccountUpgradeStep{}, err
+	}
+
+	vars, err := o.readActivateVars(coreStackName)
+	if err != nil {
+		return accountActivateVars{}, types.AccountUpgradeStep{}, err
+	}
+	deployedVersion := stackInfo.Tags[constants.AgcVersionKey]
+	step := types.AccountUpgradeStep{
+		Stack:           coreStackName,
+		DeployedVersion: deployedVersion,
+		TargetVersion:   version.Version,
+		Action:          upgradeAction(deployedVersion),
+		Details:         describeActivateVars(vars),
+	}
+	return vars, step, nil
+}
+
+func (o *accountUpgradeOpts) readActivateVars(coreStackName string) (accountActivateVars, error) {
+	var vars accountActivateVars
+	var err error
+	if vars.bucketName, err = o.ssmClient.GetCommonParameter(bucketParameterName); err != nil {
+		return vars, err
+	}
+	if customTags := o.ssmClient.GetCustomTags(); customTags != "" {
+		if err = json.Unmarshal([]byte(customTags), &vars.customTags); err != nil {
+			return vars, fmt.Errorf("unable to read the custom tags of the core infrastructure: %w", err)
+		}
+	}
+
+	vpcId, err := o.ssmClient.GetCommonParameter(vpcParameterName)
+	if err != nil {
+		return vars, err
+	}
+	vpcTags, err := o.ec2Client.GetVpcTags(vpcId)
+	if err != nil {
+		return vars, err
+	}
+	if vpcTags[cfnStackNameTagKey] == coreStackName {
+		// the VPC was created by AGC, a VPC with only public subnets is created with '--usePublicSubnets'
+		subnets, err := o.ec2Client.GetVpcSubnets(vpcId)
+		if err != nil {
+			return vars, err
+		}
+		vars.publicSubnets = !hasPrivateSubnet(subnets)
+	} else {
+		vars.vpcId = vpcId
+		subnets, err := o.ssmClient.GetCommonParameter(subnetsParameterName)
+		if err != nil {
+			return vars, err
+		}
+		vars.subnets = strings.Split(subnets, ",")
+	}
+
+	// a custom AMI must be owned by the account, otherwise the latest ECS optimized AMI was used
+	amiId, err := o.ssmClient.GetCommonParameter(amiParameterName)
+	if err != nil {
+		return vars, err
+	}
+	account, err := o.stsClient.GetAccount()
+	if err != nil {
+		return vars, err
+	}
+	amiOwner, err := o.ec2Client.GetImageOwnerId(amiId)
+	if err != nil {
+		return vars, err
+	}
+	if amiOwner == account {
+		vars.amiId = amiId
+	}
+	return vars, nil
+}
+
+func (o *accountUpgradeOpts) planContextUpgrades() ([]types.AccountUpgradeStep, error) {
+	userId, err := o.configClient.GetUserId()
+	if err != nil {
+		return nil, err
+	}
+	var steps []types.AccountUpgradeStep
+	for _, ownerId := range []string{userId, awsresources.SharedContextOwnerId} {
+		ownerSteps, err := o.planOwnerContextUpgrades(ownerId)
+		if err != nil {
+			return nil, err
+		}
+		steps = append(steps, ownerSteps...)
+	}
+	sort.Slice(steps, func(i, j int) bool {
+		if steps[i].Project != steps[j].Project {
+			return steps[i].Project < steps[j].Project
+		}
+		if steps[i].Context != steps[j].Context {
+			return steps[i].Context < steps[j].Context
+		}
+		return steps[i].Owner < steps[j].Owner
+	})
+	return steps, nil
+}
+
+// planOwnerContextUpgrades lists the deployed contexts of an owner. Contexts deployed before their deployments were
+// recorded cannot be redeployed without their project and are left to be redeployed manually.
+func (o *accountUpgradeOpts) planOwnerContextUpgrades(ownerId string) ([]types.AccountUpgradeStep, error) {
+	stackNameRegexp := regexp.MustCompile(awsresources.RenderUserContextStackNameRegexp(ownerId))
+	stacks, err := o.cfnClient.ListStacks(stackNameRegexp, cfn.ActiveStacksFilter)
+	if err != nil {
+		return nil, err
+	}
+
+	steps := make([]types.AccountUpgradeStep, 0, len(stacks))
+	for _, stack := range stacks {
+		tags, err := o.cfnClient.GetStackTags(stack.Id)
+		if err != nil {
+			return nil, err
+		}
+		matches := stackNameRegexp.FindStringSubmatch(stack.Name)
+		deployedVersion := tags[constants.AgcVersionKey]
+		step := types.AccountUpgradeStep{
+			Stack:           stack.Name,
+			Project:         matches[1],
+			Context:         matches[2],
+			Owner:           ownerId,
+			DeployedVersion: deployedVersion,
+			TargetVersion:   version.Version,
+			Action:          upgradeAction(deployedVersion),
+		}
+		if step.Action == upgradeActionUpgrade {
+			records, err := o.ddbClient.ListContextDeployments(ctx.Background(), step.Project, ownerId, step.Context)
+			if err != nil {
+				return nil, err
+			}
+			if len(records) == 0 {
+				step.Action = upgradeActionManual
+				step.Details = fmt.Sprintf("no deployment has been recorded, run 'agc context deploy %s' from the directory of project '%s'", step.Context, step.Project)
+			}
+		}
+		steps = append(steps, step)
+	}
+	return steps, nil
+}
+
+func (o *accountUpgradeOpts) activateCore(vars accountActivateVars) error {
+	log.Info().Msgf("Upgrading the core infrastructure with %s", describeActivateVars(vars))
+	doctor, err := o.newDoctor(accountDoctorVars{
+		bucketName:    vars.bucketName,
+		vpcId:         vars.vpcId,
+		publicSubnets: vars.publicSubnets,
+		subnets:       vars.subnets,
+	})
+	if err != nil {
+		return err
+	}
+	activateOpts := &accountActivateOpts{
+		accountActivateVars: vars,
+		stsClient:           o.stsClient,
+		s3Client:            o.s3Client,
+		cdkClient:           o.cdkClient,
+		region:              o.region,
+		doctor:              doctor,
+	}
+	return activateOpts.Execute()
+}
+
+// upgradeContexts redeploys the contexts that need an upgrade one project and owner at a time and returns the number of failures
+func (o *accountUpgradeOpts) upgradeContexts(steps []types.AccountUpgradeStep) int {
+	type projectOwner struct{ project, owner string }
+	var groups []projectOwner
+	groupSteps := make(map[projectOwner][]int)
+	for i, step := range steps {
+		if step.Action != upgradeActionUpgrade {
+			continue
+		}
+		group := projectOwner{step.Project, step.Owner}
+		if _, ok := groupSteps[group]; !ok {
+			groups = append(groups, group)
+		}
+		groupSteps[group] = append(groupSteps[group], i)
+	}
+
+	failures := 0
+	for _, group := range groups {
+		contexts := make([]string, len(groupSteps[group]))
+		stepsByContext := make(map[string]*types.AccountUpgradeStep, len(contexts))
+		for i, stepIndex := range groupSteps[group] {
+			contexts[i] = steps[stepIndex].Context
+			stepsByContext[contexts[i]] = &steps[stepIndex]
+		}
+		log.Info().Msgf("Upgrading context(s) %s of project '%s' owned by '%s'", contexts, group.project, group.owner)
+		for _, result := range o.ctxManager.Upgrade(group.project, group.owner, contexts) {
+			step, ok := stepsByContext[result.Context]
+			if !ok {
+				continue
+			}
+			if result.Err != nil {
+				step.Result = upgradeResultFailed
+				step.Details = result.Err.Error()
+				failures++
+			} else {
+				step.Result = upgradeResultDone
+			}
+		}
+	}
+	return failures
+}
+
+func upgradeAction(deployedVersion string) string {
+	if deployedVersion == version.Version {
+		return upgradeActionNone
+	}
+	return upgradeActionUpgrade
+}
+
+func hasPrivateSubnet(subnets []ec2.Subnet) bool {
+	for _, subnet := range subnets {
+		if subnet.IsPrivate() {
+			return true
+		}
+	}
+	return false
+}
+
+func describeActivateVars(vars accountActivateVars) string {
+	settings := []string{fmt.Sprintf("bucket=%s", vars.bucketName)}
+	if vars.vpcId != "" {
+		settings = append(settings, fmt.Sprintf("vpc=%s", vars.vpcId), fmt.Sprintf("subnets=%s", strings.Join(vars.subnets, ",")))
+	}
+	if vars.publicSubnets {
+		settings = append(settings, "usePublicSubnets=true")
+	}
+	if vars.amiId != "" {
+		settings = append(settings, fmt.Sprintf("ami=%s", vars.amiId))
+	}
+	if len(vars.customTags) > 0 {
+		keys := make([]string, 0, len(vars.customTags))
+		for key := range vars.customTags {
+			keys = append(keys, key)
+		}
+		sort.Strings(keys)
+		tags := make([]string, len(keys))
+		for i, key := range keys {
+			tags[i] = fmt.Sprintf("%s=%s", key, vars.customTags[key])
+		}
+		settings = append(settings, fmt.Sprintf("tags=%s", strings.Join(tags, ",")))
+	}
+	return strings.Join(settings, " ")
+}
+
+func upgradeFailedError(failures int) error {
+	return actionableerror.New(
+		fmt.Errorf("%d stack(s) failed to upgrade", failures),
+		"Review the reported failures, fix their cause and run 'agc account upgrade' again",
+	)
+}
+
+// BuildAccountUpgradeCommand builds the command for upgrading AGC in an AWS account.
+func BuildAccountUpgradeCommand() *cobra.Command {
+	vars := accountUpgradeVars{}
+	cmd := &cobra.Command{
+		Use:   "upgrade",
+		Short: "Upgrade AGC in an AWS account to the version of this CLI.",
+		Long: `upgrade redeploys the core infrastructure with the settings it was activated with,
+then redeploys each of your deployed contexts and each shared context, in every project, with
+the configuration recorded for its latest deployment. Stacks already at the version of this CLI
+are left as they are. Contexts deployed before their deployments were recorded are reported with
+the action MANUAL and must be redeployed with 'agc context deploy' from their project.
+Only the region and account of the profile are upgraded; upgrade contexts that target another
+region or account by running this command with a profile for that region or account.
+Use --plan to only list what would be upgraded.
+
+` + DescribeOutput([]types.AccountUpgradeStep{}),
+		Example: `
+Show what would be upgraded.
+/code $ agc account upgrade --plan`,
+		Args: cobra.NoArgs,
+		RunE: runCmdE(func(cmd *cobra.Command, args []string) error {
+			opts, err := newAccountUpgradeOpts(vars)
+			if err != nil {
+				return err
+			}
+			log.Info().Msgf("Upgrading AGC in region '%s' to version '%s'", opts.region, version.Version)
+			steps, err := opts.Execute()
+			if steps != nil {
+				format.Default.Write(steps)
+			}
+			if err != nil {
+				return clierror.New(accountUpgradeCommand, vars, err)
+			}
+			return nil
+		}),
+	}
+	cmd.Flags().BoolVar(&vars.plan, upgradePlanFlag, false, upgradePlanFlagDescription)
+	return cmd
+}
@@ -0,0 +1,196 @@
+package cli
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/aws/amazon-genomics-cli/internal/pkg/aws/cfn"
+	"github.com/aws/amazon-genomics-cli/internal/pkg/aws/ddb"
+	"github.com/aws/amazon-genomics-cli/internal/pkg/aws/ec2"
+	"github.com/aws/amazon-genomics-cli/internal/pkg/cli/awsresources"
+	"github.com/aws/amazon-genomics-cli/internal/pkg/cli/context"
+	"github.com/aws/amazon-genomics-cli/internal/pkg/cli/types"
+	"github.com/aws/amazon-genomics-cli/internal/pkg/constants"
+	"github.com/aws/amazon-genomics-cli/internal/pkg/logging"
+	contextmocks "github.com/aws/amazon-genomics-cli/internal/pkg/mocks/context"
+	"github.com/aws/amazon-genomics-cli/internal/pkg/version"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	testUpgradeUserId      = "bender123"
+	testUpgradeOldVersion  = "1.4.0"
+	testUpgradeProjectA    = "projectA"
+	testUpgradeProjectB    = "projectB"
+	testUpgradeCoreDetails = "bucket=test-account-bucket vpc=test-account-vpc-id subnets=test-account-subnet-id-1,test-account-subnet-id-2 tags=team=genomics"
+)
+
+func newTestAccountUpgradeOpts(mocks mockClients, ctxMock *contextmocks.MockContextManager, plan bool) *accountUpgradeOpts {
+	return &accountUpgradeOpts{
+		accountUpgradeVars: accountUpgradeVars{plan: plan},
+		cfnClient:          mocks.cfnMock,
+		ssmClient:          mocks.ssmMock,
+		ec2Client:          mocks.ec2Mock,
+		stsClient:          mocks.stsMock,
+		s3Client:           mocks.s3Mock,
+		cdkClient:          mocks.cdkMock,
+		ddbClient:          mocks.ddbMock,
+		configClient:       mocks.configMock,
+		ctxManager:         ctxMock,
+		region:             testAccountRegion,
+		newDoctor: func(accountDoctorVars) (*accountDoctorOpts, error) {
+			return nil, nil
+		},
+	}
+}
+
+// expectUpgradeDiscovery sets up a core stack activated with a custom VPC, three contexts of the user in two projects
+// and a shared context deployed before its deployments were recorded
+func expectUpgradeDiscovery(mocks mockClients, coreVersion string) {
+	mocks.cfnMock.EXPECT().GetStackInfo(testCoreStackName).
+		Return(cfn.StackInfo{Tags: map[string]string{constants.AgcVersionKey: coreVersion}}, nil)
+	mocks.ssmMock.EXPECT().GetCommonParameter(bucketParameterName).Return(testAccountBucketName, nil)
+	mocks.ssmMock.EXPECT().GetCustomTags().Return(`{"team":"genomics"}`)
+	mocks.ssmMock.EXPECT().GetCommonParameter(vpcParameterName).Return(testAccountVpcId, nil)
+	mocks.ec2Mock.EXPECT().GetVpcTags(testAccountVpcId).Return(map[string]string{"Name": "my-vpc"}, nil)
+	mocks.ssmMock.EXPECT().GetCommonParameter(subnetsParameterName).Return(testAccountSubnetId1+","+testAccountSubnetId2, nil)
+	mocks.ssmMock.EXPECT().GetCommonParameter(amiParameterName).Return(testAmiId, nil)
+	mocks.stsMock.EXPECT().GetAccount().Return(testAccountId, nil)
+	mocks.ec2Mock.EXPECT().GetImageOwnerId(testAmiId).Return("amazon", nil)
+
+	mocks.configMock.EXPECT().GetUserId().Return(testUpgradeUserId, nil)
+	mocks.cfnMock.EXPECT().ListStacks(regexp.MustCompile("^Agc-Context-(.+)-bender123-([^\\-]+)$"), cfn.ActiveStacksFilter).
+		Return([]cfn.Stack{
+			{Id: "id-b", Name: "Agc-Context-projectB-bender123-ctx"},
+			{Id: "id-a1", Name: "Agc-Context-projectA-bender123-ctx2"},
+			{Id: "id-a2", Name: "Agc-Context-projectA-bender123-ctx1"},
+		}, nil)
+	mocks.cfnMock.EXPECT().GetStackTags("id-b").Return(map[string]string{constants.AgcVersionKey: version.Version}, nil)
+	mocks.cfnMock.EXPECT().GetStackTags("id-a1").Return(map[string]string{constants.AgcVersionKey: testUpgradeOldVersion}, nil)
+	mocks.cfnMock.EXPECT().GetStackTags("id-a2").Return(map[string]string{constants.AgcVersionKey: testUpgradeOldVersion}, nil)
+	mocks.ddbMock.EXPECT().ListContextDeployments(gomock.Any(), testUpgradeProjectA, testUpgradeUserId, "ctx1").Return([]ddb.ContextDeployment{{}}, nil)
+	mocks.ddbMock.EXPECT().ListContextDeployments(gomock.Any(), testUpgradeProjectA, testUpgradeUserId, "ctx2").Return([]ddb.ContextDeployment{{}}, nil)
+
+	mocks.cfnMock.EXPECT().ListStacks(regexp.MustCompile("^Agc-Context-(.+)-shared-([^\\-]+)$"), cfn.ActiveStacksFilter).
+		Return([]cfn.Stack{{Id: "id-s", Name: "Agc-Context-projectA-shared-ctx1"}}, nil)
+	mocks.cfnMock.EXPECT().GetStackTags("id-s").Return(map[string]string{constants.AgcVersionKey: testUpgradeOldVersion}, nil)
+	mocks.ddbMock.EXPECT().ListContextDeployments(gomock.Any(), testUpgradeProjectA, awsresources.SharedContextOwnerId, "ctx1").Return(nil, nil)
+}
+
+func TestAccountUpgradeOpts_Execute_Plan(t *testing.T) {
+	mocks := createMocks(t)
+	defer mocks.ctrl.Finish()
+	ctxMock := contextmocks.NewMockContextManager(mocks.ctrl)
+	expectUpgradeDiscovery(mocks, testUpgradeOldVersion)
+
+	steps, err := newTestAccountUpgradeOpts(mocks, ctxMock, true).Execute()
+
+	require.NoError(t, err)
+	assert.Equal(t, []types.AccountUpgradeStep{
+		{Stack: testCoreStackName, DeployedVersion: testUpgradeOldVersion, TargetVersion: version.Version, Action: upgradeActionUpgrade, Details: testUpgradeCoreDetails},
+		{Stack: "Agc-Context-projectA-bender123-ctx1", Project: testUpgradeProjectA, Context: "ctx1", Owner: testUpgradeUserId, DeployedVersion: testUpgradeOldVersion, TargetVersion: version.Version, Action: upgradeActionUpgrade},
+		{Stack: "Agc-Context-projectA-shared-ctx1", Project: testUpgradeProjectA, Context: "ctx1", Owner: awsresources.SharedContextOwnerId, DeployedVersion: testUpgradeOldVersion, TargetVersion: version.Version, Action: upgradeActionManual,
+			Details: "no deployment has been recorded, run 'agc context deploy ctx1' from the directory of project 'projectA'"},
+		{Stack: "Agc-Context-projectA-bender123-ctx2", Project: testUpgradeProjectA, Context: "ctx2", Owner: testUpgradeUserId, DeployedVersion: testUpgradeOldVersion, TargetVersion: version.Version, Action: upgradeActionUpgrade},
+		{Stack: "Agc-Context-projectB-bender123-ctx", Project: testUpgradeProjectB, Context: "ctx", Owner: testUpgradeUserId, DeployedVersion: version.Version, TargetVersion: version.Version, Action: upgradeActionNone},
+	}, steps)
+}
+
+func TestAccountUpgradeOpts_Execute_Upgrade(t *testing.T) {
+	origVerbose := logging.Verbose
+	defer func() { logging.Verbose = origVerbose }()
+	logging.Verbose = true
+	mocks := createMocks(t)
+	defer mocks.ctrl.Finish()
+	close(mocks.progressStream)
+	ctxMock := contextmocks.NewMockContextManager(mocks.ctrl)
+	expectUpgradeDiscovery(mocks, testUpgradeOldVersion)
+	mocks.s3Mock.EXPECT().BucketExists(testAccountBucketName).Return(true, nil)
+	mocks.cdkMock.EXPECT().Bootstrap(gomock.Any(), gomock.Any(), "bootstrap").Return(mocks.progressStream, nil)
+	mocks.cdkMock.EXPECT().DeployApp(gomock.Any(), gomock.Any(), "activate").Return(mocks.progressStream, nil)
+	ctxMock.EXPECT().Upgrade(testUpgradeProjectA, testUpgradeUserId, []string{"ctx1", "ctx2"}).
+		Return([]context.ProgressResult{{Context: "ctx1"}, {Context: "ctx2", Err: fmt.Errorf("some deploy error")}})
+
+	steps, err := newTestAccountUpgradeOpts(mocks, ctxMock, false).Execute()
+
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "1 stack(s) failed to upgrade")
+	require.Len(t, steps, 5)
+	assert.Equal(t, upgradeResultDone, steps[0].Result)
+	assert.Equal(t, upgradeResultDone, steps[1].Result)
+	assert.Equal(t, "", steps[2].Result)
+	assert.Equal(t, upgradeResultFailed, steps[3].Result)
+	assert.Equal(t, "some deploy error", steps[3].Details)
+	assert.Equal(t, "", steps[4].Result)
+}
+
+func TestAccountUpgradeOpts_Execute_CoreFailureSkipsContexts(t *testing.T) {
+	mocks := createMocks(t)
+	defer mocks.ctrl.Finish()
+	ctxMock := contextmocks.NewMockContextManager(mocks.ctrl)
+	expectUpgradeDiscovery(mocks, testUpgradeOldVersion)
+	mocks.s3Mock.EXPECT().BucketExists(testAccountBucketName).Return(false, fmt.Errorf("some bucket error"))
+
+	steps, err := newTestAccountUpgradeOpts(mocks, ctxMock, false).Execute()
+
+	require.Error(t, err)
+	require.Len(t, steps, 5)
+	assert.Equal(t, upgradeResultFailed, steps[0].Result)
+	assert.Equal(t, upgradeResultSkipped, steps[1].Result)
+	assert.Equal(t, "", steps[2].Result)
+	assert.Equal(t, upgradeResultSkipped, steps[3].Result)
+	assert.Equal(t, "", steps[4].Result)
+}
+
+func TestAccountUpgradeOpts_ReadActivateVars_AgcVpc(t *testing.T) {
+	testCases := map[string]struct {
+		subnets               []ec2.Subnet
+		expectedPublicSubnets bool
+	}{
+		"private subnets": {
+			subnets: []ec2.Subnet{{Id: testAccountSubnetId1, HasInternetGatewayRoute: true}, {Id: testAccountSubnetId2, HasNatRoute: true}},
+		},
+		"only public subnets": {
+			subnets:               []ec2.Subnet{{Id: testAccountSubnetId1, HasInternetGatewayRoute: true}},
+			expectedPublicSubnets: true,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			mocks := createMocks(t)
+			defer mocks.ctrl.Finish()
+			mocks.ssmMock.EXPECT().GetCommonParameter(bucketParameterName).Return(testAccountBucketName, nil)
+			mocks.ssmMock.EXPECT().GetCustomTags().Return("")
+			mocks.ssmMock.EXPECT().GetCommonParameter(vpcParameterName).Return(testAccountVpcId, nil)
+			mocks.ec2Mock.EXPECT().GetVpcTags(testAccountVpcId).Return(map[string]string{cfnStackNameTagKey: testCoreStackName}, nil)
+			mocks.ec2Mock.EXPECT().GetVpcSubnets(testAccountVpcId).Return(tc.subnets, nil)
+			mocks.ssmMock.EXPECT().GetCommonParameter(amiParameterName).Return(testAmiId, nil)
+			mocks.stsMock.EXPECT().GetAccount().Return(testAccountId, nil)
+			mocks.ec2Mock.EXPECT().GetImageOwnerId(testAmiId).Return(testAccountId, nil)
+
+			vars, err := newTestAccountUpgradeOpts(mocks, nil, true).readActivateVars(testCoreStackName)
+
+			require.NoError(t, err)
+			assert.Equal(t, accountActivateVars{
+				bucketName:    testAccountBucketName,
+				publicSubnets: tc.expectedPublicSubnets,
+				amiId:         testAmiId,
+			}, vars)
+		})
+	}
+}
+
+func TestAccountUpgradeOpts_Execute_NotActivated(t *testing.T) {
+	mocks := createMocks(t)
+	defer mocks.ctrl.Finish()
+	mocks.cfnMock.EXPECT().GetStackInfo(testCoreStackName).Return(cfn.StackInfo{}, cfn.StackDoesNotExistError)
+
+	_, err := newTestAccountUpgradeOpts(mocks, nil, true).Execute()
+
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "AGC is not activated in region 'test-account-region'")
+}
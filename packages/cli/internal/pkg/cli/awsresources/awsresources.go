@@ -3,10 +3,23 @@ package awsresources
 import (
 	"fmt"
 	"path"
+	"regexp"
 
 	"github.com/aws/amazon-genomics-cli/internal/pkg/constants"
 )
 
+// SharedContextOwnerId is used in place of a user id for contexts that are shared by all users of a project.
+// Generated user ids always end with a hash of the email address, so they do not collide with it in practice.
+const SharedContextOwnerId = "shared"
+
+// RenderContextOwnerId returns the id that owns the resources of a context, which is the user id unless the context is shared
+func RenderContextOwnerId(userId string, isShared bool) string {
+	if isShared {
+		return SharedContextOwnerId
+	}
+	return userId
+}
+
 func RenderContextStackName(projectName, contextName, userId string) string {
 	return fmt.Sprintf("%s-Context-%s-%s-%s", constants.ProductName, projectName, userId, contextName)
 }
@@ -19,6 +32,12 @@ func RenderContextStackNameRegexp(projectName, userId string) string {
 	return fmt.Sprintf("^%s-Context-%s-%s-([^\\-]+)$", constants.ProductName, projectName, userId)
 }
 
+// RenderUserContextStackNameRegexp matches the context stacks of a user in all projects. The first group
+// captures the project name and the second group captures the context name.
+func RenderUserContextStackNameRegexp(userId string) string {
+	return fmt.Sprintf("^%s-Context-(.+)-%s-([^\\-]+)$", constants.ProductName, regexp.QuoteMeta(userId))
+}
+
 func RenderBucketContextKey(projectName, userId, contextName string, suffix ...string) string {
 	args := append([]string{"project", projectName, "userid", userId, "context", contextName}, suffix...)
 	return path.Join(args...)
@@ -4,6 +4,7 @@
 package cli
 
 import (
+	"fmt"
 	"os"
 	"strings"
 
@@ -12,6 +13,28 @@ import (
 
 var profile string
 
+// defaultContextName is the context of commands that are run without a context flag
+var defaultContextName string
+
+// SetProfileDefaults applies the AWS profile and the default context of the active configuration profile.
+// An AWS profile given with a flag takes precedence.
+func SetProfileDefaults(awsProfile, contextName string) {
+	if profile == "" {
+		profile = awsProfile
+	}
+	defaultContextName = contextName
+}
+
+func useDefaultContext(contextName *string) error {
+	if *contextName == "" {
+		*contextName = defaultContextName
+	}
+	if *contextName == "" {
+		return fmt.Errorf("required flag \"%s\" not set and the configuration profile has no default context", contextFlag)
+	}
+	return nil
+}
+
 func sanitizeProjectName(projectName string) string {
 	return strings.Replace(projectName, "-", "", -1)
 }
@@ -17,6 +17,13 @@ type mockClients struct {
 	cdkMock        *awsmocks.MockCdkClient
 	ecrMock        *awsmocks.MockEcrClient
 	cfnMock        *awsmocks.MockCfnClient
+	ec2Mock        *awsmocks.MockEc2Client
+	quotasMock     *awsmocks.MockServiceQuotasClient
+	ssmMock        *awsmocks.MockSsmClient
+	cwlMock        *awsmocks.MockCwlClient
+	ddbMock        *awsmocks.MockDdbClient
+	efsMock        *awsmocks.MockEfsClient
+	taggingMock    *awsmocks.MockTaggingClient
 	configMock     *storagemocks.MockConfigClient
 	progressStream cdk.ProgressStream
 }
@@ -31,6 +38,13 @@ func createMocks(t *testing.T) mockClients {
 		stsMock:        awsmocks.NewMockStsClient(ctrl),
 		ecrMock:        awsmocks.NewMockEcrClient(ctrl),
 		cfnMock:        awsmocks.NewMockCfnClient(ctrl),
+		ec2Mock:        awsmocks.NewMockEc2Client(ctrl),
+		quotasMock:     awsmocks.NewMockServiceQuotasClient(ctrl),
+		ssmMock:        awsmocks.NewMockSsmClient(ctrl),
+		cwlMock:        awsmocks.NewMockCwlClient(ctrl),
+		ddbMock:        awsmocks.NewMockDdbClient(ctrl),
+		efsMock:        awsmocks.NewMockEfsClient(ctrl),
+		taggingMock:    awsmocks.NewMockTaggingClient(ctrl),
 		configMock:     storagemocks.NewMockConfigClient(ctrl),
 		progressStream: make(cdk.ProgressStream),
 	}
@@ -9,7 +9,36 @@ type User struct {
 type Format struct {
 	Name string
 }
+
+// Credentials describe an IAM role that AGC assumes with the credentials of the AWS profile
+type Credentials struct {
+	RoleArn         string `yaml:"roleArn,omitempty"`
+	ExternalId      string `yaml:"externalId,omitempty"`
+	SessionName     string `yaml:"sessionName,omitempty"`
+	SessionDuration string `yaml:"sessionDuration,omitempty"`
+}
+
+// Network describes the HTTP proxy and the additional certificate authorities that AGC connects to AWS with
+type Network struct {
+	Proxy    string `yaml:"proxy,omitempty"`
+	CaBundle string `yaml:"caBundle,omitempty"`
+}
+
+// Config holds the settings of a single configuration profile
 type Config struct {
-	User   User   `yaml:"user"`
-	Format Format `yaml:"format"`
+	// Profile is the name of the configuration profile the settings were read from
+	Profile        string      `yaml:"-"`
+	User           User        `yaml:"user"`
+	Format         Format      `yaml:"format"`
+	Credentials    Credentials `yaml:"credentials,omitempty"`
+	Network        Network     `yaml:",inline"`
+	AwsProfile     string      `yaml:"awsProfile,omitempty"`
+	DefaultContext string      `yaml:"defaultContext,omitempty"`
+}
+
+// configFile is the layout of the config file. The settings at the top level form the default profile
+type configFile struct {
+	Config        `yaml:",inline"`
+	ActiveProfile string            `yaml:"activeProfile,omitempty"`
+	Profiles      map[string]Config `yaml:"profiles,omitempty"`
 }
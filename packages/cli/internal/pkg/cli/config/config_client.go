@@ -1,11 +1,15 @@
 package config
 
 import (
+	"errors"
 	"fmt"
 	"hash/fnv"
+	"io/fs"
 	"math/big"
+	"os"
 	"path/filepath"
 	"regexp"
+	"sort"
 	"strings"
 
 	"github.com/aws/amazon-genomics-cli/internal/pkg/osutils"
@@ -14,15 +18,42 @@ import (
 const (
 	configDirName  = ".agc"
 	configFileName = "config.yaml"
+
+	// ProfileEnvVar names the environment variable that selects the configuration profile. It takes precedence over
+	// the profile chosen with `agc configure use`
+	ProfileEnvVar = "AGC_CONFIG_PROFILE"
+	// DefaultProfileName is the name of the profile formed by the top level settings of the config file
+	DefaultProfileName = "default"
 )
 
-var defaultConfig = Config{Format: Format{defaultFormat}}
+var (
+	defaultConfig      = Config{Format: Format{defaultFormat}}
+	profileNamePattern = regexp.MustCompile(`^[A-Za-z0-9][A-Za-z0-9_-]*$`)
+
+	// ErrProfileNotFound is returned when a configuration profile is not in the config file
+	ErrProfileNotFound = errors.New("configuration profile does not exist")
+)
 
 type Client struct {
 	configFilePath string
+	// profileName is the profile chosen by the caller. When empty the profile is selected by ProfileEnvVar
+	// or by the active profile of the config file
+	profileName string
 }
 
 func NewConfigClient() (*Client, error) {
+	return NewProfileConfigClient("")
+}
+
+// NewProfileConfigClient creates a client for the named configuration profile. Profiles are created when
+// settings are first written to them.
+func NewProfileConfigClient(profileName string) (*Client, error) {
+	if profileName != "" {
+		if err := ValidateProfileName(profileName); err != nil {
+			return nil, err
+		}
+	}
+
 	homeDir, err := osutils.DetermineHomeDir()
 	if err != nil {
 		return nil, err
@@ -36,7 +67,14 @@ func NewConfigClient() (*Client, error) {
 
 	configFilePath := filepath.Join(configDirPath, configFileName)
 
-	return &Client{configFilePath: configFilePath}, nil
+	return &Client{configFilePath: configFilePath, profileName: profileName}, nil
+}
+
+func ValidateProfileName(profileName string) error {
+	if !profileNamePattern.MatchString(profileName) {
+		return fmt.Errorf("profile name '%s' is invalid, it must start with a letter or a digit and may only contain letters, digits, '-' and '_'", profileName)
+	}
+	return nil
 }
 
 func hash(s string) string {
@@ -74,17 +112,99 @@ func (c Client) Read() (Config, error) {
 	return c.loadFromFile()
 }
 
+// Write replaces the settings of the configuration profile
+func (c Client) Write(configData Config) error {
+	return c.storeToFile(configData)
+}
+
+// ListProfiles returns the names of the configuration profiles in the config file, starting with the default profile
+func (c Client) ListProfiles() ([]string, error) {
+	file, err := c.readConfigFile()
+	if err != nil {
+		return nil, err
+	}
+	var names []string
+	for name := range file.Profiles {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return append([]string{DefaultProfileName}, names...), nil
+}
+
+// UseProfile makes the named profile the one used when ProfileEnvVar is not set
+func (c Client) UseProfile(profileName string) error {
+	file, err := c.readConfigFile()
+	if err != nil {
+		return err
+	}
+	file.ActiveProfile = ""
+	if profileName != DefaultProfileName {
+		if _, found := file.Profiles[profileName]; !found {
+			return fmt.Errorf("%w: '%s'", ErrProfileNotFound, profileName)
+		}
+		file.ActiveProfile = profileName
+	}
+	return configToYaml(c.configFilePath, file)
+}
+
+func (c Client) selectedProfileName(file configFile) string {
+	if c.profileName != "" {
+		return c.profileName
+	}
+	if profileName := os.Getenv(ProfileEnvVar); profileName != "" {
+		return profileName
+	}
+	if file.ActiveProfile != "" {
+		return file.ActiveProfile
+	}
+	return DefaultProfileName
+}
+
+// readConfigFile reads all profiles of the config file. A missing config file holds no settings
+func (c Client) readConfigFile() (configFile, error) {
+	file, err := configFromYaml(c.configFilePath)
+	if errors.Is(err, fs.ErrNotExist) {
+		return configFile{}, nil
+	}
+	return file, err
+}
+
 func (c Client) loadFromFile() (Config, error) {
-	configData, err := configFromYaml(c.configFilePath, defaultConfig)
+	file, err := configFromYaml(c.configFilePath)
 	if err != nil {
 		return defaultConfig, err
 	}
-	configData.User.Id = userIdFromEmailAddress(configData.User.Email)
+	profileName := c.selectedProfileName(file)
+	configData := file.Config
+	if profileName != DefaultProfileName {
+		// a profile without settings reads as empty until settings are written to it
+		configData = file.Profiles[profileName]
+	}
+	if configData.Format.Name == "" {
+		configData.Format.Name = defaultFormat
+	}
+	configData.Profile = profileName
+	if configData.User.Email != "" {
+		configData.User.Id = userIdFromEmailAddress(configData.User.Email)
+	}
 	return configData, nil
 }
 
 func (c Client) storeToFile(config Config) error {
-	return configToYaml(c.configFilePath, config)
+	file, err := c.readConfigFile()
+	if err != nil {
+		return err
+	}
+	profileName := c.selectedProfileName(file)
+	if profileName == DefaultProfileName {
+		file.Config = config
+	} else {
+		if file.Profiles == nil {
+			file.Profiles = make(map[string]Config)
+		}
+		file.Profiles[profileName] = config
+	}
+	return configToYaml(c.configFilePath, file)
 }
 
 func (c Client) GetUserEmailAddress() (string, error) {
@@ -127,3 +247,31 @@ func (c Client) GetFormat() (string, error) {
 	}
 	return configData.Format.Name, nil
 }
+
+func (c Client) GetCredentials() (Credentials, error) {
+	configData, err := c.loadFromFile()
+	if err != nil {
+		return Credentials{}, err
+	}
+	return configData.Credentials, nil
+}
+
+func (c Client) SetCredentials(credentials Credentials) error {
+	configData, _ := c.loadFromFile()
+	configData.Credentials = credentials
+	return c.storeToFile(configData)
+}
+
+func (c Client) GetNetwork() (Network, error) {
+	configData, err := c.loadFromFile()
+	if err != nil {
+		return Network{}, err
+	}
+	return configData.Network, nil
+}
+
+func (c Client) SetNetwork(network Network) error {
+	configData, _ := c.loadFromFile()
+	configData.Network = network
+	return c.storeToFile(configData)
+}
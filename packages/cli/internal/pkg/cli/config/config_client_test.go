@@ -3,6 +3,7 @@ package config
 import (
 	"fmt"
 	"io/fs"
+	"path/filepath"
 	"testing"
 
 	iomocks "github.com/aws/amazon-genomics-cli/internal/pkg/mocks/io"
@@ -79,7 +80,7 @@ func TestSetFormat(t *testing.T) {
 	defer ctrl.Finish()
 
 	mockFileReader := iomocks.NewMockFileReader(ctrl)
-	mockFileReader.EXPECT().ReadFile(testFileName).Return([]byte(expectedConfigYaml), nil)
+	mockFileReader.EXPECT().ReadFile(testFileName).Return([]byte(expectedConfigYaml), nil).Times(2)
 
 	origReadFile := readFile
 	readFile = mockFileReader.ReadFile
@@ -100,6 +101,65 @@ func TestSetFormat(t *testing.T) {
 	require.NoError(t, err)
 }
 
+func TestSetCredentials(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockFileReader := iomocks.NewMockFileReader(ctrl)
+	mockFileReader.EXPECT().ReadFile(testFileName).Return([]byte(expectedConfigYaml), nil).Times(2)
+
+	origReadFile := readFile
+	readFile = mockFileReader.ReadFile
+	defer func() { readFile = origReadFile }()
+
+	credentials := Credentials{RoleArn: "arn:aws:iam::111122223333:role/AgcProjectRole", ExternalId: "external-id", SessionDuration: "2h"}
+	expected := expectedConfig
+	expected.Credentials = credentials
+	expectedConfigBytes, _ := yaml.Marshal(expected)
+	mockFileWriter := iomocks.NewMockFileWriter(ctrl)
+	mockFileWriter.EXPECT().WriteFile(testFileName, expectedConfigBytes, fs.FileMode(0644)).Return(nil)
+
+	origWriteFile := writeFile
+	writeFile = mockFileWriter.WriteFile
+	defer func() { writeFile = origWriteFile }()
+
+	var client = Client{
+		configFilePath: testFileName,
+	}
+	err := client.SetCredentials(credentials)
+	require.NoError(t, err)
+}
+
+func TestSetNetwork(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockFileReader := iomocks.NewMockFileReader(ctrl)
+	mockFileReader.EXPECT().ReadFile(testFileName).Return([]byte(expectedConfigYaml), nil).Times(2)
+
+	origReadFile := readFile
+	readFile = mockFileReader.ReadFile
+	defer func() { readFile = origReadFile }()
+
+	network := Network{Proxy: "http://proxy.example.com:3128", CaBundle: "/etc/ssl/proxy-ca.pem"}
+	expected := expectedConfig
+	expected.Network = network
+	expectedConfigBytes, _ := yaml.Marshal(expected)
+	assert.Contains(t, string(expectedConfigBytes), "proxy: http://proxy.example.com:3128\ncaBundle: /etc/ssl/proxy-ca.pem\n")
+	mockFileWriter := iomocks.NewMockFileWriter(ctrl)
+	mockFileWriter.EXPECT().WriteFile(testFileName, expectedConfigBytes, fs.FileMode(0644)).Return(nil)
+
+	origWriteFile := writeFile
+	writeFile = mockFileWriter.WriteFile
+	defer func() { writeFile = origWriteFile }()
+
+	var client = Client{
+		configFilePath: testFileName,
+	}
+	err := client.SetNetwork(network)
+	require.NoError(t, err)
+}
+
 func TestLoadFromFile(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
@@ -119,3 +179,69 @@ func TestLoadFromFile(t *testing.T) {
 	require.Error(t, err)
 	assert.Equal(t, configData, expectedDefaultConfig)
 }
+
+func TestProfiles(t *testing.T) {
+	t.Setenv(ProfileEnvVar, "")
+	configFilePath := filepath.Join(t.TempDir(), configFileName)
+	client := Client{configFilePath: configFilePath}
+	prodClient := Client{configFilePath: configFilePath, profileName: "prod"}
+
+	require.NoError(t, client.SetUserEmailAddress("dev@example.com"))
+	require.NoError(t, prodClient.Write(Config{
+		User:           User{Email: "prod@example.com"},
+		Format:         Format{Name: "json"},
+		AwsProfile:     "prod-account",
+		DefaultContext: "ctx1",
+	}))
+
+	profiles, err := client.ListProfiles()
+	require.NoError(t, err)
+	assert.Equal(t, []string{DefaultProfileName, "prod"}, profiles)
+
+	configData, err := client.Read()
+	require.NoError(t, err)
+	assert.Equal(t, DefaultProfileName, configData.Profile)
+	assert.Equal(t, "dev@example.com", configData.User.Email)
+
+	require.NoError(t, client.UseProfile("prod"))
+	configData, err = client.Read()
+	require.NoError(t, err)
+	assert.Equal(t, "prod", configData.Profile)
+	assert.Equal(t, "prod@example.com", configData.User.Email)
+	assert.Equal(t, "json", configData.Format.Name)
+	assert.Equal(t, "prod-account", configData.AwsProfile)
+	assert.Equal(t, "ctx1", configData.DefaultContext)
+
+	t.Setenv(ProfileEnvVar, DefaultProfileName)
+	configData, err = client.Read()
+	require.NoError(t, err)
+	assert.Equal(t, "dev@example.com", configData.User.Email)
+
+	t.Setenv(ProfileEnvVar, "missing")
+	configData, err = client.Read()
+	require.NoError(t, err)
+	assert.Equal(t, "missing", configData.Profile)
+	assert.Equal(t, "", configData.User.Email)
+	assert.ErrorIs(t, client.UseProfile("missing"), ErrProfileNotFound)
+}
+
+func TestProfiles_NewProfile(t *testing.T) {
+	t.Setenv(ProfileEnvVar, "")
+	client := Client{configFilePath: filepath.Join(t.TempDir(), configFileName), profileName: "staging"}
+
+	require.NoError(t, client.SetNetwork(Network{Proxy: "http://proxy.example.com:3128"}))
+	configData, err := client.Read()
+	require.NoError(t, err)
+	assert.Equal(t, "staging", configData.Profile)
+	assert.Equal(t, defaultFormat, configData.Format.Name)
+	assert.Equal(t, "", configData.User.Id)
+	assert.Equal(t, "http://proxy.example.com:3128", configData.Network.Proxy)
+}
+
+func TestValidateProfileName(t *testing.T) {
+	assert.NoError(t, ValidateProfileName("prod"))
+	assert.NoError(t, ValidateProfileName("team-a_2"))
+	assert.Error(t, ValidateProfileName(""))
+	assert.Error(t, ValidateProfileName("-prod"))
+	assert.Error(t, ValidateProfileName("prod/eu"))
+}
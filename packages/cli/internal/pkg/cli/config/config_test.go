@@ -22,19 +22,19 @@ format:
 
 var (
 	expectedConfig = Config{
-		User{
+		User: User{
 			Email: "my@email.com",
 		},
-		Format{
+		Format: Format{
 			Name: "text",
 		},
 	}
 
 	expectedDefaultConfig = Config{
-		User{
+		User: User{
 			Email: "",
 		},
-		Format{
+		Format: Format{
 			Name: "text",
 		},
 	}
@@ -51,9 +51,9 @@ func TestConfig_ReadData(t *testing.T) {
 	readFile = mockFileReader.ReadFile
 	defer func() { readFile = origReadFile }()
 
-	configData, err := configFromYaml(testFileName, defaultConfig)
+	file, err := configFromYaml(testFileName)
 	require.NoError(t, err)
-	assert.Equal(t, expectedConfig, configData)
+	assert.Equal(t, configFile{Config: expectedConfig}, file)
 }
 
 func TestConfig_WriteData(t *testing.T) {
@@ -68,6 +68,6 @@ func TestConfig_WriteData(t *testing.T) {
 	writeFile = mockFileWriter.WriteFile
 	defer func() { writeFile = origWriteFile }()
 
-	err := configToYaml(testFileName, expectedConfig)
+	err := configToYaml(testFileName, configFile{Config: expectedConfig})
 	require.NoError(t, err)
 }
@@ -15,21 +15,22 @@ const (
 	defaultFormat = "text"
 )
 
-func configToYaml(filePath string, configData Config) error {
-	bytes, err := yaml.Marshal(configData)
+func configToYaml(filePath string, file configFile) error {
+	bytes, err := yaml.Marshal(file)
 	if err != nil {
 		return err
 	}
 	return writeFile(filePath, bytes, 0644)
 }
 
-func configFromYaml(filePath string, configData Config) (Config, error) {
+func configFromYaml(filePath string) (configFile, error) {
+	var file configFile
 	bytes, err := readFile(filePath)
 	if err != nil {
-		return Config{}, err
+		return configFile{}, err
 	}
-	if err := yaml.Unmarshal(bytes, &configData); err != nil {
-		return Config{}, err
+	if err := yaml.Unmarshal(bytes, &file); err != nil {
+		return configFile{}, err
 	}
-	return configData, nil
+	return file, nil
 }
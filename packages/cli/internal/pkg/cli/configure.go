@@ -5,7 +5,9 @@ package cli
 
 import (
 	"github.com/aws/amazon-genomics-cli/cmd/application/template"
+	"github.com/aws/amazon-genomics-cli/internal/pkg/cli/clierror"
 	"github.com/aws/amazon-genomics-cli/internal/pkg/cli/group"
+	"github.com/rs/zerolog/log"
 	"github.com/spf13/cobra"
 )
 
@@ -16,12 +18,42 @@ func BuildConfigureCommand() *cobra.Command {
 		Short: `Commands for configuration.
 Configuration is stored per user.`,
 		Long: `Commands for configuration.
-Configure local settings and preferences to customize the CLI experience.`,
+Configure local settings and preferences to customize the CLI experience.
+Running configure without a command asks for every setting of a configuration profile,
+checking each value as it is entered. Settings are stored in the active profile unless
+--profile-name is given.`,
+		Example: `
+Create or update the "prod" profile
+/code $ agc configure --profile-name prod`,
+		Args: cobra.NoArgs,
+		RunE: runCmdE(func(cmd *cobra.Command, args []string) error {
+			vars := configureProfileVars{profileName: configProfileName}
+			opts, err := newConfigureProfileOpts(vars)
+			if err != nil {
+				return err
+			}
+			if err := opts.Validate(); err != nil {
+				return err
+			}
+			configClient, err := newConfigureClient()
+			if err != nil {
+				return clierror.New(configureCommand, vars, err)
+			}
+			opts.configClient = configClient
+			log.Info().Msg("Configuring profile, press enter to keep the current value of a setting")
+			if err := opts.Execute(); err != nil {
+				return clierror.New(configureCommand, vars, err)
+			}
+			return nil
+		}),
 	}
 
 	cmd.AddCommand(BuildConfigureEmailCommand())
 	cmd.AddCommand(BuildDescribeShowCommand())
 	cmd.AddCommand(BuildConfigureFormatCommand())
+	cmd.AddCommand(BuildConfigureCredentialsCommand())
+	cmd.AddCommand(BuildConfigureNetworkCommand())
+	cmd.AddCommand(BuildConfigureUseCommand())
 
 	cmd.SetUsageTemplate(template.Usage)
 	cmd.Annotations = map[string]string{
@@ -29,6 +61,7 @@ Configure local settings and preferences to customize the CLI experience.`,
 	}
 
 	cmd.PersistentFlags().StringVarP(&profile, AWSProfileFlag, AWSProfileFlagShort, "", AWSProfileFlagDescription)
+	cmd.PersistentFlags().StringVar(&configProfileName, profileNameFlag, "", profileNameFlagDescription)
 
 	return cmd
 }
@@ -0,0 +1,98 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"fmt"
+	"regexp"
+	"time"
+
+	"github.com/aws/amazon-genomics-cli/internal/pkg/cli/clierror"
+	"github.com/aws/amazon-genomics-cli/internal/pkg/cli/config"
+	"github.com/aws/amazon-genomics-cli/internal/pkg/storage"
+	"github.com/rs/zerolog/log"
+	"github.com/spf13/cobra"
+)
+
+const configureCredentialsCommand = "configure credentials"
+
+var roleArnRegexp = regexp.MustCompile(`^arn:aws[a-z-]*:iam::[0-9]{12}:role/.+$`)
+
+type credentialsContextVars struct {
+	config.Credentials
+}
+type credentialsContextOpts struct {
+	credentialsContextVars
+	configClient storage.ConfigClient
+}
+
+func newCredentialsContextOpts(vars credentialsContextVars) (*credentialsContextOpts, error) {
+	return &credentialsContextOpts{
+		credentialsContextVars: vars,
+	}, nil
+}
+
+func (o *credentialsContextOpts) Validate() error {
+	if o.RoleArn == "" {
+		if o.ExternalId != "" || o.SessionName != "" || o.SessionDuration != "" {
+			return fmt.Errorf("an external id, session name or session duration requires a role to be provided with '--%s'", RoleArnFlag)
+		}
+		return nil
+	}
+	if !roleArnRegexp.MatchString(o.RoleArn) {
+		return fmt.Errorf("'%s' is not an IAM role ARN", o.RoleArn)
+	}
+	if o.SessionDuration != "" {
+		if _, err := time.ParseDuration(o.SessionDuration); err != nil {
+			return fmt.Errorf("invalid session duration '%s': %w", o.SessionDuration, err)
+		}
+	}
+	return nil
+}
+
+// Execute stores the role to assume in the config file, or removes it when no role is provided.
+func (o *credentialsContextOpts) Execute() error {
+	return o.configClient.SetCredentials(o.Credentials)
+}
+
+func BuildConfigureCredentialsCommand() *cobra.Command {
+	vars := credentialsContextVars{}
+	cmd := &cobra.Command{
+		Use:   "credentials",
+		Short: "Sets an IAM role that AGC assumes with the credentials of the AWS CLI profile",
+		Long: `credentials stores an IAM role in the AGC config file. AGC assumes the role with the credentials
+of the AWS CLI profile for every AWS call it makes, including the calls made to deploy contexts and to
+run workflows. The role can be overridden for a single command with the --role-arn flag.
+Running the command without a role removes the stored role.`,
+		Args: cobra.NoArgs,
+		RunE: runCmdE(func(cmd *cobra.Command, args []string) error {
+			opts, err := newCredentialsContextOpts(vars)
+			if err != nil {
+				return err
+			}
+			if err := opts.Validate(); err != nil {
+				return err
+			}
+			configClient, err := newConfigureClient()
+			if err != nil {
+				return clierror.New(configureCredentialsCommand, vars, err)
+			}
+			opts.configClient = configClient
+			if opts.RoleArn == "" {
+				log.Info().Msg("Removing the role to assume")
+			} else {
+				log.Info().Msgf("Setting the role to assume to: '%s'", opts.RoleArn)
+			}
+			if err := opts.Execute(); err != nil {
+				return clierror.New(configureCredentialsCommand, vars, err)
+			}
+			return nil
+		}),
+	}
+	cmd.Flags().StringVar(&vars.RoleArn, RoleArnFlag, "", RoleArnFlagDescription)
+	cmd.Flags().StringVar(&vars.ExternalId, ExternalIdFlag, "", ExternalIdFlagDescription)
+	cmd.Flags().StringVar(&vars.SessionName, SessionNameFlag, "", SessionNameFlagDescription)
+	cmd.Flags().StringVar(&vars.SessionDuration, SessionDurationFlag, "", SessionDurationFlagDescription)
+	return cmd
+}
@@ -0,0 +1,61 @@
+package cli
+
+import (
+	"testing"
+
+	"github.com/aws/amazon-genomics-cli/internal/pkg/cli/config"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const testRoleArn = "arn:aws:iam::111122223333:role/AgcProjectRole"
+
+func TestCredentialsContextOpts_Validate(t *testing.T) {
+	testCases := map[string]struct {
+		credentials config.Credentials
+		expectedErr string
+	}{
+		"no role": {},
+		"role with options": {
+			credentials: config.Credentials{RoleArn: testRoleArn, ExternalId: "id", SessionName: "me", SessionDuration: "2h"},
+		},
+		"options without role": {
+			credentials: config.Credentials{ExternalId: "id"},
+			expectedErr: "an external id, session name or session duration requires a role to be provided with '--role-arn'",
+		},
+		"invalid role": {
+			credentials: config.Credentials{RoleArn: "AgcProjectRole"},
+			expectedErr: "'AgcProjectRole' is not an IAM role ARN",
+		},
+		"invalid duration": {
+			credentials: config.Credentials{RoleArn: testRoleArn, SessionDuration: "two hours"},
+			expectedErr: "invalid session duration 'two hours': time: invalid duration \"two hours\"",
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			opts, err := newCredentialsContextOpts(credentialsContextVars{tc.credentials})
+			require.NoError(t, err)
+			err = opts.Validate()
+			if tc.expectedErr != "" {
+				assert.EqualError(t, err, tc.expectedErr)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestCredentialsContextOpts_Execute(t *testing.T) {
+	mocks := createMocks(t)
+	defer mocks.ctrl.Finish()
+	credentials := config.Credentials{RoleArn: testRoleArn, ExternalId: "id"}
+
+	opts, err := newCredentialsContextOpts(credentialsContextVars{credentials})
+	require.NoError(t, err)
+	opts.configClient = mocks.configMock
+	mocks.configMock.EXPECT().SetCredentials(credentials).Return(nil)
+
+	assert.NoError(t, opts.Execute())
+}
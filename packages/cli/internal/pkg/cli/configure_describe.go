@@ -7,6 +7,7 @@ import (
 	"github.com/aws/amazon-genomics-cli/internal/pkg/cli/clierror"
 	"github.com/aws/amazon-genomics-cli/internal/pkg/cli/config"
 	"github.com/aws/amazon-genomics-cli/internal/pkg/cli/format"
+	"github.com/aws/amazon-genomics-cli/internal/pkg/network"
 	"github.com/aws/amazon-genomics-cli/internal/pkg/storage"
 	"github.com/rs/zerolog/log"
 	"github.com/spf13/cobra"
@@ -19,7 +20,7 @@ type showContextOpts struct {
 }
 
 func newConfigureDescribeContextOpts() (*showContextOpts, error) {
-	configClient, err := config.NewConfigClient()
+	configClient, err := newConfigureClient()
 	if err != nil {
 		return nil, err
 	}
@@ -31,9 +32,11 @@ func (o *showContextOpts) Validate() error {
 	return nil
 }
 
-// Execute returns current user specific configuration snapshot
+// Execute returns current user specific configuration snapshot. The password of the proxy is not shown.
 func (o *showContextOpts) Execute() (config.Config, error) {
-	return o.configClient.Read()
+	configuration, err := o.configClient.Read()
+	configuration.Network.Proxy = network.RedactProxy(configuration.Network.Proxy)
+	return configuration, err
 }
 
 func BuildDescribeShowCommand() *cobra.Command {
@@ -7,7 +7,6 @@ import (
 	"net/mail"
 
 	"github.com/aws/amazon-genomics-cli/internal/pkg/cli/clierror"
-	"github.com/aws/amazon-genomics-cli/internal/pkg/cli/config"
 	"github.com/aws/amazon-genomics-cli/internal/pkg/storage"
 	"github.com/rs/zerolog/log"
 	"github.com/spf13/cobra"
@@ -56,7 +55,7 @@ func BuildConfigureEmailCommand() *cobra.Command {
 				if err != nil {
 					return err
 				}
-				configClient, err := config.NewConfigClient()
+				configClient, err := newConfigureClient()
 				if err != nil {
 					return clierror.New(configureEmailCommand, vars, err)
 				}
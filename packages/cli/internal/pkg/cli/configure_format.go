@@ -7,7 +7,6 @@ import (
 	"fmt"
 
 	"github.com/aws/amazon-genomics-cli/internal/pkg/cli/clierror"
-	"github.com/aws/amazon-genomics-cli/internal/pkg/cli/config"
 	"github.com/aws/amazon-genomics-cli/internal/pkg/cli/format"
 	"github.com/aws/amazon-genomics-cli/internal/pkg/storage"
 	"github.com/rs/zerolog/log"
@@ -64,7 +63,7 @@ func BuildConfigureFormatCommand() *cobra.Command {
 				return err
 			}
 			opts.format = args[0]
-			configClient, err := config.NewConfigClient()
+			configClient, err := newConfigureClient()
 			if err != nil {
 				return clierror.New(configureFormatCommand, vars, err)
 			}
@@ -0,0 +1,140 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"errors"
+	"fmt"
+	"io/fs"
+	"net/mail"
+	"regexp"
+
+	"github.com/AlecAivazis/survey/v2"
+	"github.com/aws/amazon-genomics-cli/internal/pkg/aws"
+	"github.com/aws/amazon-genomics-cli/internal/pkg/cli/config"
+	"github.com/aws/amazon-genomics-cli/internal/pkg/cli/format"
+	"github.com/aws/amazon-genomics-cli/internal/pkg/network"
+	"github.com/aws/amazon-genomics-cli/internal/pkg/storage"
+)
+
+const (
+	configureCommand = "configure"
+
+	profileNameFlag            = "profile-name"
+	profileNameFlagDescription = "Name of the configuration profile to configure. Defaults to the active profile"
+)
+
+var contextNameRegexp = regexp.MustCompile(`^[A-Za-z0-9]+$`)
+
+// configProfileName is the configuration profile that the configure commands read and write
+var configProfileName string
+
+var validateAwsProfile = aws.ValidateProfile
+
+func newConfigureClient() (storage.ConfigClient, error) {
+	return config.NewProfileConfigClient(configProfileName)
+}
+
+// prompter asks for a value, offering the default value, until the answer passes validation
+type prompter func(message, defaultValue string, validate func(string) error) (string, error)
+
+func askInput(message, defaultValue string, validate func(string) error) (string, error) {
+	var answer string
+	err := survey.AskOne(&survey.Input{Message: message, Default: defaultValue}, &answer, survey.WithValidator(func(value interface{}) error {
+		return validate(value.(string))
+	}))
+	return answer, err
+}
+
+type configureProfileVars struct {
+	profileName string
+}
+
+type configureProfileOpts struct {
+	configureProfileVars
+	configClient storage.ConfigClient
+	ask          prompter
+}
+
+func newConfigureProfileOpts(vars configureProfileVars) (*configureProfileOpts, error) {
+	return &configureProfileOpts{
+		configureProfileVars: vars,
+		ask:                  askInput,
+	}, nil
+}
+
+func (o *configureProfileOpts) Validate() error {
+	if o.profileName == "" {
+		return nil
+	}
+	return config.ValidateProfileName(o.profileName)
+}
+
+// Execute asks for every setting of the configuration profile, starting from the stored values, and stores the answers
+func (o *configureProfileOpts) Execute() error {
+	configData, err := o.configClient.Read()
+	if err != nil && !errors.Is(err, fs.ErrNotExist) {
+		return err
+	}
+
+	if configData.User.Email, err = o.ask("Email address", configData.User.Email, validateEmail); err != nil {
+		return err
+	}
+	if configData.Format.Name, err = o.ask("Output format (text, table or json)", configData.Format.Name, validateFormat); err != nil {
+		return err
+	}
+	if configData.AwsProfile, err = o.ask("AWS profile (leave empty for the default credential chain)", configData.AwsProfile, validateOptionalAwsProfile); err != nil {
+		return err
+	}
+	if configData.DefaultContext, err = o.ask("Default context (leave empty for none)", configData.DefaultContext, validateOptionalContextName); err != nil {
+		return err
+	}
+	if configData.Network.Proxy, err = o.ask("HTTP proxy URL (leave empty for none)", configData.Network.Proxy, validateProxy); err != nil {
+		return err
+	}
+	caBundle, err := o.ask("CA bundle path (leave empty for none)", configData.Network.CaBundle, validateCaBundle)
+	if err != nil {
+		return err
+	}
+	if configData.Network.CaBundle, err = absoluteCaBundle(caBundle); err != nil {
+		return err
+	}
+
+	return o.configClient.Write(configData)
+}
+
+func validateEmail(value string) error {
+	_, err := mail.ParseAddress(value)
+	return err
+}
+
+func validateFormat(value string) error {
+	return format.FormatterType(value).ValidateFormatter()
+}
+
+func validateOptionalAwsProfile(value string) error {
+	if value == "" {
+		return nil
+	}
+	return validateAwsProfile(value)
+}
+
+func validateOptionalContextName(value string) error {
+	if value != "" && !contextNameRegexp.MatchString(value) {
+		return fmt.Errorf("context name '%s' is invalid, it may only contain letters and digits", value)
+	}
+	return nil
+}
+
+func validateProxy(value string) error {
+	return network.Validate(network.Settings{Proxy: value})
+}
+
+func validateCaBundle(value string) error {
+	caBundle, err := absoluteCaBundle(value)
+	if err != nil {
+		return err
+	}
+	return network.Validate(network.Settings{CaBundle: caBundle})
+}
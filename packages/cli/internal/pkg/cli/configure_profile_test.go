@@ -0,0 +1,126 @@
+package cli
+
+import (
+	"fmt"
+	"io/fs"
+	"testing"
+
+	"github.com/aws/amazon-genomics-cli/internal/pkg/cli/config"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// scriptedPrompter answers the prompts in order, moving to the next answer of a prompt while an answer is rejected.
+// An empty answer keeps the default value
+func scriptedPrompter(t *testing.T, answers [][]string, rejected *int) prompter {
+	prompt := 0
+	return func(message, defaultValue string, validate func(string) error) (string, error) {
+		require.Less(t, prompt, len(answers), "unexpected prompt %q", message)
+		defer func() { prompt++ }()
+		for _, answer := range answers[prompt] {
+			if answer == "" {
+				answer = defaultValue
+			}
+			if err := validate(answer); err != nil {
+				*rejected++
+				continue
+			}
+			return answer, nil
+		}
+		return "", fmt.Errorf("no valid answer for %q", message)
+	}
+}
+
+func TestConfigureProfileOpts_Execute(t *testing.T) {
+	bundlePath := writeTestCaBundle(t)
+	origValidateAwsProfile := validateAwsProfile
+	validateAwsProfile = func(profile string) error {
+		if profile != "prod-account" {
+			return fmt.Errorf("profile '%s' does not exist", profile)
+		}
+		return nil
+	}
+	defer func() { validateAwsProfile = origValidateAwsProfile }()
+
+	testCases := map[string]struct {
+		stored           config.Config
+		readErr          error
+		answers          [][]string
+		expected         config.Config
+		expectedRejected int
+	}{
+		"new profile": {
+			readErr: fs.ErrNotExist,
+			answers: [][]string{
+				{"not-an-email", "prod@example.com"},
+				{"yaml", "json"},
+				{"missing", "prod-account"},
+				{"ctx-1", "ctx1"},
+				{"proxy.example.com", "http://proxy.example.com:3128"},
+				{bundlePath},
+			},
+			expected: config.Config{
+				User:           config.User{Email: "prod@example.com"},
+				Format:         config.Format{Name: "json"},
+				AwsProfile:     "prod-account",
+				DefaultContext: "ctx1",
+				Network:        config.Network{Proxy: "http://proxy.example.com:3128", CaBundle: bundlePath},
+			},
+			expectedRejected: 5,
+		},
+		"keep stored values": {
+			stored: config.Config{
+				Profile:     "prod",
+				User:        config.User{Email: "prod@example.com"},
+				Format:      config.Format{Name: "table"},
+				Credentials: config.Credentials{RoleArn: testRoleArn},
+			},
+			answers: [][]string{{""}, {""}, {""}, {""}, {""}, {""}},
+			expected: config.Config{
+				Profile:     "prod",
+				User:        config.User{Email: "prod@example.com"},
+				Format:      config.Format{Name: "table"},
+				Credentials: config.Credentials{RoleArn: testRoleArn},
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			mocks := createMocks(t)
+			defer mocks.ctrl.Finish()
+			mocks.configMock.EXPECT().Read().Return(tc.stored, tc.readErr)
+			mocks.configMock.EXPECT().Write(tc.expected).Return(nil)
+
+			rejected := 0
+			opts := &configureProfileOpts{
+				configureProfileVars: configureProfileVars{profileName: "prod"},
+				configClient:         mocks.configMock,
+				ask:                  scriptedPrompter(t, tc.answers, &rejected),
+			}
+			require.NoError(t, opts.Validate())
+			require.NoError(t, opts.Execute())
+			assert.Equal(t, tc.expectedRejected, rejected)
+		})
+	}
+}
+
+func TestConfigureProfileOpts_Validate(t *testing.T) {
+	opts := &configureProfileOpts{configureProfileVars: configureProfileVars{profileName: "prod/eu"}}
+	assert.Error(t, opts.Validate())
+}
+
+func TestUseDefaultContext(t *testing.T) {
+	defer SetProfileDefaults("", "")
+
+	contextName := ""
+	assert.Error(t, useDefaultContext(&contextName))
+
+	SetProfileDefaults("", "ctx1")
+	require.NoError(t, useDefaultContext(&contextName))
+	assert.Equal(t, "ctx1", contextName)
+
+	contextName = "ctx2"
+	require.NoError(t, useDefaultContext(&contextName))
+	assert.Equal(t, "ctx2", contextName)
+}
@@ -0,0 +1,96 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/aws/amazon-genomics-cli/internal/pkg/cli/clierror"
+	"github.com/aws/amazon-genomics-cli/internal/pkg/cli/clierror/actionableerror"
+	"github.com/aws/amazon-genomics-cli/internal/pkg/cli/config"
+	"github.com/aws/amazon-genomics-cli/internal/pkg/storage"
+	"github.com/rs/zerolog/log"
+	"github.com/spf13/cobra"
+)
+
+const configureUseCommand = "configure use"
+
+type useProfileVars struct {
+	profileName string
+}
+type useProfileOpts struct {
+	useProfileVars
+	configClient storage.ConfigClient
+}
+
+func newUseProfileOpts(vars useProfileVars) (*useProfileOpts, error) {
+	return &useProfileOpts{
+		useProfileVars: vars,
+	}, nil
+}
+
+func (o *useProfileOpts) Validate() error {
+	return config.ValidateProfileName(o.profileName)
+}
+
+// Execute makes the profile the active one for every later command
+func (o *useProfileOpts) Execute() error {
+	err := o.configClient.UseProfile(o.profileName)
+	if errors.Is(err, config.ErrProfileNotFound) {
+		return actionableerror.New(err, fmt.Sprintf("Create the profile with 'agc configure --profile-name %s'", o.profileName))
+	}
+	return err
+}
+
+func BuildConfigureUseCommand() *cobra.Command {
+	vars := useProfileVars{}
+	cmd := &cobra.Command{
+		Use:   "use profile_name",
+		Short: "Sets the configuration profile that AGC commands use",
+		Long: `use makes the named configuration profile the active one. The settings of the active profile,
+such as the email address, output format, AWS profile, default context and network settings, apply to every
+later command. The AGC_CONFIG_PROFILE environment variable takes precedence over the active profile.
+The profile named 'default' holds the settings that are not part of a named profile.`,
+		Example: `
+Use the settings of the "prod" profile
+/code $ agc configure use prod`,
+		Args: cobra.ExactArgs(1),
+		RunE: runCmdE(func(cmd *cobra.Command, args []string) error {
+			vars.profileName = args[0]
+			opts, err := newUseProfileOpts(vars)
+			if err != nil {
+				return err
+			}
+			if err := opts.Validate(); err != nil {
+				return err
+			}
+			configClient, err := config.NewConfigClient()
+			if err != nil {
+				return clierror.New(configureUseCommand, vars, err)
+			}
+			opts.configClient = configClient
+			log.Info().Msgf("Using configuration profile '%s'", opts.profileName)
+			if err := opts.Execute(); err != nil {
+				return clierror.New(configureUseCommand, vars, err)
+			}
+			return nil
+		}),
+		ValidArgsFunction: func(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+			if len(args) != 0 {
+				return nil, cobra.ShellCompDirectiveNoFileComp
+			}
+			configClient, err := config.NewConfigClient()
+			if err != nil {
+				return nil, cobra.ShellCompDirectiveError
+			}
+			profileNames, err := configClient.ListProfiles()
+			if err != nil {
+				return nil, cobra.ShellCompDirectiveError
+			}
+			return profileNames, cobra.ShellCompDirectiveNoFileComp
+		},
+	}
+	return cmd
+}
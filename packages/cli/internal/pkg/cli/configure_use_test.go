@@ -0,0 +1,40 @@
+package cli
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/amazon-genomics-cli/internal/pkg/cli/config"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestUseProfileOpts_Execute(t *testing.T) {
+	testCases := map[string]struct {
+		useErr      error
+		expectedErr string
+	}{
+		"profile exists": {},
+		"profile not found": {
+			useErr:      fmt.Errorf("%w: 'prod'", config.ErrProfileNotFound),
+			expectedErr: "agc configure --profile-name prod",
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			mocks := createMocks(t)
+			defer mocks.ctrl.Finish()
+			mocks.configMock.EXPECT().UseProfile("prod").Return(tc.useErr)
+
+			opts := &useProfileOpts{useProfileVars: useProfileVars{profileName: "prod"}, configClient: mocks.configMock}
+			assert.NoError(t, opts.Validate())
+			err := opts.Execute()
+			if tc.expectedErr != "" {
+				assert.Error(t, err)
+				assert.Contains(t, err.Error(), tc.expectedErr)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
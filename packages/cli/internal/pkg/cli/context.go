@@ -26,6 +26,8 @@ particular context.`,
 	cmd.AddCommand(BuildContextDeployCommand())
 	cmd.AddCommand(BuildContextDestroyCommand())
 	cmd.AddCommand(BuildContextStatusCommand())
+	cmd.AddCommand(BuildContextHistoryCommand())
+	cmd.AddCommand(BuildContextRollbackCommand())
 
 	cmd.SetUsageTemplate(template.Usage)
 	cmd.Annotations = map[string]string{
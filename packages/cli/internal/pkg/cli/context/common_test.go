@@ -63,6 +63,7 @@ type mockClients struct {
 	cdkMock         *awsmocks.MockCdkClient
 	projMock        *storagemocks.MockProjectClient
 	cfnMock         *awsmocks.MockCfnClient
+	ddbMock         *awsmocks.MockDdbClient
 	ssmMock         *awsmocks.MockSsmClient
 	configMock      *storagemocks.MockConfigClient
 	ecrClientMock   *awsmocks.MockEcrClient
@@ -78,6 +79,7 @@ func createMocks(t *testing.T) mockClients {
 		cdkMock:         awsmocks.NewMockCdkClient(ctrl),
 		projMock:        storagemocks.NewMockProjectClient(ctrl),
 		cfnMock:         awsmocks.NewMockCfnClient(ctrl),
+		ddbMock:         awsmocks.NewMockDdbClient(ctrl),
 		ssmMock:         awsmocks.NewMockSsmClient(ctrl),
 		configMock:      storagemocks.NewMockConfigClient(ctrl),
 		ecrClientMock:   awsmocks.NewMockEcrClient(ctrl),
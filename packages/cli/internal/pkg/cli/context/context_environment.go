@@ -33,15 +33,38 @@ type contextEnvironment struct {
 	ReadBucketArns      string
 	ReadWriteBucketArns string
 	InstanceTypes       string
-	ResourceType        string
-	MaxVCpus            int
+
+	ResourceType string
+	MaxVCpus     int
 
 	RequestSpotInstances bool
 	UsePublicSubnets     bool
+
+	MinVCpus                 int
+	SpotBidPercentage        int
+	AllocationStrategy       string
+	RootVolumeSize           int
+	RootVolumeType           string
+	ScratchVolumeSize        int
+	ScratchVolumeType        string
+	AmiId                    string
+	ExcludedInstanceFamilies string
+
+	TaskEnvironmentJson string
+	TaskSecretsJson     string
+
+	ReadKmsKeyArns       string
+	ReadWriteKmsKeyArns  string
+	RequesterPaysBuckets string
+	DataBucketOwnersJson string
 }
 
 func (input contextEnvironment) ToEnvironmentList() []string {
-	return environmentMapToList(map[string]string{
+	return environmentMapToList(input.toEnvironmentMap())
+}
+
+func (input contextEnvironment) toEnvironmentMap() map[string]string {
+	return map[string]string{
 		"PROJECT":       input.ProjectName,
 		"CONTEXT":       input.ContextName,
 		"USER_ID":       input.UserId,
@@ -68,5 +91,23 @@ func (input contextEnvironment) ToEnvironmentList() []string {
 		"MAX_V_CPUS":                   strconv.Itoa(input.MaxVCpus),
 		"REQUEST_SPOT_INSTANCES":       strconv.FormatBool(input.RequestSpotInstances),
 		"PUBLIC_SUBNETS":               strconv.FormatBool(input.UsePublicSubnets),
-	})
+
+		"MIN_V_CPUS":                 strconv.Itoa(input.MinVCpus),
+		"SPOT_BID_PERCENTAGE":        strconv.Itoa(input.SpotBidPercentage),
+		"ALLOCATION_STRATEGY":        input.AllocationStrategy,
+		"ROOT_VOLUME_SIZE":           strconv.Itoa(input.RootVolumeSize),
+		"ROOT_VOLUME_TYPE":           input.RootVolumeType,
+		"SCRATCH_VOLUME_SIZE":        strconv.Itoa(input.ScratchVolumeSize),
+		"SCRATCH_VOLUME_TYPE":        input.ScratchVolumeType,
+		"CONTEXT_AMI":                input.AmiId,
+		"EXCLUDED_INSTANCE_FAMILIES": input.ExcludedInstanceFamilies,
+
+		"TASK_ENVIRONMENT": input.TaskEnvironmentJson,
+		"TASK_SECRETS":     input.TaskSecretsJson,
+
+		"READ_KMS_KEY_ARNS":       input.ReadKmsKeyArns,
+		"READ_WRITE_KMS_KEY_ARNS": input.ReadWriteKmsKeyArns,
+		"REQUESTER_PAYS_BUCKETS":  input.RequesterPaysBuckets,
+		"DATA_BUCKET_OWNERS":      input.DataBucketOwnersJson,
+	}
 }
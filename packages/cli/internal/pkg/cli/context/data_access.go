@@ -0,0 +1,120 @@
+package context
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/aws/amazon-genomics-cli/internal/pkg/aws/s3"
+	"github.com/aws/amazon-genomics-cli/internal/pkg/cli/awsresources"
+	"github.com/aws/amazon-genomics-cli/internal/pkg/cli/spec"
+)
+
+var (
+	readDataActions      = []string{"s3:GetObject"}
+	readWriteDataActions = []string{"s3:GetObject", "s3:PutObject", "s3:DeleteObject"}
+	readKeyActions       = []string{"kms:Decrypt"}
+	readWriteKeyActions  = []string{"kms:Decrypt", "kms:GenerateDataKey"}
+)
+
+// DataAccess is the access of the roles of a context to a data location of the project
+type DataAccess struct {
+	Location      string
+	ReadOnly      bool
+	RequesterPays bool
+	KmsKeyArn     string
+	BucketOwner   string
+	PolicyHint    string
+}
+
+// setDataBuckets turns the data locations of the project into the least privilege IAM inputs of the context: the
+// buckets or prefixes and the KMS keys the roles are granted, the buckets whose requester pays and the expected owner
+// of cross-account buckets
+func (m *Manager) setDataBuckets() {
+	if m.err != nil {
+		return
+	}
+	bucketOwners := make(map[string]string)
+	for _, dataItem := range m.projectSpec.Data {
+		if err := s3.ValidateS3Uri(dataItem.Location); err != nil {
+			m.err = err
+			return
+		}
+		if err := dataItem.ValidateWildcard(); err != nil {
+			m.err = err
+			return
+		}
+		if dataItem.ReadOnly {
+			m.readBuckets = append(m.readBuckets, dataItem.AccessArn())
+			m.readKmsKeys = appendUnique(m.readKmsKeys, dataItem.KmsKeyArn)
+		} else {
+			m.readWriteBuckets = append(m.readWriteBuckets, dataItem.AccessArn())
+			m.readWriteKmsKeys = appendUnique(m.readWriteKmsKeys, dataItem.KmsKeyArn)
+		}
+		if dataItem.RequesterPays {
+			m.requesterPaysBuckets = appendUnique(m.requesterPaysBuckets, dataItem.BucketName())
+		}
+		if dataItem.BucketOwner != "" {
+			bucketOwners[dataItem.BucketName()] = dataItem.BucketOwner
+		}
+	}
+	if len(bucketOwners) == 0 {
+		return
+	}
+	ownerBytes, err := json.Marshal(bucketOwners)
+	if err != nil {
+		m.err = err
+		return
+	}
+	m.bucketOwnersJson = string(ownerBytes)
+}
+
+func (m *Manager) buildDataAccess(contextName string) []DataAccess {
+	var dataAccess []DataAccess
+	stackName := awsresources.RenderContextStackName(m.projectSpec.Name, contextName, m.contextOwnerId(contextName))
+	for _, dataItem := range m.projectSpec.Data {
+		dataAccess = append(dataAccess, DataAccess{
+			Location:      dataItem.Location,
+			ReadOnly:      dataItem.ReadOnly,
+			RequesterPays: dataItem.RequesterPays,
+			KmsKeyArn:     dataItem.KmsKeyArn,
+			BucketOwner:   dataItem.BucketOwner,
+			PolicyHint:    renderPolicyHint(dataItem, stackName),
+		})
+	}
+	return dataAccess
+}
+
+// renderPolicyHint describes the statements that the owner of a cross-account bucket must add to its bucket policy,
+// and to its key policy for encrypted data, for the roles of a context to access the data
+func renderPolicyHint(dataItem spec.Data, stackName string) string {
+	if dataItem.BucketOwner == "" {
+		return ""
+	}
+	dataActions, keyActions := readDataActions, readKeyActions
+	if !dataItem.ReadOnly {
+		dataActions, keyActions = readWriteDataActions, readWriteKeyActions
+	}
+	listHint := fmt.Sprintf("s3:ListBucket on '%s'", dataItem.BucketArn())
+	if dataItem.AccessArn() != dataItem.BucketArn() {
+		listHint += fmt.Sprintf(" with the condition s3:prefix like '%s'", dataItem.KeyPattern())
+	}
+	hint := fmt.Sprintf("account %s must allow the roles of stack '%s' %s and %s on '%s' in the bucket policy",
+		dataItem.BucketOwner, stackName, listHint, strings.Join(dataActions, ", "), dataItem.ObjectsArn())
+	if dataItem.KmsKeyArn != "" {
+		hint += fmt.Sprintf(", and %s in the policy of key '%s'", strings.Join(keyActions, ", "), dataItem.KmsKeyArn)
+	}
+	return hint
+}
+
+func appendUnique(values []string, value string) []string {
+	if value == "" {
+		return values
+	}
+	for _, existing := range values {
+		if existing == value {
+			return values
+		}
+	}
+	return append(values, value)
+}
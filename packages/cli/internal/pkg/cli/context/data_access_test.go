@@ -0,0 +1,65 @@
+package context
+
+import (
+	"testing"
+
+	"github.com/aws/amazon-genomics-cli/internal/pkg/cli/spec"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const testKmsKeyArn = "arn:aws:kms:us-east-1:210987654321:key/1234abcd-12ab-34cd-56ef-1234567890ab"
+
+var testDataAccessProjectSpec = spec.Project{
+	Name: testProjectName,
+	Data: []spec.Data{
+		{Location: "s3://reference-bucket", ReadOnly: true},
+		{Location: "s3://cohort-bucket/cohortA/", ReadOnly: true, RequesterPays: true, KmsKeyArn: testKmsKeyArn, BucketOwner: "210987654321"},
+		{Location: "s3://cohort-bucket/cohortB/*", ReadOnly: true, RequesterPays: true, KmsKeyArn: testKmsKeyArn, BucketOwner: "210987654321"},
+		{Location: "s3://results-bucket/runs/", KmsKeyArn: testKmsKeyArn},
+	},
+	Contexts: map[string]spec.Context{testContextName1: {}},
+}
+
+func TestManager_SetDataBuckets(t *testing.T) {
+	manager := Manager{baseProps: baseProps{projectSpec: testDataAccessProjectSpec}}
+
+	manager.setDataBuckets()
+
+	require.NoError(t, manager.err)
+	assert.Equal(t, []string{"arn:aws:s3:::reference-bucket", "arn:aws:s3:::cohort-bucket/cohortA/*", "arn:aws:s3:::cohort-bucket/cohortB/*"}, manager.readBuckets)
+	assert.Equal(t, []string{"arn:aws:s3:::results-bucket/runs/*"}, manager.readWriteBuckets)
+	assert.Equal(t, []string{testKmsKeyArn}, manager.readKmsKeys)
+	assert.Equal(t, []string{testKmsKeyArn}, manager.readWriteKmsKeys)
+	assert.Equal(t, []string{"cohort-bucket"}, manager.requesterPaysBuckets)
+	assert.Equal(t, `{"cohort-bucket":"210987654321"}`, manager.bucketOwnersJson)
+}
+
+func TestManager_SetDataBuckets_InvalidLocation(t *testing.T) {
+	manager := Manager{baseProps: baseProps{projectSpec: spec.Project{Data: []spec.Data{{Location: "https://bucket/path"}}}}}
+
+	manager.setDataBuckets()
+
+	assert.EqualError(t, manager.err, "'https://bucket/path' is not an S3 URI, it must start with 's3://'")
+}
+
+func TestManager_BuildDataAccess(t *testing.T) {
+	manager := Manager{baseProps: baseProps{projectSpec: testDataAccessProjectSpec, userId: testUserId}}
+
+	dataAccess := manager.buildDataAccess(testContextName1)
+
+	require.Len(t, dataAccess, 4)
+	assert.Equal(t, DataAccess{Location: "s3://reference-bucket", ReadOnly: true}, dataAccess[0])
+	assert.Equal(t, "account 210987654321 must allow the roles of stack 'Agc-Context-testProjectName-bender123-testContextName1' "+
+		"s3:ListBucket on 'arn:aws:s3:::cohort-bucket' with the condition s3:prefix like 'cohortA/*' and s3:GetObject on "+
+		"'arn:aws:s3:::cohort-bucket/cohortA/*' in the bucket policy, and kms:Decrypt in the policy of key '"+testKmsKeyArn+"'", dataAccess[1].PolicyHint)
+	assert.True(t, dataAccess[1].RequesterPays)
+	assert.Empty(t, dataAccess[3].PolicyHint)
+}
+
+func TestRenderPolicyHint_ReadWrite(t *testing.T) {
+	hint := renderPolicyHint(spec.Data{Location: "s3://partner-bucket", BucketOwner: "210987654321"}, "Agc-Context-stack")
+
+	assert.Equal(t, "account 210987654321 must allow the roles of stack 'Agc-Context-stack' s3:ListBucket on 'arn:aws:s3:::partner-bucket' "+
+		"and s3:GetObject, s3:PutObject, s3:DeleteObject on 'arn:aws:s3:::partner-bucket/*' in the bucket policy", hint)
+}
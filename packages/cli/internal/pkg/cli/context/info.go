@@ -13,8 +13,10 @@ type Summary struct {
 	Name          string
 	MaxVCpus      int
 	IsSpot        bool
+	IsShared      bool
 	InstanceTypes []string
 	Engines       []spec.Engine
+	Region        string
 }
 
 func (s Summary) IsEmpty() bool {
@@ -36,6 +38,9 @@ type Detail struct {
 	WesLogGroupName    string
 	EngineLogGroupName string
 	AccessLogGroupName string
+	Tags               []spec.Tag
+	Data               []DataAccess
+	TargetProfile      string
 }
 
 type Instance struct {
@@ -43,6 +48,17 @@ type Instance struct {
 	ContextStatus          Status
 	ContextReason          string
 	IsDefinedInProjectFile bool
+	IsShared               bool
+	Region                 string
+}
+
+type Deployment struct {
+	Id         string
+	Time       string
+	UserEmail  string
+	AgcVersion string
+	Images     string
+	RollbackOf string
 }
 
 func (d Detail) IsEmpty() bool {
@@ -6,4 +6,7 @@ type Interface interface {
 	List() (map[string]Summary, error)
 	StatusList() ([]Instance, error)
 	Destroy(contexts []string) []ProgressResult
+	History(contextName string) ([]Deployment, error)
+	Rollback(contextName, deploymentId string) []ProgressResult
+	Upgrade(projectName, ownerId string, contexts []string) []ProgressResult
 }
@@ -1,16 +1,19 @@
 package context
 
 import (
+	"encoding/json"
 	"fmt"
 	"net/url"
+	"sort"
 	"strings"
 
 	"github.com/aws/amazon-genomics-cli/internal/pkg/aws"
 	"github.com/aws/amazon-genomics-cli/internal/pkg/aws/cdk"
 	"github.com/aws/amazon-genomics-cli/internal/pkg/aws/cfn"
+	"github.com/aws/amazon-genomics-cli/internal/pkg/aws/ddb"
 	"github.com/aws/amazon-genomics-cli/internal/pkg/aws/ecr"
-	"github.com/aws/amazon-genomics-cli/internal/pkg/aws/s3"
 	"github.com/aws/amazon-genomics-cli/internal/pkg/aws/ssm"
+	"github.com/aws/amazon-genomics-cli/internal/pkg/cli/awsresources"
 	"github.com/aws/amazon-genomics-cli/internal/pkg/cli/clierror/actionableerror"
 	"github.com/aws/amazon-genomics-cli/internal/pkg/cli/config"
 	"github.com/aws/amazon-genomics-cli/internal/pkg/cli/spec"
@@ -39,13 +42,20 @@ type baseProps struct {
 
 //nolint:structcheck
 type contextProps struct {
-	readBuckets      []string
-	readWriteBuckets []string
-	outputBucket     string
-	artifactBucket   string
-	artifactUrl      string
-	customTagsJson   string
-	contextEnv       contextEnvironment
+	readBuckets          []string
+	readWriteBuckets     []string
+	readKmsKeys          []string
+	readWriteKmsKeys     []string
+	requesterPaysBuckets []string
+	bucketOwnersJson     string
+	outputBucket         string
+	artifactBucket       string
+	artifactUrl          string
+	customTagsJson       string
+	tags                 []spec.Tag
+	taskEnvJson          string
+	taskSecretsJson      string
+	contextEnv           contextEnvironment
 }
 
 //nolint:structcheck
@@ -59,9 +69,16 @@ type listProps struct {
 	contexts map[string]Summary
 }
 
+//nolint:structcheck
+type deploymentProps struct {
+	pendingDeployments map[string]ddb.ContextDeployment
+	deploymentRecord   ddb.ContextDeployment
+}
+
 type Manager struct {
 	Cdk       cdk.Interface
 	Cfn       cfn.Interface
+	Ddb       ddb.Interface
 	Project   storage.ProjectClient
 	Config    storage.ConfigClient
 	Ssm       ssm.Interface
@@ -69,10 +86,15 @@ type Manager struct {
 	imageRefs map[string]ecr.ImageReference
 	region    string
 
+	profile       string
+	defaultRegion string
+	targetClients func(profile string) targetClients
+
 	baseProps
 	contextProps
 	infoProps
 	listProps
+	deploymentProps
 	err             error
 	progressResults []ProgressResult
 }
@@ -88,6 +110,11 @@ var showExecution = cdk.ShowExecution
 var silentExecution = cdk.SilentExecution
 
 func (m *Manager) getEnvironmentVars() []string {
+	return imageEnvironmentVars(m.getImageRefs())
+}
+
+// getImageRefs returns the images of the components used by the context's engine, in the region of the current profile
+func (m *Manager) getImageRefs() map[string]ecr.ImageReference {
 	// Different engines will need different environment variables to define
 	// their Docker images.
 	engine := m.contextEnv.EngineName
@@ -96,18 +123,36 @@ func (m *Manager) getEnvironmentVars() []string {
 	if environment.UsesWesAdapter[engine] {
 		relevantComponents = append(relevantComponents, constants.WES)
 	}
-	var environmentVars []string
+	imageRefs := make(map[string]ecr.ImageReference)
 	for _, component := range relevantComponents {
-		capComponent := strings.ToUpper(component)
 		// Each engine or other component has its own section in imageRefs
+		imageRefs[component] = ecr.ImageReference{
+			RegistryId:     m.imageRefs[component].RegistryId,
+			Region:         m.region,
+			RepositoryName: m.imageRefs[component].RepositoryName,
+			ImageTag:       m.imageRefs[component].ImageTag,
+		}
+	}
+	return imageRefs
+}
+
+func imageEnvironmentVars(imageRefs map[string]ecr.ImageReference) []string {
+	components := make([]string, 0, len(imageRefs))
+	for component := range imageRefs {
+		components = append(components, component)
+	}
+	sort.Strings(components)
+
+	var environmentVars []string
+	for _, component := range components {
+		capComponent := strings.ToUpper(component)
 		environmentVars = append(environmentVars,
-			fmt.Sprintf("ECR_%s_ACCOUNT_ID=%s", capComponent, m.imageRefs[component].RegistryId),
-			fmt.Sprintf("ECR_%s_REGION=%s", capComponent, m.region),
-			fmt.Sprintf("ECR_%s_TAG=%s", capComponent, m.imageRefs[component].ImageTag),
-			fmt.Sprintf("ECR_%s_REPOSITORY=%s", capComponent, m.imageRefs[component].RepositoryName),
+			fmt.Sprintf("ECR_%s_ACCOUNT_ID=%s", capComponent, imageRefs[component].RegistryId),
+			fmt.Sprintf("ECR_%s_REGION=%s", capComponent, imageRefs[component].Region),
+			fmt.Sprintf("ECR_%s_TAG=%s", capComponent, imageRefs[component].ImageTag),
+			fmt.Sprintf("ECR_%s_REPOSITORY=%s", capComponent, imageRefs[component].RepositoryName),
 		)
 	}
-
 	return environmentVars
 }
 
@@ -127,6 +172,7 @@ func NewManager(profile string) *Manager {
 	return &Manager{
 		Cdk:       aws.CdkClient(profile),
 		Cfn:       aws.CfnClient(profile),
+		Ddb:       aws.DdbClient(profile),
 		Project:   projectClient,
 		Config:    configClient,
 		Ssm:       aws.SsmClient(profile),
@@ -134,6 +180,10 @@ func NewManager(profile string) *Manager {
 		imageRefs: environment.CommonImages,
 		ecrClient: aws.EcrClient(profile),
 		region:    aws.Region(profile),
+
+		profile:       profile,
+		defaultRegion: aws.Region(profile),
+		targetClients: newTargetClients,
 	}
 }
 
@@ -156,24 +206,6 @@ func (m *Manager) readContextSpec(contextName string) {
 	m.contextSpec = contextSpec
 }
 
-func (m *Manager) setDataBuckets() {
-	if m.err != nil {
-		return
-	}
-	for _, dataItem := range m.projectSpec.Data {
-		s3Arn, err := s3.UriToArn(dataItem.Location)
-		if err != nil {
-			m.err = err
-			return
-		}
-		if dataItem.ReadOnly {
-			m.readBuckets = append(m.readBuckets, s3Arn)
-		} else {
-			m.readWriteBuckets = append(m.readWriteBuckets, s3Arn)
-		}
-	}
-}
-
 func (m *Manager) setArtifactUrl() {
 	if m.err != nil {
 		return
@@ -206,12 +238,67 @@ func (m *Manager) setOutputBucket() {
 	}
 }
 
-func (m *Manager) setCustomTags() {
+// setCustomTags merges the tags of the account, the project and the context, in increasing order of precedence
+func (m *Manager) setCustomTags(contextName string) {
+	if m.err != nil {
+		return
+	}
+
+	accountTags := make(map[string]string)
+	if accountTagsJson := m.Ssm.GetCustomTags(); accountTagsJson != "" {
+		if err := json.Unmarshal([]byte(accountTagsJson), &accountTags); err != nil {
+			m.err = fmt.Errorf("unable to parse the custom tags of the account: %w", err)
+			return
+		}
+	}
+
+	tags, conflicts := spec.MergeTags(
+		spec.TagSource{Name: "account", Tags: accountTags},
+		spec.TagSource{Name: "project", Tags: m.projectSpec.Tags},
+		spec.TagSource{Name: fmt.Sprintf("context '%s'", contextName), Tags: m.contextSpec.Tags},
+	)
+	for _, conflict := range conflicts {
+		log.Warn().Msgf("Conflicting cost allocation tags: %s", conflict)
+	}
+	m.tags = tags
+
+	m.customTagsJson = ""
+	if len(tags) > 0 {
+		tagBytes, err := json.Marshal(spec.TagMap(tags))
+		if err != nil {
+			m.err = err
+			return
+		}
+		m.customTagsJson = string(tagBytes)
+	}
+}
+
+func (m *Manager) setTaskEnvironment() {
 	if m.err != nil {
 		return
 	}
 
-	m.customTagsJson = m.Ssm.GetCustomTags()
+	m.taskEnvJson, m.taskSecretsJson = "", ""
+	if len(m.contextSpec.Environment) > 0 {
+		envBytes, err := json.Marshal(m.contextSpec.Environment)
+		if err != nil {
+			m.err = err
+			return
+		}
+		m.taskEnvJson = string(envBytes)
+	}
+	if len(m.contextSpec.Secrets) > 0 {
+		secrets := make([]spec.Secret, len(m.contextSpec.Secrets))
+		for i, secret := range m.contextSpec.Secrets {
+			secrets[i] = spec.Secret{Name: secret.Name, ValueFrom: secret.ValueFrom, Source: secret.GetSource()}
+		}
+		secretBytes, err := json.Marshal(secrets)
+		if err != nil {
+			m.err = err
+			return
+		}
+		m.taskSecretsJson = string(secretBytes)
+	}
 }
 
 func (m *Manager) setContextEnv(contextName string) {
@@ -225,20 +312,45 @@ func (m *Manager) setContextEnv(contextName string) {
 		return
 	}
 
+	instanceTypes := m.contextSpec.GetInstanceTypes()
+	if len(m.contextSpec.InstanceTypes) > 0 && len(instanceTypes) == 0 {
+		m.err = actionableerror.New(
+			fmt.Errorf("all instance types of context '%s' belong to excluded instance families", contextName),
+			"Please add instance types to the context that are not excluded by 'excludeInstanceFamilies' or 'excludeGpuInstances'",
+		)
+		return
+	}
+
 	m.contextEnv = contextEnvironment{
 		ProjectName:          m.projectSpec.Name,
 		ContextName:          contextName,
-		UserId:               m.userId,
+		UserId:               awsresources.RenderContextOwnerId(m.userId, context.Shared),
 		UserEmail:            m.userEmail,
 		OutputBucketName:     m.outputBucket,
 		CustomTagsJson:       m.customTagsJson,
 		ArtifactBucketName:   m.artifactBucket,
 		ReadBucketArns:       strings.Join(m.readBuckets, listDelimiter),
 		ReadWriteBucketArns:  strings.Join(m.readWriteBuckets, listDelimiter),
-		InstanceTypes:        strings.Join(m.contextSpec.InstanceTypes, listDelimiter),
+		ReadKmsKeyArns:       strings.Join(m.readKmsKeys, listDelimiter),
+		ReadWriteKmsKeyArns:  strings.Join(m.readWriteKmsKeys, listDelimiter),
+		RequesterPaysBuckets: strings.Join(m.requesterPaysBuckets, listDelimiter),
+		DataBucketOwnersJson: m.bucketOwnersJson,
+		InstanceTypes:        strings.Join(instanceTypes, listDelimiter),
 		MaxVCpus:             m.contextSpec.MaxVCpus,
 		RequestSpotInstances: m.contextSpec.RequestSpotInstances,
 		UsePublicSubnets:     m.contextSpec.UsePublicSubnets,
+		TaskEnvironmentJson:  m.taskEnvJson,
+		TaskSecretsJson:      m.taskSecretsJson,
+
+		MinVCpus:                 m.contextSpec.MinVCpus,
+		SpotBidPercentage:        m.contextSpec.SpotBidPercentage,
+		AllocationStrategy:       m.contextSpec.AllocationStrategy,
+		RootVolumeSize:           m.contextSpec.RootVolume.Size,
+		RootVolumeType:           m.contextSpec.RootVolume.VolumeType,
+		ScratchVolumeSize:        m.contextSpec.ScratchVolume.Size,
+		ScratchVolumeType:        m.contextSpec.ScratchVolume.VolumeType,
+		AmiId:                    m.contextSpec.AmiId,
+		ExcludedInstanceFamilies: strings.Join(m.contextSpec.GetExcludedInstanceFamilies(), listDelimiter),
 		// TODO: we default to a single engine in a context for now
 		// need to allow for multiple engines in the same context
 		EngineName:              context.Engines[0].Engine,
@@ -284,6 +396,11 @@ func (m *Manager) readConfig() {
 	m.userEmail, m.err = m.Config.GetUserEmailAddress()
 }
 
+// contextOwnerId returns the id under which the resources of the context are deployed
+func (m *Manager) contextOwnerId(contextName string) string {
+	return awsresources.RenderContextOwnerId(m.userId, m.projectSpec.Contexts[contextName].Shared)
+}
+
 func (m *Manager) readProjectInformation() {
 	if m.err != nil {
 		return
@@ -28,6 +28,7 @@ func (m *Manager) deployAllContexts(contexts []string) {
 
 	description := fmt.Sprintf("Deploying resources for context(s) %s", contextsWithStreams)
 	m.processExecution(progressStreams, description)
+	m.recordDeployments()
 }
 
 func (m *Manager) getStreamsForCdkDeployments(contexts []string) ([]cdk.ProgressStream, []string) {
@@ -35,7 +36,8 @@ func (m *Manager) getStreamsForCdkDeployments(contexts []string) ([]cdk.Progress
 	var contextsWithStreams []string
 	for _, contextName := range contexts {
 		m.readContextSpec(contextName)
-		m.setCdkConfigurationForDeployment()
+		m.useContextTarget(contextName)
+		m.setCdkConfigurationForDeployment(contextName)
 		m.clearCdkContext(contextDir)
 		m.setContextEnv(contextName)
 		m.validateImage()
@@ -52,12 +54,13 @@ func (m *Manager) getStreamsForCdkDeployments(contexts []string) ([]cdk.Progress
 	return progressStreams, contextsWithStreams
 }
 
-func (m *Manager) setCdkConfigurationForDeployment() {
+func (m *Manager) setCdkConfigurationForDeployment(contextName string) {
 	m.setDataBuckets()
 	m.setOutputBucket()
 	m.setArtifactUrl()
 	m.setArtifactBucket()
-	m.setCustomTags()
+	m.setCustomTags(contextName)
+	m.setTaskEnvironment()
 }
 
 func (m *Manager) clearCdkContext(appDir string) {
@@ -78,6 +81,8 @@ func (m *Manager) deployContext(contextName string) cdk.ProgressStream {
 
 	if err != nil {
 		m.progressResults = append(m.progressResults, ProgressResult{Context: contextName, Err: err})
+		return progressStream
 	}
+	m.addPendingDeployment(contextName, m.newDeploymentRecord(contextName))
 	return progressStream
 }
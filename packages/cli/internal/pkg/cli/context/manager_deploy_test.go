@@ -19,7 +19,7 @@ const (
 	// We check a lot of generated CDK commands to make sure they have the
 	// right number of command line arguments. How many should there be to
 	// start?
-	testCdkBaseArgumentCount = 27
+	testCdkBaseArgumentCount = 42
 	// And how many do we expect if the WES adapter images are also to be
 	// passed?
 	testCdkAdaptedArgumentCount = testCdkBaseArgumentCount + 4
@@ -60,6 +60,7 @@ func TestManager_Deploy(t *testing.T) {
 				mockClients.cdkMock.EXPECT().DeployApp(filepath.Join(testHomeDir, ".agc/cdk/apps/context"), gomock.Len(testCdkAdaptedArgumentCount), testContextName3).After(clearContext).Return(mockClients.progressStream1, nil)
 				displayProgressBar = mockClients.cdkMock.DisplayProgressBar
 				mockClients.cdkMock.EXPECT().DisplayProgressBar(fmt.Sprintf("Deploying resources for context(s) %s", []string{testContextName3}), []cdk.ProgressStream{mockClients.progressStream1}).Return([]cdk.Result{{Outputs: []string{"some message"}, ExecutionName: testContextName3}})
+				mockClients.ddbMock.EXPECT().WriteContextDeployment(gomock.Any(), gomock.Any()).Return(nil)
 				return mockClients
 			},
 		},
@@ -83,6 +84,7 @@ func TestManager_Deploy(t *testing.T) {
 				mockClients.cdkMock.EXPECT().DeployApp(filepath.Join(testHomeDir, ".agc/cdk/apps/context"), gomock.Len(testCdkAdaptedArgumentCount), testContextName3).After(clearContext).Return(mockClients.progressStream1, nil)
 				displayProgressBar = mockClients.cdkMock.DisplayProgressBar
 				mockClients.cdkMock.EXPECT().DisplayProgressBar(fmt.Sprintf("Deploying resources for context(s) %s", []string{testContextName3}), []cdk.ProgressStream{mockClients.progressStream1}).Return([]cdk.Result{{Outputs: []string{"some message"}, ExecutionName: testContextName3}})
+				mockClients.ddbMock.EXPECT().WriteContextDeployment(gomock.Any(), gomock.Any()).Return(nil)
 				return mockClients
 			},
 		},
@@ -106,6 +108,7 @@ func TestManager_Deploy(t *testing.T) {
 				mockClients.cdkMock.EXPECT().DeployApp(filepath.Join(testHomeDir, ".agc/cdk/apps/context"), gomock.Len(testCdkBaseArgumentCount), testContextName4).After(clearContext).Return(mockClients.progressStream1, nil)
 				displayProgressBar = mockClients.cdkMock.DisplayProgressBar
 				mockClients.cdkMock.EXPECT().DisplayProgressBar(fmt.Sprintf("Deploying resources for context(s) %s", []string{testContextName4}), []cdk.ProgressStream{mockClients.progressStream1}).Return([]cdk.Result{{Outputs: []string{"some message"}, ExecutionName: testContextName4}})
+				mockClients.ddbMock.EXPECT().WriteContextDeployment(gomock.Any(), gomock.Any()).Return(nil)
 				return mockClients
 			},
 		},
@@ -133,6 +136,7 @@ func TestManager_Deploy(t *testing.T) {
 				displayProgressBar = mockClients.cdkMock.DisplayProgressBar
 				expectedCdkResult := []cdk.Result{{Outputs: []string{"some message"}, ExecutionName: testContextName1}, {Outputs: []string{"some other message"}, ExecutionName: testContextName2}}
 				mockClients.cdkMock.EXPECT().DisplayProgressBar(fmt.Sprintf("Deploying resources for context(s) %s", []string{testContextName1, testContextName2}), []cdk.ProgressStream{mockClients.progressStream1, mockClients.progressStream2}).Return(expectedCdkResult)
+				mockClients.ddbMock.EXPECT().WriteContextDeployment(gomock.Any(), gomock.Any()).Times(2).Return(nil)
 				return mockClients
 			},
 		},
@@ -303,6 +307,7 @@ func TestManager_Deploy(t *testing.T) {
 				Ssm:       mockClients.ssmMock,
 				Config:    mockClients.configMock,
 				Cfn:       mockClients.cfnMock,
+				Ddb:       mockClients.ddbMock,
 				ecrClient: mockClients.ecrClientMock,
 				baseProps: baseProps{homeDir: testHomeDir},
 				imageRefs: environment.CommonImages,
@@ -25,6 +25,7 @@ func (m *Manager) getStreamsForCdkDestroys(contexts []string) ([]cdk.ProgressStr
 	var progressStreams []cdk.ProgressStream
 	var contextsWithStreams []string
 	for _, contextName := range contexts {
+		m.useContextTarget(contextName)
 		m.setContextEnv(contextName)
 		m.setContextPlaceholders()
 		progressStream := m.destroyContext(contextName)
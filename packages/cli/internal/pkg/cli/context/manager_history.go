@@ -0,0 +1,172 @@
+package context
+
+import (
+	ctx "context"
+	"fmt"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/aws/amazon-genomics-cli/internal/pkg/aws/cdk"
+	"github.com/aws/amazon-genomics-cli/internal/pkg/aws/ddb"
+	"github.com/aws/amazon-genomics-cli/internal/pkg/version"
+	"github.com/rs/zerolog/log"
+)
+
+const agcVersionEnvKey = "AGC_VERSION"
+
+// History returns the recorded deployments of a context, most recent first
+func (m *Manager) History(contextName string) ([]Deployment, error) {
+	m.readProjectInformation()
+	m.validateContextName(contextName)
+	m.useContextTarget(contextName)
+	if m.err != nil {
+		return nil, m.err
+	}
+	records, err := m.Ddb.ListContextDeployments(ctx.Background(), m.projectSpec.Name, m.contextOwnerId(contextName), contextName)
+	if err != nil {
+		return nil, err
+	}
+	deployments := make([]Deployment, len(records))
+	for i, record := range records {
+		deployments[i] = deploymentFromRecord(record)
+	}
+	return deployments, nil
+}
+
+// Rollback redeploys a context with the environment and engine images recorded for an earlier deployment
+func (m *Manager) Rollback(contextName, deploymentId string) []ProgressResult {
+	m.readProjectInformation()
+	m.validateContextName(contextName)
+	m.useContextTarget(contextName)
+	m.readDeploymentRecord(contextName, deploymentId)
+	m.verifyRecordedImages()
+	m.clearCdkContext(contextDir)
+
+	progressStream := m.rollbackContext(contextName, deploymentId)
+	if progressStream != nil {
+		description := fmt.Sprintf("Rolling back context '%s' to deployment '%s'", contextName, deploymentId)
+		m.processExecution([]cdk.ProgressStream{progressStream}, description)
+		m.recordDeployments()
+	}
+	return m.progressResults
+}
+
+func (m *Manager) validateContextName(contextName string) {
+	if m.err != nil {
+		return
+	}
+	_, m.err = m.projectSpec.GetContext(contextName)
+}
+
+func (m *Manager) readDeploymentRecord(contextName, deploymentId string) {
+	if m.err != nil {
+		return
+	}
+	m.deploymentRecord, m.err = m.Ddb.GetContextDeployment(ctx.Background(), m.projectSpec.Name, m.contextOwnerId(contextName), contextName, deploymentId)
+	if m.err == nil && m.deploymentRecord.AgcVersion != version.Version {
+		log.Warn().Msgf("Deployment '%s' was made with AGC version '%s'. Its configuration and engine images will be deployed with the infrastructure of version '%s'",
+			deploymentId, m.deploymentRecord.AgcVersion, version.Version)
+	}
+}
+
+func (m *Manager) verifyRecordedImages() {
+	if m.err != nil {
+		return
+	}
+	for _, imageRef := range m.deploymentRecord.ImageRefs {
+		if m.err = m.ecrClient.VerifyImageExists(imageRef); m.err != nil {
+			return
+		}
+	}
+}
+
+func (m *Manager) rollbackContext(contextName, deploymentId string) cdk.ProgressStream {
+	if m.err != nil {
+		m.progressResults = append(m.progressResults, ProgressResult{Context: contextName, Err: m.err})
+		return nil
+	}
+
+	environment := make(map[string]string, len(m.deploymentRecord.Environment))
+	for key, value := range m.deploymentRecord.Environment {
+		environment[key] = value
+	}
+	environment[agcVersionEnvKey] = version.Version
+
+	deploymentVars := append(environmentMapToList(environment), imageEnvironmentVars(m.deploymentRecord.ImageRefs)...)
+	progressStream, err := m.Cdk.DeployApp(filepath.Join(m.homeDir, cdkAppsDirBase, contextDir), deploymentVars, contextName)
+	if err != nil {
+		m.progressResults = append(m.progressResults, ProgressResult{Context: contextName, Err: err})
+		return progressStream
+	}
+
+	m.addPendingDeployment(contextName, ddb.ContextDeployment{
+		ProjectName: m.projectSpec.Name,
+		ContextName: contextName,
+		OwnerId:     m.contextOwnerId(contextName),
+		UserId:      m.userId,
+		UserEmail:   m.userEmail,
+		AgcVersion:  version.Version,
+		RollbackOf:  deploymentId,
+		Environment: environment,
+		ImageRefs:   m.deploymentRecord.ImageRefs,
+	})
+	return progressStream
+}
+
+func (m *Manager) newDeploymentRecord(contextName string) ddb.ContextDeployment {
+	return ddb.ContextDeployment{
+		ProjectName: m.projectSpec.Name,
+		ContextName: contextName,
+		OwnerId:     m.contextEnv.UserId,
+		UserId:      m.userId,
+		UserEmail:   m.userEmail,
+		AgcVersion:  version.Version,
+		Environment: m.contextEnv.toEnvironmentMap(),
+		ImageRefs:   m.getImageRefs(),
+	}
+}
+
+func (m *Manager) addPendingDeployment(contextName string, deployment ddb.ContextDeployment) {
+	if m.pendingDeployments == nil {
+		m.pendingDeployments = make(map[string]ddb.ContextDeployment)
+	}
+	m.pendingDeployments[contextName] = deployment
+}
+
+// recordDeployments writes the deployments that succeeded to the deployment history. A failure to record
+// a deployment is not a failure of the deployment itself, so it is only logged.
+func (m *Manager) recordDeployments() {
+	for _, result := range m.progressResults {
+		deployment, ok := m.pendingDeployments[result.Context]
+		if !ok || result.Err != nil {
+			continue
+		}
+		m.useContextTarget(result.Context)
+		if err := m.Ddb.WriteContextDeployment(ctx.Background(), deployment); err != nil {
+			log.Warn().Err(err).Msgf("Unable to record the deployment of context '%s' in its deployment history", result.Context)
+		}
+	}
+	m.pendingDeployments = nil
+}
+
+func deploymentFromRecord(record ddb.ContextDeployment) Deployment {
+	components := make([]string, 0, len(record.ImageRefs))
+	for component := range record.ImageRefs {
+		components = append(components, component)
+	}
+	sort.Strings(components)
+	images := make([]string, len(components))
+	for i, component := range components {
+		imageRef := record.ImageRefs[component]
+		images[i] = fmt.Sprintf("%s=%s:%s", component, imageRef.RepositoryName, imageRef.ImageTag)
+	}
+	return Deployment{
+		Id:         record.DeploymentId,
+		Time:       record.CreatedTime,
+		UserEmail:  record.UserEmail,
+		AgcVersion: record.AgcVersion,
+		Images:     strings.Join(images, ", "),
+		RollbackOf: record.RollbackOf,
+	}
+}
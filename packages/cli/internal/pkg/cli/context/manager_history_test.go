@@ -0,0 +1,165 @@
+package context
+
+import (
+	ctx "context"
+	"fmt"
+	"path/filepath"
+	"testing"
+
+	"github.com/aws/amazon-genomics-cli/internal/pkg/aws/cdk"
+	"github.com/aws/amazon-genomics-cli/internal/pkg/aws/ddb"
+	"github.com/aws/amazon-genomics-cli/internal/pkg/aws/ecr"
+	"github.com/aws/amazon-genomics-cli/internal/pkg/logging"
+	"github.com/aws/amazon-genomics-cli/internal/pkg/version"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+)
+
+const testDeploymentId = "20221019T113948Z"
+
+var (
+	testCromwellImage = ecr.ImageReference{RegistryId: "111122223333", Region: "us-east-1", RepositoryName: "aws/cromwell-mirror", ImageTag: "63"}
+	testWesImage      = ecr.ImageReference{RegistryId: "111122223333", Region: "us-east-1", RepositoryName: "aws/wes-release", ImageTag: "0.0.9"}
+	testDeployment    = ddb.ContextDeployment{
+		DeploymentId: testDeploymentId,
+		ProjectName:  testProjectName,
+		ContextName:  testContextName1,
+		OwnerId:      testUserId,
+		UserId:       testUserId,
+		UserEmail:    testUserEmail,
+		AgcVersion:   "1.4.0",
+		CreatedTime:  "2022-10-19T11:39:48Z",
+		Environment:  map[string]string{"PROJECT": testProjectName, "CONTEXT": testContextName1, "AGC_VERSION": "1.4.0"},
+		ImageRefs:    map[string]ecr.ImageReference{"cromwell": testCromwellImage, "wes": testWesImage},
+	}
+)
+
+func TestManager_History(t *testing.T) {
+	mockClients := createMocks(t)
+	defer mockClients.ctrl.Finish()
+	mockClients.configMock.EXPECT().GetUserEmailAddress().Return(testUserEmail, nil)
+	mockClients.configMock.EXPECT().GetUserId().Return(testUserId, nil)
+	mockClients.projMock.EXPECT().Read().Return(testValidProjectSpec, nil)
+	mockClients.ddbMock.EXPECT().ListContextDeployments(ctx.Background(), testProjectName, testUserId, testContextName1).
+		Return([]ddb.ContextDeployment{testDeployment}, nil)
+	manager := Manager{Project: mockClients.projMock, Config: mockClients.configMock, Ddb: mockClients.ddbMock}
+
+	deployments, err := manager.History(testContextName1)
+
+	assert.NoError(t, err)
+	assert.Equal(t, []Deployment{{
+		Id:         testDeploymentId,
+		Time:       "2022-10-19T11:39:48Z",
+		UserEmail:  testUserEmail,
+		AgcVersion: "1.4.0",
+		Images:     "cromwell=aws/cromwell-mirror:63, wes=aws/wes-release:0.0.9",
+	}}, deployments)
+}
+
+func TestManager_History_UnknownContext(t *testing.T) {
+	mockClients := createMocks(t)
+	defer mockClients.ctrl.Finish()
+	mockClients.configMock.EXPECT().GetUserEmailAddress().Return(testUserEmail, nil)
+	mockClients.configMock.EXPECT().GetUserId().Return(testUserId, nil)
+	mockClients.projMock.EXPECT().Read().Return(testValidProjectSpec, nil)
+	manager := Manager{Project: mockClients.projMock, Config: mockClients.configMock, Ddb: mockClients.ddbMock}
+
+	_, err := manager.History(testUnknownContextName)
+
+	assert.Contains(t, err.Error(), fmt.Sprintf("context '%s' is not defined in Project '%s' specification", testUnknownContextName, testProjectName))
+}
+
+func TestManager_Rollback(t *testing.T) {
+	origVerbose := logging.Verbose
+	origDisplayProgressBar := displayProgressBar
+	defer func() {
+		logging.Verbose = origVerbose
+		displayProgressBar = origDisplayProgressBar
+	}()
+	logging.Verbose = false
+
+	mockClients := createMocks(t)
+	defer mockClients.ctrl.Finish()
+	defer close(mockClients.progressStream1)
+	mockClients.configMock.EXPECT().GetUserEmailAddress().Return(testUserEmail, nil)
+	mockClients.configMock.EXPECT().GetUserId().Return(testUserId, nil)
+	mockClients.projMock.EXPECT().Read().Return(testValidProjectSpec, nil)
+	mockClients.ddbMock.EXPECT().GetContextDeployment(ctx.Background(), testProjectName, testUserId, testContextName1, testDeploymentId).
+		Return(testDeployment, nil)
+	mockClients.ecrClientMock.EXPECT().VerifyImageExists(testCromwellImage).Return(nil)
+	mockClients.ecrClientMock.EXPECT().VerifyImageExists(testWesImage).Return(nil)
+	appDir := filepath.Join(testHomeDir, ".agc/cdk/apps/context")
+	clearContext := mockClients.cdkMock.EXPECT().ClearContext(appDir).Return(nil)
+	expectedVars := []string{
+		"ECR_CROMWELL_ACCOUNT_ID=111122223333",
+		"ECR_CROMWELL_REGION=us-east-1",
+		"ECR_CROMWELL_TAG=63",
+		"ECR_CROMWELL_REPOSITORY=aws/cromwell-mirror",
+		"ECR_WES_ACCOUNT_ID=111122223333",
+		"ECR_WES_REGION=us-east-1",
+		"ECR_WES_TAG=0.0.9",
+		"ECR_WES_REPOSITORY=aws/wes-release",
+	}
+	mockClients.cdkMock.EXPECT().DeployApp(appDir, gomock.Any(), testContextName1).After(clearContext).
+		DoAndReturn(func(_ string, vars []string, _ string) (cdk.ProgressStream, error) {
+			assert.Len(t, vars, len(testDeployment.Environment)+len(expectedVars))
+			assert.Contains(t, vars, "AGC_VERSION="+version.Version)
+			assert.Contains(t, vars, "CONTEXT="+testContextName1)
+			assert.Subset(t, vars, expectedVars)
+			return mockClients.progressStream1, nil
+		})
+	displayProgressBar = mockClients.cdkMock.DisplayProgressBar
+	mockClients.cdkMock.EXPECT().DisplayProgressBar(fmt.Sprintf("Rolling back context '%s' to deployment '%s'", testContextName1, testDeploymentId), []cdk.ProgressStream{mockClients.progressStream1}).
+		Return([]cdk.Result{{Outputs: []string{"some message"}, ExecutionName: testContextName1}})
+	mockClients.ddbMock.EXPECT().WriteContextDeployment(ctx.Background(), gomock.Any()).
+		DoAndReturn(func(_ ctx.Context, deployment ddb.ContextDeployment) error {
+			assert.Equal(t, testDeploymentId, deployment.RollbackOf)
+			assert.Equal(t, version.Version, deployment.AgcVersion)
+			assert.Equal(t, testDeployment.ImageRefs, deployment.ImageRefs)
+			return nil
+		})
+	manager := Manager{
+		Cdk:       mockClients.cdkMock,
+		Project:   mockClients.projMock,
+		Config:    mockClients.configMock,
+		Ddb:       mockClients.ddbMock,
+		ecrClient: mockClients.ecrClientMock,
+		baseProps: baseProps{homeDir: testHomeDir},
+	}
+
+	results := manager.Rollback(testContextName1, testDeploymentId)
+
+	assert.Equal(t, []ProgressResult{{Context: testContextName1, Outputs: []string{"some message"}}}, results)
+}
+
+func TestManager_Rollback_DeploymentNotFound(t *testing.T) {
+	mockClients := createMocks(t)
+	defer mockClients.ctrl.Finish()
+	mockClients.configMock.EXPECT().GetUserEmailAddress().Return(testUserEmail, nil)
+	mockClients.configMock.EXPECT().GetUserId().Return(testUserId, nil)
+	mockClients.projMock.EXPECT().Read().Return(testValidProjectSpec, nil)
+	expectedErr := fmt.Errorf("deployment 'unknown' of context '%s' does not exist", testContextName1)
+	mockClients.ddbMock.EXPECT().GetContextDeployment(ctx.Background(), testProjectName, testUserId, testContextName1, "unknown").
+		Return(ddb.ContextDeployment{}, expectedErr)
+	manager := Manager{Project: mockClients.projMock, Config: mockClients.configMock, Ddb: mockClients.ddbMock}
+
+	results := manager.Rollback(testContextName1, "unknown")
+
+	assert.Equal(t, []ProgressResult{{Context: testContextName1, Err: expectedErr}}, results)
+}
+
+func TestManager_RecordDeployments_WriteFailureIsNotFatal(t *testing.T) {
+	mockClients := createMocks(t)
+	defer mockClients.ctrl.Finish()
+	mockClients.ddbMock.EXPECT().WriteContextDeployment(ctx.Background(), testDeployment).Return(fmt.Errorf("some ddb error"))
+	manager := Manager{
+		Ddb:             mockClients.ddbMock,
+		deploymentProps: deploymentProps{pendingDeployments: map[string]ddb.ContextDeployment{testContextName1: testDeployment, testContextName2: testDeployment}},
+		progressResults: []ProgressResult{{Context: testContextName1}, {Context: testContextName2, Err: fmt.Errorf("some deploy error")}},
+	}
+
+	manager.recordDeployments()
+
+	assert.NoError(t, manager.err)
+	assert.Nil(t, manager.pendingDeployments)
+}
@@ -11,7 +11,10 @@ import (
 func (m *Manager) Info(contextName string) (Detail, error) {
 	m.readProjectSpec()
 	m.readConfig()
+	m.readContextSpec(contextName)
+	m.useContextTarget(contextName)
 	m.setOutputBucket()
+	m.setCustomTags(contextName)
 	m.setContextStackInfo(contextName)
 	m.setContextEnv(contextName)
 	m.parseContextStatus()
@@ -22,7 +25,7 @@ func (m *Manager) setContextStackInfo(contextName string) {
 	if m.err != nil {
 		return
 	}
-	engineStackName := awsresources.RenderContextStackName(m.projectSpec.Name, contextName, m.userId)
+	engineStackName := awsresources.RenderContextStackName(m.projectSpec.Name, contextName, m.contextOwnerId(contextName))
 	m.contextStackInfo, m.err = m.Cfn.GetStackInfo(engineStackName)
 	if errors.Is(m.err, cfn.StackDoesNotExistError) {
 		m.err = nil
@@ -56,15 +59,20 @@ func (m *Manager) buildContextInfo(contextName string) (Detail, error) {
 		Summary: Summary{
 			Name:          contextName,
 			IsSpot:        m.projectSpec.Contexts[contextName].RequestSpotInstances,
+			IsShared:      m.projectSpec.Contexts[contextName].Shared,
 			MaxVCpus:      m.projectSpec.Contexts[contextName].MaxVCpus,
 			InstanceTypes: m.projectSpec.Contexts[contextName].InstanceTypes,
+			Region:        m.contextRegion(m.projectSpec.Contexts[contextName]),
 		},
 		Status:             m.contextStatus,
-		BucketLocation:     s3.RenderS3Uri(m.outputBucket, awsresources.RenderBucketContextKey(m.projectSpec.Name, m.userId, contextName)),
+		BucketLocation:     s3.RenderS3Uri(m.outputBucket, awsresources.RenderBucketContextKey(m.projectSpec.Name, m.contextOwnerId(contextName), contextName)),
 		WesUrl:             m.contextStackInfo.Outputs["WesUrl"],
 		WesLogGroupName:    m.contextStackInfo.Outputs["AdapterLogGroupName"],
 		EngineLogGroupName: m.contextStackInfo.Outputs["EngineLogGroupName"],
 		AccessLogGroupName: m.contextStackInfo.Outputs["AccessLogGroupName"],
+		Tags:               m.tags,
+		Data:               m.buildDataAccess(contextName),
+		TargetProfile:      m.contextTargetProfile(m.projectSpec.Contexts[contextName]),
 	}
 	return contextInfo, m.err
 }
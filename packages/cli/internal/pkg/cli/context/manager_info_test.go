@@ -32,6 +32,7 @@ func TestManager_Info(t *testing.T) {
 				mockClients.configMock.EXPECT().GetUserId().Return(testUserId, nil)
 				mockClients.projMock.EXPECT().Read().Return(spec.Project{Name: testProjectName, Contexts: map[string]spec.Context{testContextName1: {RequestSpotInstances: true, InstanceTypes: []string{"c5"}, Engines: []spec.Engine{{Type: "wdl", Engine: "cromwell"}}}}}, nil)
 				mockClients.ssmMock.EXPECT().GetOutputBucket().Return(testOutputBucket, nil)
+				mockClients.ssmMock.EXPECT().GetCustomTags().Return("")
 				mockClients.cfnMock.EXPECT().GetStackInfo("Agc-Context-testProjectName-bender123-testContextName1").
 					Return(cfn.StackInfo{}, cfn.StackDoesNotExistError)
 				return mockClients
@@ -51,6 +52,7 @@ func TestManager_Info(t *testing.T) {
 				mockClients.configMock.EXPECT().GetUserId().Return(testUserId, nil)
 				mockClients.projMock.EXPECT().Read().Return(spec.Project{Name: testProjectName, Contexts: map[string]spec.Context{testContextName1: {Engines: []spec.Engine{{Type: "wdl", Engine: "cromwell"}}}}}, nil)
 				mockClients.ssmMock.EXPECT().GetOutputBucket().Return(testOutputBucket, nil)
+				mockClients.ssmMock.EXPECT().GetCustomTags().Return("")
 				mockClients.cfnMock.EXPECT().GetStackInfo("Agc-Context-testProjectName-bender123-testContextName1").
 					Return(cfn.StackInfo{Status: types.StackStatusCreateComplete, Outputs: map[string]string{"WesUrl": testWesUrl, "EngineLogGroupName": testLogGroupName}}, nil)
 				return mockClients
@@ -70,6 +72,7 @@ func TestManager_Info(t *testing.T) {
 				mockClients.configMock.EXPECT().GetUserId().Return(testUserId, nil)
 				mockClients.projMock.EXPECT().Read().Return(spec.Project{Name: testProjectName, Contexts: map[string]spec.Context{testContextName1: {Engines: []spec.Engine{{Type: "wdl", Engine: "cromwell"}}}}}, nil)
 				mockClients.ssmMock.EXPECT().GetOutputBucket().Return(testOutputBucket, nil)
+				mockClients.ssmMock.EXPECT().GetCustomTags().Return("")
 				mockClients.cfnMock.EXPECT().GetStackInfo("Agc-Context-testProjectName-bender123-testContextName1").
 					Return(cfn.StackInfo{Status: types.StackStatusCreateFailed, Outputs: map[string]string{"WesUrl": testWesUrl, "EngineLogGroupName": testLogGroupName}}, nil)
 				return mockClients
@@ -89,6 +92,7 @@ func TestManager_Info(t *testing.T) {
 				mockClients.configMock.EXPECT().GetUserId().Return(testUserId, nil)
 				mockClients.projMock.EXPECT().Read().Return(spec.Project{Name: testProjectName, Contexts: map[string]spec.Context{testContextName1: {Engines: []spec.Engine{{Type: "wdl", Engine: "cromwell"}}}}}, nil)
 				mockClients.ssmMock.EXPECT().GetOutputBucket().Return(testOutputBucket, nil)
+				mockClients.ssmMock.EXPECT().GetCustomTags().Return("")
 				mockClients.cfnMock.EXPECT().GetStackInfo("Agc-Context-testProjectName-bender123-testContextName1").
 					Return(cfn.StackInfo{Status: types.StackStatusDeleteComplete, Outputs: map[string]string{"WesUrl": testWesUrl, "EngineLogGroupName": testLogGroupName}}, nil)
 				return mockClients
@@ -101,9 +105,48 @@ func TestManager_Info(t *testing.T) {
 				mockClients.configMock.EXPECT().GetUserEmailAddress().Return(testUserEmail, nil)
 				mockClients.configMock.EXPECT().GetUserId().Return(testUserId, nil)
 				mockClients.projMock.EXPECT().Read().Return(spec.Project{Name: testProjectName}, nil)
+				return mockClients
+			},
+		},
+		"context tags": {
+			expectedInfo: Detail{
+				Summary:        Summary{Name: testContextName1},
+				Status:         StatusNotStarted,
+				BucketLocation: "s3://test-output-bucket/project/testProjectName/userid/bender123/context/testContextName1",
+				Tags: []spec.Tag{
+					{Key: "k1", Value: "context-v1", Source: "context 'testContextName1'"},
+					{Key: "k2", Value: "v2", Source: "account"},
+					{Key: "k3", Value: "v3", Source: "project"},
+				},
+			},
+			setupMocks: func(t *testing.T) mockClients {
+				mockClients := createMocks(t)
+				mockClients.configMock.EXPECT().GetUserEmailAddress().Return(testUserEmail, nil)
+				mockClients.configMock.EXPECT().GetUserId().Return(testUserId, nil)
+				mockClients.projMock.EXPECT().Read().Return(spec.Project{
+					Name: testProjectName,
+					Tags: map[string]string{"k1": "project-v1", "k3": "v3"},
+					Contexts: map[string]spec.Context{testContextName1: {
+						Tags:    map[string]string{"k1": "context-v1"},
+						Engines: []spec.Engine{{Type: "wdl", Engine: "cromwell"}},
+					}},
+				}, nil)
 				mockClients.ssmMock.EXPECT().GetOutputBucket().Return(testOutputBucket, nil)
+				mockClients.ssmMock.EXPECT().GetCustomTags().Return(testTags)
 				mockClients.cfnMock.EXPECT().GetStackInfo("Agc-Context-testProjectName-bender123-testContextName1").
-					Return(cfn.StackInfo{Status: "unknown", Outputs: map[string]string{"EngineLogGroupName": testLogGroupName}}, nil)
+					Return(cfn.StackInfo{}, cfn.StackDoesNotExistError)
+				return mockClients
+			},
+		},
+		"invalid account tags": {
+			expectedErrMessage: "unable to parse the custom tags of the account",
+			setupMocks: func(t *testing.T) mockClients {
+				mockClients := createMocks(t)
+				mockClients.configMock.EXPECT().GetUserEmailAddress().Return(testUserEmail, nil)
+				mockClients.configMock.EXPECT().GetUserId().Return(testUserId, nil)
+				mockClients.projMock.EXPECT().Read().Return(spec.Project{Name: testProjectName, Contexts: map[string]spec.Context{testContextName1: {Engines: []spec.Engine{{Type: "wdl", Engine: "cromwell"}}}}}, nil)
+				mockClients.ssmMock.EXPECT().GetOutputBucket().Return(testOutputBucket, nil)
+				mockClients.ssmMock.EXPECT().GetCustomTags().Return("{")
 				return mockClients
 			},
 		},
@@ -121,7 +164,7 @@ func TestManager_Info(t *testing.T) {
 				mockClients := createMocks(t)
 				mockClients.configMock.EXPECT().GetUserEmailAddress().Return(testUserEmail, nil)
 				mockClients.configMock.EXPECT().GetUserId().Return(testUserId, nil)
-				mockClients.projMock.EXPECT().Read().Return(spec.Project{Name: testProjectName}, nil)
+				mockClients.projMock.EXPECT().Read().Return(spec.Project{Name: testProjectName, Contexts: map[string]spec.Context{testContextName1: {Engines: []spec.Engine{{Type: "wdl", Engine: "cromwell"}}}}}, nil)
 				mockClients.ssmMock.EXPECT().GetOutputBucket().Return("", fmt.Errorf("some output bucket error"))
 				return mockClients
 			},
@@ -134,6 +177,7 @@ func TestManager_Info(t *testing.T) {
 				mockClients.configMock.EXPECT().GetUserId().Return(testUserId, nil)
 				mockClients.projMock.EXPECT().Read().Return(spec.Project{Name: testProjectName, Contexts: map[string]spec.Context{testContextName1: {Engines: []spec.Engine{{Type: "wdl", Engine: "cromwell"}}}}}, nil)
 				mockClients.ssmMock.EXPECT().GetOutputBucket().Return(testOutputBucket, nil)
+				mockClients.ssmMock.EXPECT().GetCustomTags().Return("")
 				mockClients.cfnMock.EXPECT().GetStackInfo("Agc-Context-testProjectName-bender123-testContextName1").
 					Return(cfn.StackInfo{}, fmt.Errorf("some stack error"))
 				return mockClients
@@ -146,9 +190,6 @@ func TestManager_Info(t *testing.T) {
 				mockClients.configMock.EXPECT().GetUserEmailAddress().Return(testUserEmail, nil)
 				mockClients.configMock.EXPECT().GetUserId().Return(testUserId, nil)
 				mockClients.projMock.EXPECT().Read().Return(spec.Project{Name: testProjectName, Contexts: map[string]spec.Context{testUnknownContextName: {Engines: []spec.Engine{{Type: "wdl", Engine: "cromwell"}}}}}, nil)
-				mockClients.ssmMock.EXPECT().GetOutputBucket().Return(testOutputBucket, nil)
-				mockClients.cfnMock.EXPECT().GetStackInfo("Agc-Context-testProjectName-bender123-testContextName1").
-					Return(cfn.StackInfo{}, cfn.StackDoesNotExistError)
 				return mockClients
 			},
 		},
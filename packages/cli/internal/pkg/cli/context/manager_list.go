@@ -19,8 +19,12 @@ func (m *Manager) getLocalContexts() {
 	if m.err != nil {
 		return
 	}
-	for contextName := range m.projectSpec.Contexts {
-		engines := m.projectSpec.Contexts[contextName].Engines
-		m.contexts[contextName] = Summary{Name: contextName, Engines: engines}
+	for contextName, contextSpec := range m.projectSpec.Contexts {
+		m.contexts[contextName] = Summary{
+			Name:     contextName,
+			Engines:  contextSpec.Engines,
+			IsShared: contextSpec.Shared,
+			Region:   m.contextRegion(contextSpec),
+		}
 	}
 }
@@ -2,6 +2,7 @@ package context
 
 import (
 	"regexp"
+	"sort"
 
 	"github.com/aws/amazon-genomics-cli/internal/pkg/aws/cfn"
 	"github.com/aws/amazon-genomics-cli/internal/pkg/cli/awsresources"
@@ -19,25 +20,69 @@ func (m *Manager) getAllContexts() ([]Instance, error) {
 	if m.err != nil {
 		return nil, m.err
 	}
-	contextStackNameRegexp := regexp.MustCompile(awsresources.RenderContextStackNameRegexp(m.projectSpec.Name, m.userId))
+	var instances []Instance
+	for _, targetProfile := range m.targetProfiles() {
+		m.useTarget(targetProfile)
+		userContexts, err := m.getContextsOwnedBy(targetProfile, m.userId, false)
+		if err != nil {
+			return nil, err
+		}
+		sharedContexts, err := m.getContextsOwnedBy(targetProfile, awsresources.SharedContextOwnerId, true)
+		if err != nil {
+			return nil, err
+		}
+		instances = append(instances, userContexts...)
+		instances = append(instances, sharedContexts...)
+	}
+	return instances, nil
+}
+
+// targetProfiles returns the distinct profile keys of the regions and accounts that contexts of the project can be
+// deployed to, starting with the one of the current profile
+func (m *Manager) targetProfiles() []string {
+	targetProfiles := []string{m.profile}
+	seen := map[string]bool{m.profile: true}
+	contextNames := make([]string, 0, len(m.projectSpec.Contexts))
+	for contextName := range m.projectSpec.Contexts {
+		contextNames = append(contextNames, contextName)
+	}
+	sort.Strings(contextNames)
+	for _, contextName := range contextNames {
+		targetProfile := m.contextTargetProfile(m.projectSpec.Contexts[contextName])
+		if !seen[targetProfile] {
+			seen[targetProfile] = true
+			targetProfiles = append(targetProfiles, targetProfile)
+		}
+	}
+	return targetProfiles
+}
+
+func (m *Manager) getContextsOwnedBy(targetProfile, ownerId string, isShared bool) ([]Instance, error) {
+	contextStackNameRegexp := regexp.MustCompile(awsresources.RenderContextStackNameRegexp(m.projectSpec.Name, ownerId))
 	stacks, err := m.Cfn.ListStacks(contextStackNameRegexp, cfn.ActiveStacksFilter)
 	if err != nil {
 		return nil, err
 	}
 
-	var allContextStatusList []Instance
+	var contextStatusList []Instance
 
 	for _, stack := range stacks {
 		contextName := contextStackNameRegexp.FindStringSubmatch(stack.Name)[1]
-		_, isDefinedInProjectFile := m.contexts[contextName]
+		localContext, isDefinedInProjectFile := m.contexts[contextName]
+		if isDefinedInProjectFile {
+			isDefinedInProjectFile = localContext.IsShared == isShared &&
+				m.contextTargetProfile(m.projectSpec.Contexts[contextName]) == targetProfile
+		}
 
-		allContextStatusList = append(allContextStatusList, Instance{
+		contextStatusList = append(contextStatusList, Instance{
 			ContextName:            contextName,
 			ContextStatus:          mapStackToStatus(stack.Status),
 			ContextReason:          stack.StatusReason,
 			IsDefinedInProjectFile: isDefinedInProjectFile,
+			IsShared:               isShared,
+			Region:                 m.region,
 		})
 	}
 
-	return allContextStatusList, nil
+	return contextStatusList, nil
 }
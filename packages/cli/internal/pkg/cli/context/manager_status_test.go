@@ -37,6 +37,13 @@ func TestManager_Status(t *testing.T) {
 					ContextStatus:          "STOPPED",
 					ContextReason:          "some-reason",
 					IsDefinedInProjectFile: false,
+				},
+				{
+					ContextName:            "teamContext",
+					ContextStatus:          "STARTED",
+					ContextReason:          "shared reason",
+					IsDefinedInProjectFile: false,
+					IsShared:               true,
 				}},
 			setupMocks: func(t *testing.T) mockClients {
 				mockClients := createMocks(t)
@@ -58,6 +65,13 @@ func TestManager_Status(t *testing.T) {
 						Name:         "Agc-Context-testProjectName-bender123-testContextName45",
 						StatusReason: "some-reason",
 					}}, nil)
+				sharedStackNamePattern := awsresources.RenderContextStackNameRegexp(testProjectName, awsresources.SharedContextOwnerId)
+				mockClients.cfnMock.EXPECT().ListStacks(regexp.MustCompile(sharedStackNamePattern), cfn.ActiveStacksFilter).
+					Return([]cfn.Stack{{
+						Status:       types.StackStatusUpdateComplete,
+						Name:         "Agc-Context-testProjectName-shared-teamContext",
+						StatusReason: "shared reason",
+					}}, nil)
 				return mockClients
 			},
 		},
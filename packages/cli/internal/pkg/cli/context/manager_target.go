@@ -0,0 +1,72 @@
+package context
+
+import (
+	"github.com/aws/amazon-genomics-cli/internal/pkg/aws"
+	"github.com/aws/amazon-genomics-cli/internal/pkg/aws/cdk"
+	"github.com/aws/amazon-genomics-cli/internal/pkg/aws/cfn"
+	"github.com/aws/amazon-genomics-cli/internal/pkg/aws/ddb"
+	"github.com/aws/amazon-genomics-cli/internal/pkg/aws/ecr"
+	"github.com/aws/amazon-genomics-cli/internal/pkg/aws/ssm"
+	"github.com/aws/amazon-genomics-cli/internal/pkg/cli/spec"
+)
+
+// targetClients are the clients of the region and account that a context is deployed to
+type targetClients struct {
+	cdk    cdk.Interface
+	cfn    cfn.Interface
+	ddb    ddb.Interface
+	ssm    ssm.Interface
+	ecr    ecr.Interface
+	region string
+}
+
+func newTargetClients(profile string) targetClients {
+	return targetClients{
+		cdk:    aws.CdkClient(profile),
+		cfn:    aws.CfnClient(profile),
+		ddb:    aws.DdbClient(profile),
+		ssm:    aws.SsmClient(profile),
+		ecr:    aws.EcrClient(profile),
+		region: aws.Region(profile),
+	}
+}
+
+// contextTargetProfile returns the profile key of the clients for the region and account of a context
+func (m *Manager) contextTargetProfile(contextSpec spec.Context) string {
+	profile := m.profile
+	if contextSpec.AwsProfile != "" {
+		profile = contextSpec.AwsProfile
+	}
+	return aws.TargetProfile(profile, contextSpec.Region, contextSpec.RoleArn)
+}
+
+// useContextTarget switches the clients of the manager to the region and account of a context of the project
+func (m *Manager) useContextTarget(contextName string) {
+	if m.err != nil {
+		return
+	}
+	contextSpec, ok := m.projectSpec.Contexts[contextName]
+	if !ok {
+		return
+	}
+	m.useTarget(m.contextTargetProfile(contextSpec))
+}
+
+func (m *Manager) useTarget(targetProfile string) {
+	if m.targetClients == nil {
+		return
+	}
+	clients := m.targetClients(targetProfile)
+	m.Cdk, m.Cfn, m.Ddb, m.Ssm, m.ecrClient, m.region = clients.cdk, clients.cfn, clients.ddb, clients.ssm, clients.ecr, clients.region
+}
+
+// contextRegion returns the region a context of the project is deployed to
+func (m *Manager) contextRegion(contextSpec spec.Context) string {
+	if contextSpec.Region != "" {
+		return contextSpec.Region
+	}
+	if contextSpec.AwsProfile != "" && m.targetClients != nil {
+		return m.targetClients(contextSpec.AwsProfile).region
+	}
+	return m.defaultRegion
+}
@@ -0,0 +1,120 @@
+package context
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/aws/amazon-genomics-cli/internal/pkg/aws"
+	"github.com/aws/amazon-genomics-cli/internal/pkg/aws/cfn"
+	"github.com/aws/amazon-genomics-cli/internal/pkg/cli/awsresources"
+	"github.com/aws/amazon-genomics-cli/internal/pkg/cli/spec"
+	awsmocks "github.com/aws/amazon-genomics-cli/internal/pkg/mocks/aws"
+	"github.com/aws/aws-sdk-go-v2/service/cloudformation/types"
+	"github.com/stretchr/testify/assert"
+)
+
+const (
+	testProfile       = "test-profile"
+	testDefaultRegion = "us-east-1"
+	testContextRegion = "eu-west-1"
+)
+
+var testRegionalProjectSpec = spec.Project{
+	Name: testProjectName,
+	Contexts: map[string]spec.Context{
+		testContextName1: {Engines: []spec.Engine{{Type: "wdl", Engine: "cromwell"}}},
+		testContextName2: {Engines: []spec.Engine{{Type: "nextflow", Engine: "nextflow"}}, Region: testContextRegion},
+	},
+}
+
+func TestManager_StatusList_ContextRegions(t *testing.T) {
+	mockClients := createMocks(t)
+	defer mockClients.ctrl.Finish()
+	regionalCfnMock := awsmocks.NewMockCfnClient(mockClients.ctrl)
+	regionalProfile := aws.TargetProfile(testProfile, testContextRegion, "")
+
+	mockClients.configMock.EXPECT().GetUserEmailAddress().Return(testUserEmail, nil)
+	mockClients.configMock.EXPECT().GetUserId().Return(testUserId, nil)
+	mockClients.projMock.EXPECT().Read().Return(testRegionalProjectSpec, nil)
+	userPattern := regexp.MustCompile(awsresources.RenderContextStackNameRegexp(testProjectName, testUserId))
+	sharedPattern := regexp.MustCompile(awsresources.RenderContextStackNameRegexp(testProjectName, awsresources.SharedContextOwnerId))
+	mockClients.cfnMock.EXPECT().ListStacks(userPattern, cfn.ActiveStacksFilter).Return([]cfn.Stack{
+		{Name: "Agc-Context-testProjectName-bender123-testContextName1", Status: types.StackStatusCreateComplete},
+		{Name: "Agc-Context-testProjectName-bender123-testContextName2", Status: types.StackStatusCreateComplete},
+	}, nil)
+	mockClients.cfnMock.EXPECT().ListStacks(sharedPattern, cfn.ActiveStacksFilter).Return(nil, nil)
+	regionalCfnMock.EXPECT().ListStacks(userPattern, cfn.ActiveStacksFilter).Return([]cfn.Stack{
+		{Name: "Agc-Context-testProjectName-bender123-testContextName2", Status: types.StackStatusCreateComplete},
+	}, nil)
+	regionalCfnMock.EXPECT().ListStacks(sharedPattern, cfn.ActiveStacksFilter).Return(nil, nil)
+
+	manager := Manager{
+		Project:       mockClients.projMock,
+		Config:        mockClients.configMock,
+		profile:       testProfile,
+		defaultRegion: testDefaultRegion,
+		targetClients: func(profile string) targetClients {
+			if profile == regionalProfile {
+				return targetClients{cfn: regionalCfnMock, region: testContextRegion}
+			}
+			return targetClients{cfn: mockClients.cfnMock, region: testDefaultRegion}
+		},
+	}
+
+	instances, err := manager.StatusList()
+	assert.NoError(t, err)
+	assert.Equal(t, []Instance{
+		{ContextName: testContextName1, ContextStatus: StatusStarted, IsDefinedInProjectFile: true, Region: testDefaultRegion},
+		{ContextName: testContextName2, ContextStatus: StatusStarted, IsDefinedInProjectFile: false, Region: testDefaultRegion},
+		{ContextName: testContextName2, ContextStatus: StatusStarted, IsDefinedInProjectFile: true, Region: testContextRegion},
+	}, instances)
+}
+
+func TestManager_List_ContextRegions(t *testing.T) {
+	mockClients := createMocks(t)
+	defer mockClients.ctrl.Finish()
+	mockClients.configMock.EXPECT().GetUserEmailAddress().Return(testUserEmail, nil)
+	mockClients.configMock.EXPECT().GetUserId().Return(testUserId, nil)
+	mockClients.projMock.EXPECT().Read().Return(testRegionalProjectSpec, nil)
+
+	manager := Manager{
+		Project:       mockClients.projMock,
+		Config:        mockClients.configMock,
+		defaultRegion: testDefaultRegion,
+	}
+
+	contexts, err := manager.List()
+	assert.NoError(t, err)
+	assert.Equal(t, testDefaultRegion, contexts[testContextName1].Region)
+	assert.Equal(t, testContextRegion, contexts[testContextName2].Region)
+}
+
+func TestManager_UseContextTarget(t *testing.T) {
+	mockClients := createMocks(t)
+	defer mockClients.ctrl.Finish()
+	regionalCdkMock := awsmocks.NewMockCdkClient(mockClients.ctrl)
+	var requestedProfiles []string
+
+	manager := Manager{
+		Cdk:     mockClients.cdkMock,
+		profile: testProfile,
+		region:  testDefaultRegion,
+		targetClients: func(profile string) targetClients {
+			requestedProfiles = append(requestedProfiles, profile)
+			if profile == testProfile {
+				return targetClients{cdk: mockClients.cdkMock, region: testDefaultRegion}
+			}
+			return targetClients{cdk: regionalCdkMock, region: testContextRegion}
+		},
+	}
+	manager.projectSpec = testRegionalProjectSpec
+
+	manager.useContextTarget(testContextName2)
+	assert.Equal(t, regionalCdkMock, manager.Cdk)
+	assert.Equal(t, testContextRegion, manager.region)
+
+	manager.useContextTarget(testContextName1)
+	assert.Equal(t, mockClients.cdkMock, manager.Cdk)
+	assert.Equal(t, testDefaultRegion, manager.region)
+	assert.Equal(t, []string{aws.TargetProfile(testProfile, testContextRegion, ""), testProfile}, requestedProfiles)
+}
@@ -2,9 +2,14 @@ package context
 
 import (
 	"errors"
+	"fmt"
+	"strings"
 	"testing"
 
 	"github.com/aws/amazon-genomics-cli/internal/pkg/aws/cdk"
+	"github.com/aws/amazon-genomics-cli/internal/pkg/cli/awsresources"
+	"github.com/aws/amazon-genomics-cli/internal/pkg/cli/clierror/actionableerror"
+	"github.com/aws/amazon-genomics-cli/internal/pkg/cli/spec"
 	"github.com/aws/amazon-genomics-cli/internal/pkg/logging"
 	"github.com/stretchr/testify/assert"
 )
@@ -97,3 +102,106 @@ func TestManager_ProcessExecutionSilent(t *testing.T) {
 	}
 	assert.ElementsMatch(t, expectedProgressResults, manager.progressResults)
 }
+
+func TestManager_SetTaskEnvironment(t *testing.T) {
+	manager := Manager{
+		baseProps: baseProps{
+			contextSpec: spec.Context{
+				Environment: map[string]string{"NXF_OPTS": "-Xms1g"},
+				Secrets: []spec.Secret{
+					{Name: "LICENSE_SERVER", ValueFrom: "/license/server"},
+					{Name: "API_TOKEN", ValueFrom: "api-token", Source: spec.SecretSourceSecretsManager},
+				},
+			},
+		},
+	}
+
+	manager.setTaskEnvironment()
+
+	assert.NoError(t, manager.err)
+	assert.Equal(t, `{"NXF_OPTS":"-Xms1g"}`, manager.taskEnvJson)
+	assert.Equal(t, `[{"name":"LICENSE_SERVER","valueFrom":"/license/server","source":"parameterStore"},{"name":"API_TOKEN","valueFrom":"api-token","source":"secretsManager"}]`, manager.taskSecretsJson)
+}
+
+func TestManager_SetTaskEnvironment_Empty(t *testing.T) {
+	manager := Manager{}
+
+	manager.setTaskEnvironment()
+
+	assert.NoError(t, manager.err)
+	assert.Empty(t, manager.taskEnvJson)
+	assert.Empty(t, manager.taskSecretsJson)
+}
+
+func TestManager_SetContextEnv_AllInstanceTypesExcluded(t *testing.T) {
+	manager := Manager{
+		baseProps: baseProps{
+			projectSpec: testValidProjectSpec,
+			contextSpec: spec.Context{
+				InstanceTypes:       []string{"p3.2xlarge", "g4dn"},
+				ExcludeGpuInstances: true,
+				Engines:             []spec.Engine{{Type: "wdl", Engine: "cromwell"}},
+			},
+		},
+	}
+
+	manager.setContextEnv(testContextName1)
+
+	assert.EqualError(t, manager.err, actionableerror.New(
+		fmt.Errorf("all instance types of context '%s' belong to excluded instance families", testContextName1),
+		"Please add instance types to the context that are not excluded by 'excludeInstanceFamilies' or 'excludeGpuInstances'",
+	).Error())
+}
+
+func TestManager_SetContextEnv_ComputeTuning(t *testing.T) {
+	manager := Manager{
+		baseProps: baseProps{
+			projectSpec: testValidProjectSpec,
+			contextSpec: spec.Context{
+				InstanceTypes:        []string{"c5", "p3.2xlarge"},
+				ExcludeGpuInstances:  true,
+				RequestSpotInstances: true,
+				SpotBidPercentage:    70,
+				AllocationStrategy:   "SPOT_CAPACITY_OPTIMIZED",
+				MinVCpus:             2,
+				MaxVCpus:             64,
+				RootVolume:           spec.Volume{Size: 50, VolumeType: "gp3"},
+				ScratchVolume:        spec.Volume{Size: 500, VolumeType: "io2"},
+				AmiId:                "ami-0123456789abcdef0",
+			},
+		},
+	}
+
+	manager.setContextEnv(testContextName1)
+
+	assert.NoError(t, manager.err)
+	envList := manager.contextEnv.ToEnvironmentList()
+	assert.Contains(t, envList, "BATCH_COMPUTE_INSTANCE_TYPES=c5")
+	assert.Contains(t, envList, "MIN_V_CPUS=2")
+	assert.Contains(t, envList, "SPOT_BID_PERCENTAGE=70")
+	assert.Contains(t, envList, "ALLOCATION_STRATEGY=SPOT_CAPACITY_OPTIMIZED")
+	assert.Contains(t, envList, "ROOT_VOLUME_SIZE=50")
+	assert.Contains(t, envList, "ROOT_VOLUME_TYPE=gp3")
+	assert.Contains(t, envList, "SCRATCH_VOLUME_SIZE=500")
+	assert.Contains(t, envList, "SCRATCH_VOLUME_TYPE=io2")
+	assert.Contains(t, envList, "CONTEXT_AMI=ami-0123456789abcdef0")
+	assert.Contains(t, envList, "EXCLUDED_INSTANCE_FAMILIES="+strings.Join(spec.GpuInstanceFamilies, ","))
+}
+
+func TestManager_SetContextEnv_SharedContext(t *testing.T) {
+	projectSpec := spec.Project{
+		Name: testProjectName,
+		Contexts: map[string]spec.Context{
+			testContextName1: {
+				Shared:  true,
+				Engines: []spec.Engine{{Type: "wdl", Engine: "cromwell"}},
+			},
+		},
+	}
+	manager := Manager{baseProps: baseProps{projectSpec: projectSpec, userId: testUserId}}
+
+	manager.setContextEnv(testContextName1)
+
+	assert.NoError(t, manager.err)
+	assert.Contains(t, manager.contextEnv.ToEnvironmentList(), "USER_ID="+awsresources.SharedContextOwnerId)
+}
@@ -0,0 +1,110 @@
+package context
+
+import (
+	ctx "context"
+	"fmt"
+	"path/filepath"
+
+	"github.com/aws/amazon-genomics-cli/internal/pkg/aws/cdk"
+	"github.com/aws/amazon-genomics-cli/internal/pkg/aws/ddb"
+	"github.com/aws/amazon-genomics-cli/internal/pkg/aws/ecr"
+	"github.com/aws/amazon-genomics-cli/internal/pkg/cli/clierror/actionableerror"
+	"github.com/aws/amazon-genomics-cli/internal/pkg/version"
+)
+
+// Upgrade redeploys contexts of a project owned by ownerId with the infrastructure and engine images of the current
+// version of AGC. The configuration recorded for the latest deployment of each context is reused, so the project does
+// not need to be available locally.
+func (m *Manager) Upgrade(projectName, ownerId string, contexts []string) []ProgressResult {
+	m.readConfig()
+	m.clearCdkContext(contextDir)
+	if m.err != nil {
+		for _, contextName := range contexts {
+			m.progressResults = append(m.progressResults, ProgressResult{Context: contextName, Err: m.err})
+		}
+		return m.progressResults
+	}
+
+	var progressStreams []cdk.ProgressStream
+	for _, contextName := range contexts {
+		progressStream := m.upgradeContext(projectName, ownerId, contextName)
+		if progressStream != nil {
+			progressStreams = append(progressStreams, progressStream)
+		}
+		m.err = nil
+	}
+
+	description := fmt.Sprintf("Upgrading context(s) %s of project '%s'", contexts, projectName)
+	m.processExecution(progressStreams, description)
+	m.recordDeployments()
+	return m.progressResults
+}
+
+func (m *Manager) upgradeContext(projectName, ownerId, contextName string) cdk.ProgressStream {
+	latest, err := m.latestDeploymentRecord(projectName, ownerId, contextName)
+	if err != nil {
+		m.progressResults = append(m.progressResults, ProgressResult{Context: contextName, Err: err})
+		return nil
+	}
+
+	imageRefs := m.currentImageRefs(latest.ImageRefs)
+	for _, imageRef := range imageRefs {
+		if err := m.ecrClient.VerifyImageExists(imageRef); err != nil {
+			m.progressResults = append(m.progressResults, ProgressResult{Context: contextName, Err: err})
+			return nil
+		}
+	}
+
+	environment := make(map[string]string, len(latest.Environment))
+	for key, value := range latest.Environment {
+		environment[key] = value
+	}
+	environment[agcVersionEnvKey] = version.Version
+
+	deploymentVars := append(environmentMapToList(environment), imageEnvironmentVars(imageRefs)...)
+	progressStream, err := m.Cdk.DeployApp(filepath.Join(m.homeDir, cdkAppsDirBase, contextDir), deploymentVars, contextName)
+	if err != nil {
+		m.progressResults = append(m.progressResults, ProgressResult{Context: contextName, Err: err})
+		return progressStream
+	}
+
+	m.addPendingDeployment(contextName, ddb.ContextDeployment{
+		ProjectName: projectName,
+		ContextName: contextName,
+		OwnerId:     ownerId,
+		UserId:      m.userId,
+		UserEmail:   m.userEmail,
+		AgcVersion:  version.Version,
+		Environment: environment,
+		ImageRefs:   imageRefs,
+	})
+	return progressStream
+}
+
+func (m *Manager) latestDeploymentRecord(projectName, ownerId, contextName string) (ddb.ContextDeployment, error) {
+	records, err := m.Ddb.ListContextDeployments(ctx.Background(), projectName, ownerId, contextName)
+	if err != nil {
+		return ddb.ContextDeployment{}, err
+	}
+	if len(records) == 0 {
+		return ddb.ContextDeployment{}, actionableerror.New(
+			fmt.Errorf("no deployment of context '%s' of project '%s' has been recorded", contextName, projectName),
+			fmt.Sprintf("Run 'agc context deploy %s' from the directory of project '%s' instead", contextName, projectName),
+		)
+	}
+	return records[0], nil
+}
+
+// currentImageRefs returns the images of the current version of AGC for the components of a recorded deployment
+func (m *Manager) currentImageRefs(recordedImageRefs map[string]ecr.ImageReference) map[string]ecr.ImageReference {
+	imageRefs := make(map[string]ecr.ImageReference, len(recordedImageRefs))
+	for component := range recordedImageRefs {
+		imageRefs[component] = ecr.ImageReference{
+			RegistryId:     m.imageRefs[component].RegistryId,
+			Region:         m.region,
+			RepositoryName: m.imageRefs[component].RepositoryName,
+			ImageTag:       m.imageRefs[component].ImageTag,
+		}
+	}
+	return imageRefs
+}
@@ -0,0 +1,117 @@
+package context
+
+import (
+	ctx "context"
+	"fmt"
+	"path/filepath"
+	"testing"
+
+	"github.com/aws/amazon-genomics-cli/internal/pkg/aws/cdk"
+	"github.com/aws/amazon-genomics-cli/internal/pkg/aws/ddb"
+	"github.com/aws/amazon-genomics-cli/internal/pkg/aws/ecr"
+	"github.com/aws/amazon-genomics-cli/internal/pkg/cli/awsresources"
+	"github.com/aws/amazon-genomics-cli/internal/pkg/logging"
+	"github.com/aws/amazon-genomics-cli/internal/pkg/version"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const testUpgradeRegion = "us-west-2"
+
+var (
+	testCurrentCromwellImage = ecr.ImageReference{RegistryId: "111122223333", Region: testUpgradeRegion, RepositoryName: "aws/cromwell-mirror", ImageTag: "64"}
+	testCurrentWesImage      = ecr.ImageReference{RegistryId: "111122223333", Region: testUpgradeRegion, RepositoryName: "aws/wes-release", ImageTag: "0.1.0"}
+	testCurrentImageRefs     = map[string]ecr.ImageReference{
+		"cromwell": {RegistryId: "111122223333", Region: "us-east-1", RepositoryName: "aws/cromwell-mirror", ImageTag: "64"},
+		"wes":      {RegistryId: "111122223333", Region: "us-east-1", RepositoryName: "aws/wes-release", ImageTag: "0.1.0"},
+		"nextflow": {RegistryId: "111122223333", Region: "us-east-1", RepositoryName: "aws/nextflow-mirror", ImageTag: "22.04.3"},
+	}
+)
+
+func TestManager_Upgrade(t *testing.T) {
+	origVerbose := logging.Verbose
+	origDisplayProgressBar := displayProgressBar
+	defer func() {
+		logging.Verbose = origVerbose
+		displayProgressBar = origDisplayProgressBar
+	}()
+	logging.Verbose = false
+	mockClients := createMocks(t)
+	defer mockClients.ctrl.Finish()
+	defer close(mockClients.progressStream1)
+	mockClients.configMock.EXPECT().GetUserEmailAddress().Return(testUserEmail, nil)
+	mockClients.configMock.EXPECT().GetUserId().Return(testUserId, nil)
+	appDir := filepath.Join(testHomeDir, ".agc/cdk/apps/context")
+	clearContext := mockClients.cdkMock.EXPECT().ClearContext(appDir).Return(nil)
+	mockClients.ddbMock.EXPECT().ListContextDeployments(ctx.Background(), testProjectName, testUserId, testContextName1).
+		Return([]ddb.ContextDeployment{testDeployment}, nil)
+	mockClients.ecrClientMock.EXPECT().VerifyImageExists(testCurrentCromwellImage).Return(nil)
+	mockClients.ecrClientMock.EXPECT().VerifyImageExists(testCurrentWesImage).Return(nil)
+	mockClients.cdkMock.EXPECT().DeployApp(appDir, gomock.Any(), testContextName1).After(clearContext).
+		DoAndReturn(func(_ string, vars []string, _ string) (cdk.ProgressStream, error) {
+			assert.Contains(t, vars, "AGC_VERSION="+version.Version)
+			assert.Contains(t, vars, "CONTEXT="+testContextName1)
+			assert.Contains(t, vars, "ECR_CROMWELL_TAG=64")
+			assert.Contains(t, vars, "ECR_WES_REGION="+testUpgradeRegion)
+			assert.NotContains(t, vars, "ECR_NEXTFLOW_TAG=22.04.3")
+			return mockClients.progressStream1, nil
+		})
+	displayProgressBar = mockClients.cdkMock.DisplayProgressBar
+	mockClients.cdkMock.EXPECT().DisplayProgressBar(fmt.Sprintf("Upgrading context(s) [%s] of project '%s'", testContextName1, testProjectName), []cdk.ProgressStream{mockClients.progressStream1}).
+		Return([]cdk.Result{{ExecutionName: testContextName1}})
+	mockClients.ddbMock.EXPECT().WriteContextDeployment(ctx.Background(), gomock.Any()).
+		DoAndReturn(func(_ ctx.Context, deployment ddb.ContextDeployment) error {
+			assert.Equal(t, testProjectName, deployment.ProjectName)
+			assert.Equal(t, testUserId, deployment.OwnerId)
+			assert.Equal(t, version.Version, deployment.AgcVersion)
+			assert.Equal(t, map[string]ecr.ImageReference{"cromwell": testCurrentCromwellImage, "wes": testCurrentWesImage}, deployment.ImageRefs)
+			return nil
+		})
+	manager := Manager{
+		Cdk:       mockClients.cdkMock,
+		Config:    mockClients.configMock,
+		Ddb:       mockClients.ddbMock,
+		ecrClient: mockClients.ecrClientMock,
+		imageRefs: testCurrentImageRefs,
+		region:    testUpgradeRegion,
+		baseProps: baseProps{homeDir: testHomeDir},
+	}
+
+	results := manager.Upgrade(testProjectName, testUserId, []string{testContextName1})
+
+	assert.Equal(t, []ProgressResult{{Context: testContextName1}}, results)
+}
+
+func TestManager_Upgrade_NoDeploymentRecord(t *testing.T) {
+	mockClients := createMocks(t)
+	defer mockClients.ctrl.Finish()
+	mockClients.configMock.EXPECT().GetUserEmailAddress().Return(testUserEmail, nil)
+	mockClients.configMock.EXPECT().GetUserId().Return(testUserId, nil)
+	mockClients.cdkMock.EXPECT().ClearContext(gomock.Any()).Return(nil)
+	mockClients.ddbMock.EXPECT().ListContextDeployments(ctx.Background(), testProjectName, awsresources.SharedContextOwnerId, testContextName1).Return(nil, nil)
+	manager := Manager{
+		Cdk:       mockClients.cdkMock,
+		Config:    mockClients.configMock,
+		Ddb:       mockClients.ddbMock,
+		baseProps: baseProps{homeDir: testHomeDir},
+	}
+
+	results := manager.Upgrade(testProjectName, awsresources.SharedContextOwnerId, []string{testContextName1})
+
+	require.Len(t, results, 1)
+	require.Error(t, results[0].Err)
+	assert.Contains(t, results[0].Err.Error(), fmt.Sprintf("no deployment of context '%s' of project '%s' has been recorded", testContextName1, testProjectName))
+}
+
+func TestManager_Upgrade_ConfigError(t *testing.T) {
+	mockClients := createMocks(t)
+	defer mockClients.ctrl.Finish()
+	expectedErr := fmt.Errorf("some config error")
+	mockClients.configMock.EXPECT().GetUserId().Return("", expectedErr)
+	manager := Manager{Config: mockClients.configMock}
+
+	results := manager.Upgrade(testProjectName, testUserId, []string{testContextName1, testContextName2})
+
+	assert.Equal(t, []ProgressResult{{Context: testContextName1, Err: expectedErr}, {Context: testContextName2, Err: expectedErr}}, results)
+}
@@ -9,6 +9,7 @@ import (
 	"github.com/aws/amazon-genomics-cli/internal/pkg/cli/clierror"
 	"github.com/aws/amazon-genomics-cli/internal/pkg/cli/context"
 	"github.com/aws/amazon-genomics-cli/internal/pkg/cli/format"
+	"github.com/aws/amazon-genomics-cli/internal/pkg/cli/spec"
 	"github.com/aws/amazon-genomics-cli/internal/pkg/cli/types"
 	"github.com/rs/zerolog/log"
 	"github.com/spf13/cobra"
@@ -53,6 +54,7 @@ func (o *describeContextOpts) Execute() (types.Context, error) {
 	}
 	return types.Context{
 		Name:                 ctxName,
+		Region:               info.Region,
 		Status:               info.Status.ToString(),
 		StatusReason:         info.StatusReason,
 		InstanceTypes:        buildInstanceTypes(info.InstanceTypes),
@@ -60,9 +62,34 @@ func (o *describeContextOpts) Execute() (types.Context, error) {
 		RequestSpotInstances: info.IsSpot,
 		Output:               types.OutputLocation{Url: info.BucketLocation},
 		WesEndpoint:          types.WesEndpoint{Url: info.WesUrl},
+		Tags:                 buildContextTags(info.Tags),
+		Data:                 buildContextData(info.Data),
 	}, nil
 }
 
+func buildContextData(dataAccess []context.DataAccess) []types.ContextData {
+	var contextData []types.ContextData
+	for _, access := range dataAccess {
+		contextData = append(contextData, types.ContextData{
+			Location:      access.Location,
+			ReadOnly:      access.ReadOnly,
+			RequesterPays: access.RequesterPays,
+			KmsKeyArn:     access.KmsKeyArn,
+			BucketOwner:   access.BucketOwner,
+			PolicyHint:    access.PolicyHint,
+		})
+	}
+	return contextData
+}
+
+func buildContextTags(tags []spec.Tag) []types.ContextTag {
+	var contextTags []types.ContextTag
+	for _, tag := range tags {
+		contextTags = append(contextTags, types.ContextTag{Key: tag.Key, Value: tag.Value, Source: tag.Source})
+	}
+	return contextTags
+}
+
 func buildInstanceTypes(stringTypes []string) []types.InstanceType {
 	var instanceTypes []types.InstanceType
 	for _, val := range stringTypes {
@@ -78,6 +105,9 @@ func BuildContextDescribeCommand() *cobra.Command {
 		Use:   "describe context_name",
 		Short: "Show the information for a specific context in the current project",
 		Long: `describe is for showing information about the specified context.
+The data locations of the project are listed with the access of the context to them. For a bucket of another
+account, given by the bucketOwner of the data location, the policy hint describes the statements its owner must add
+to the bucket policy, and to the key policy of encrypted data, for the context to access the data.
 
 ` + DescribeOutput(types.Context{}),
 		Example: `
@@ -8,6 +8,7 @@ import (
 	"testing"
 
 	"github.com/aws/amazon-genomics-cli/internal/pkg/cli/context"
+	"github.com/aws/amazon-genomics-cli/internal/pkg/cli/spec"
 	"github.com/aws/amazon-genomics-cli/internal/pkg/cli/types"
 	contextmocks "github.com/aws/amazon-genomics-cli/internal/pkg/mocks/context"
 	"github.com/golang/mock/gomock"
@@ -26,16 +27,27 @@ func TestDescribeContextOpts_Execute(t *testing.T) {
 			contextName: testContextName1,
 			expected: types.Context{
 				Name:        testContextName1,
+				Region:      "us-east-2",
 				Status:      "STARTED",
 				Output:      types.OutputLocation{Url: "s3://some-bucket/project/TestProject/context/test-context-name-1"},
 				WesEndpoint: types.WesEndpoint{Url: "https://wes.execute-api.us-east-2.amazonaws.com/prod/ga4gh/wes/v1"},
+				Tags:        []types.ContextTag{{Key: "cost-center", Value: "1234", Source: "project"}},
+				Data: []types.ContextData{
+					{Location: "s3://reference-bucket", ReadOnly: true},
+					{Location: "s3://partner-bucket/cohortA", ReadOnly: true, RequesterPays: true, BucketOwner: "210987654321", PolicyHint: "account 210987654321 must allow"},
+				},
 			},
 			setupMocks: func(opts *describeContextOpts) {
 				opts.ctxManager.(*contextmocks.MockContextManager).EXPECT().Info(testContextName1).Return(context.Detail{
-					Summary:        context.Summary{Name: testContextName1},
+					Summary:        context.Summary{Name: testContextName1, Region: "us-east-2"},
 					Status:         context.StatusStarted,
 					BucketLocation: "s3://some-bucket/project/TestProject/context/test-context-name-1",
 					WesUrl:         "https://wes.execute-api.us-east-2.amazonaws.com/prod/ga4gh/wes/v1",
+					Tags:           []spec.Tag{{Key: "cost-center", Value: "1234", Source: "project"}},
+					Data: []context.DataAccess{
+						{Location: "s3://reference-bucket", ReadOnly: true},
+						{Location: "s3://partner-bucket/cohortA", ReadOnly: true, RequesterPays: true, BucketOwner: "210987654321", PolicyHint: "account 210987654321 must allow"},
+					},
 				}, nil)
 			},
 		},
@@ -5,8 +5,10 @@ package cli
 
 import (
 	"fmt"
+	"strings"
 
 	"github.com/aws/amazon-genomics-cli/internal/pkg/cli/clierror"
+	"github.com/aws/amazon-genomics-cli/internal/pkg/cli/clierror/actionableerror"
 	"github.com/aws/amazon-genomics-cli/internal/pkg/cli/context"
 	"github.com/aws/amazon-genomics-cli/internal/pkg/cli/workflow"
 	"github.com/rs/zerolog/log"
@@ -36,6 +38,7 @@ type destroyContextOpts struct {
 	destroyContextVars
 	ctxManagerFactory func() context.Interface
 	wfsManager        func() workflow.Interface
+	ctxSummaries      map[string]context.Summary
 }
 
 func newDestroyContextOpts(vars destroyContextVars) (*destroyContextOpts, error) {
@@ -66,6 +69,11 @@ func (o *destroyContextOpts) Validate(contexts []string) error {
 		if err != nil {
 			return err
 		}
+		if o.ctxSummaries[ctx].IsShared {
+			if err := validateNoRunsOfOtherUsers(wfsManager, ctx, workflows); err != nil {
+				return err
+			}
+		}
 		for _, wf := range workflows {
 			if wf.IsInstanceRunning() {
 				if !o.destroyForce {
@@ -97,11 +105,39 @@ func (o *destroyContextOpts) Execute() error {
 	return nil
 }
 
+// validateNoRunsOfOtherUsers makes sure that destroying a shared context does not interrupt runs submitted by other users.
+// The workflows of the current user are passed in, any other active run in the context's engine belongs to another user.
+func validateNoRunsOfOtherUsers(wfsManager workflow.Interface, contextName string, userWorkflows []workflow.InstanceSummary) error {
+	activeRunIds, err := wfsManager.ListActiveRunIdsByContext(contextName)
+	if err != nil {
+		return err
+	}
+	userRunIds := make(map[string]bool, len(userWorkflows))
+	for _, wf := range userWorkflows {
+		userRunIds[wf.Id] = true
+	}
+	var otherRunIds []string
+	for _, runId := range activeRunIds {
+		if !userRunIds[runId] {
+			otherRunIds = append(otherRunIds, runId)
+		}
+	}
+	if len(otherRunIds) > 0 {
+		return actionableerror.New(
+			fmt.Errorf("shared context '%s' has %d active workflow run(s) submitted by other users: %s",
+				contextName, len(otherRunIds), strings.Join(otherRunIds, ", ")),
+			"Please wait for the runs to finish or ask their owners to stop them before destroying the shared context",
+		)
+	}
+	return nil
+}
+
 func (o *destroyContextOpts) validateContexts() error {
 	ctxList, err := o.ctxManagerFactory().List()
 	if err != nil {
 		return err
 	}
+	o.ctxSummaries = ctxList
 	if o.destroyAll {
 		for contextName := range ctxList {
 			o.contexts = append(o.contexts, contextName)
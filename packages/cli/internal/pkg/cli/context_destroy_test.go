@@ -8,6 +8,7 @@ import (
 	"fmt"
 	"testing"
 
+	"github.com/aws/amazon-genomics-cli/internal/pkg/cli/clierror/actionableerror"
 	"github.com/aws/amazon-genomics-cli/internal/pkg/cli/context"
 	"github.com/aws/amazon-genomics-cli/internal/pkg/cli/workflow"
 	contextmocks "github.com/aws/amazon-genomics-cli/internal/pkg/mocks/context"
@@ -185,6 +186,52 @@ func TestDestroyContextOpts_ValidateForce_ContainsRunningContext(t *testing.T) {
 	assert.NoError(t, opts.Validate([]string{}))
 }
 
+func TestDestroyContextOpts_Validate_SharedContextWithOwnRuns(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	wfMock := workflowmocks.NewMockWorkflowManager(ctrl)
+	ctxMock := contextmocks.NewMockContextManager(ctrl)
+	runId := "testId"
+	runningSummary := []workflow.InstanceSummary{{State: "RUNNING", Id: runId}}
+	ctxMock.EXPECT().List().Return(map[string]context.Summary{testContextName1: {IsShared: true}}, nil)
+	wfMock.EXPECT().StatusWorkflowByContext(testContextName1, workflowMaxAllowedInstance).Return(runningSummary, nil)
+	wfMock.EXPECT().ListActiveRunIdsByContext(testContextName1).Return([]string{runId}, nil)
+	wfMock.EXPECT().StopWorkflowInstance(runId)
+	opts := &destroyContextOpts{
+		destroyContextVars: destroyContextVars{destroyForce: true},
+		wfsManager: func() workflow.Interface {
+			return wfMock
+		},
+		ctxManagerFactory: func() context.Interface {
+			return ctxMock
+		}}
+	assert.NoError(t, opts.Validate([]string{testContextName1}))
+}
+
+func TestDestroyContextOpts_Validate_SharedContextWithRunsOfOtherUsers(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	wfMock := workflowmocks.NewMockWorkflowManager(ctrl)
+	ctxMock := contextmocks.NewMockContextManager(ctrl)
+	ctxMock.EXPECT().List().Return(map[string]context.Summary{testContextName1: {IsShared: true}}, nil)
+	wfMock.EXPECT().StatusWorkflowByContext(testContextName1, workflowMaxAllowedInstance).Return([]workflow.InstanceSummary{}, nil)
+	wfMock.EXPECT().ListActiveRunIdsByContext(testContextName1).Return([]string{"otherRun1", "otherRun2"}, nil)
+	opts := &destroyContextOpts{
+		destroyContextVars: destroyContextVars{destroyForce: true},
+		wfsManager: func() workflow.Interface {
+			return wfMock
+		},
+		ctxManagerFactory: func() context.Interface {
+			return ctxMock
+		}}
+	err := opts.Validate([]string{testContextName1})
+	expectedErr := actionableerror.New(
+		fmt.Errorf("shared context '%s' has 2 active workflow run(s) submitted by other users: otherRun1, otherRun2", testContextName1),
+		"Please wait for the runs to finish or ask their owners to stop them before destroying the shared context",
+	)
+	assert.Equal(t, expectedErr, err)
+}
+
 func TestDestroyContextOpts_GetContexts_DontGetAll(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
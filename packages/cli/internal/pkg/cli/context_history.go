@@ -0,0 +1,81 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"github.com/aws/amazon-genomics-cli/internal/pkg/cli/clierror"
+	"github.com/aws/amazon-genomics-cli/internal/pkg/cli/context"
+	"github.com/aws/amazon-genomics-cli/internal/pkg/cli/format"
+	"github.com/aws/amazon-genomics-cli/internal/pkg/cli/types"
+	"github.com/rs/zerolog/log"
+	"github.com/spf13/cobra"
+)
+
+type contextHistoryVars struct {
+	ContextName string
+}
+
+type contextHistoryOpts struct {
+	contextHistoryVars
+	ctxManager context.Interface
+}
+
+func newContextHistoryOpts(vars contextHistoryVars) (*contextHistoryOpts, error) {
+	return &contextHistoryOpts{
+		contextHistoryVars: vars,
+		ctxManager:         context.NewManager(profile),
+	}, nil
+}
+
+// Execute returns the recorded deployments of the context, most recent first.
+func (o *contextHistoryOpts) Execute() ([]types.ContextDeployment, error) {
+	deployments, err := o.ctxManager.History(o.ContextName)
+	if err != nil {
+		return nil, err
+	}
+	history := make([]types.ContextDeployment, len(deployments))
+	for i, deployment := range deployments {
+		history[i] = types.ContextDeployment{
+			Id:         deployment.Id,
+			DeployedAt: deployment.Time,
+			DeployedBy: deployment.UserEmail,
+			AgcVersion: deployment.AgcVersion,
+			Images:     deployment.Images,
+			RollbackOf: deployment.RollbackOf,
+		}
+	}
+	return history, nil
+}
+
+// BuildContextHistoryCommand builds the command to show the deployment history of a context.
+func BuildContextHistoryCommand() *cobra.Command {
+	vars := contextHistoryVars{}
+	cmd := &cobra.Command{
+		Use:   "history context_name",
+		Short: "Show the deployment history of a context.",
+		Long: `history shows each recorded deployment of a context, most recent first.
+The id of a deployment can be passed to 'agc context rollback'.
+
+` + DescribeOutput([]types.ContextDeployment{}),
+		Example: `
+/code agc context history myCtx`,
+		Args: cobra.ExactArgs(1),
+		RunE: runCmdE(func(cmd *cobra.Command, args []string) error {
+			vars.ContextName = args[0]
+			opts, err := newContextHistoryOpts(vars)
+			if err != nil {
+				return err
+			}
+			log.Info().Msgf("Showing deployment history of context '%s'", opts.ContextName)
+			history, err := opts.Execute()
+			if err != nil {
+				return clierror.New("context history", vars, err)
+			}
+			format.Default.Write(history)
+			return nil
+		}),
+		ValidArgsFunction: NewContextAutoComplete().GetContextAutoComplete(),
+	}
+	return cmd
+}
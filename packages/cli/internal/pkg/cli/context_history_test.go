@@ -0,0 +1,64 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/amazon-genomics-cli/internal/pkg/cli/context"
+	"github.com/aws/amazon-genomics-cli/internal/pkg/cli/types"
+	contextmocks "github.com/aws/amazon-genomics-cli/internal/pkg/mocks/context"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestContextHistoryOpts_Execute(t *testing.T) {
+	testCases := map[string]struct {
+		expected    []types.ContextDeployment
+		expectedErr error
+		setupMocks  func(ctxMock *contextmocks.MockContextManager)
+	}{
+		"history": {
+			expected: []types.ContextDeployment{
+				{Id: "20221020T080000Z", DeployedAt: "2022-10-20T08:00:00Z", DeployedBy: "bender@amazon.com", AgcVersion: "1.5.0", Images: "toil=aws/toil-mirror:5.7.0", RollbackOf: "20221019T113948Z"},
+				{Id: "20221019T113948Z", DeployedAt: "2022-10-19T11:39:48Z", DeployedBy: "bender@amazon.com", AgcVersion: "1.5.0", Images: "toil=aws/toil-mirror:5.7.0"},
+			},
+			setupMocks: func(ctxMock *contextmocks.MockContextManager) {
+				ctxMock.EXPECT().History(testContextName1).Return([]context.Deployment{
+					{Id: "20221020T080000Z", Time: "2022-10-20T08:00:00Z", UserEmail: "bender@amazon.com", AgcVersion: "1.5.0", Images: "toil=aws/toil-mirror:5.7.0", RollbackOf: "20221019T113948Z"},
+					{Id: "20221019T113948Z", Time: "2022-10-19T11:39:48Z", UserEmail: "bender@amazon.com", AgcVersion: "1.5.0", Images: "toil=aws/toil-mirror:5.7.0"},
+				}, nil)
+			},
+		},
+		"history error": {
+			expectedErr: fmt.Errorf("some history error"),
+			setupMocks: func(ctxMock *contextmocks.MockContextManager) {
+				ctxMock.EXPECT().History(testContextName1).Return(nil, fmt.Errorf("some history error"))
+			},
+		},
+	}
+
+	for name, tt := range testCases {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			ctxMock := contextmocks.NewMockContextManager(ctrl)
+			tt.setupMocks(ctxMock)
+			opts := &contextHistoryOpts{
+				contextHistoryVars: contextHistoryVars{ContextName: testContextName1},
+				ctxManager:         ctxMock,
+			}
+
+			history, err := opts.Execute()
+
+			if tt.expectedErr != nil {
+				assert.Equal(t, tt.expectedErr, err)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.expected, history)
+			}
+		})
+	}
+}
@@ -49,6 +49,7 @@ func (o *listContextOpts) Execute() ([]types.ContextSummary, error) {
 		contextNames = append(contextNames, types.ContextSummary{
 			Name:       name,
 			EngineName: contexts[name].Engines[0].Engine,
+			Region:     contexts[name].Region,
 		})
 	}
 
@@ -32,10 +32,10 @@ func TestListContextOpts_Execute(t *testing.T) {
 		"initial context": {
 			setExpectations: func(ctxManager *contextmocks.MockContextManager) {
 				ctxManager.EXPECT().List().Return(map[string]context.Summary{
-					testContextName: {Name: testContextName, Engines: []spec.Engine{{Type: "type", Engine: "engine"}}},
+					testContextName: {Name: testContextName, Engines: []spec.Engine{{Type: "type", Engine: "engine"}}, Region: "eu-west-1"},
 				}, nil)
 			},
-			expected: []types.ContextSummary{{Name: testContextName, EngineName: "engine"}},
+			expected: []types.ContextSummary{{Name: testContextName, EngineName: "engine", Region: "eu-west-1"}},
 		},
 		"list error": {
 			setExpectations: func(ctxManager *contextmocks.MockContextManager) {
@@ -0,0 +1,86 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"fmt"
+
+	"github.com/aws/amazon-genomics-cli/internal/pkg/cli/clierror"
+	"github.com/aws/amazon-genomics-cli/internal/pkg/cli/context"
+	"github.com/rs/zerolog/log"
+	"github.com/spf13/cobra"
+)
+
+const (
+	rollbackToFlag        = "to"
+	rollbackToDescription = "Id of the deployment to roll back to, as shown by 'agc context history'"
+)
+
+type rollbackContextVars struct {
+	ContextName  string
+	DeploymentId string
+}
+
+type rollbackContextOpts struct {
+	rollbackContextVars
+	ctxManager context.Interface
+}
+
+func newRollbackContextOpts(vars rollbackContextVars) (*rollbackContextOpts, error) {
+	return &rollbackContextOpts{
+		rollbackContextVars: vars,
+		ctxManager:          context.NewManager(profile),
+	}, nil
+}
+
+func (o *rollbackContextOpts) Validate() error {
+	if o.DeploymentId == "" {
+		return fmt.Errorf("a deployment to roll back to must be provided with the '--%s' flag", rollbackToFlag)
+	}
+	return nil
+}
+
+// Execute redeploys the context with the configuration and engine images of an earlier deployment.
+func (o *rollbackContextOpts) Execute() error {
+	for _, result := range o.ctxManager.Rollback(o.ContextName, o.DeploymentId) {
+		if result.Err != nil {
+			printErroredLogs(result, true)
+			return result.Err
+		}
+	}
+	log.Info().Msgf("Successfully rolled back context '%s' to deployment '%s'", o.ContextName, o.DeploymentId)
+	return nil
+}
+
+// BuildContextRollbackCommand builds the command to roll back a context to an earlier deployment.
+func BuildContextRollbackCommand() *cobra.Command {
+	vars := rollbackContextVars{}
+	cmd := &cobra.Command{
+		Use:   "rollback context_name --to deployment_id",
+		Short: "Roll back a context to an earlier deployment.",
+		Long: `rollback redeploys a context with the environment and engine images
+recorded for an earlier deployment. Use 'agc context history' to list the deployments of a context.`,
+		Example: `
+/code agc context rollback myCtx --to 20221019T113948.123Z`,
+		Args: cobra.ExactArgs(1),
+		RunE: runCmdE(func(cmd *cobra.Command, args []string) error {
+			vars.ContextName = args[0]
+			opts, err := newRollbackContextOpts(vars)
+			if err != nil {
+				return err
+			}
+			if err := opts.Validate(); err != nil {
+				return err
+			}
+			log.Info().Msgf("Rolling back context '%s'", opts.ContextName)
+			if err := opts.Execute(); err != nil {
+				return clierror.New("context rollback", vars, err)
+			}
+			return nil
+		}),
+		ValidArgsFunction: NewContextAutoComplete().GetContextAutoComplete(),
+	}
+	cmd.Flags().StringVar(&vars.DeploymentId, rollbackToFlag, "", rollbackToDescription)
+	return cmd
+}
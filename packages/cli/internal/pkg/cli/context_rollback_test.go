@@ -0,0 +1,60 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/amazon-genomics-cli/internal/pkg/cli/context"
+	contextmocks "github.com/aws/amazon-genomics-cli/internal/pkg/mocks/context"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+)
+
+const testDeploymentId = "20221019T113948Z"
+
+func TestRollbackContextOpts_Validate(t *testing.T) {
+	opts := &rollbackContextOpts{rollbackContextVars: rollbackContextVars{ContextName: testContextName1}}
+	assert.EqualError(t, opts.Validate(), "a deployment to roll back to must be provided with the '--to' flag")
+
+	opts.DeploymentId = testDeploymentId
+	assert.NoError(t, opts.Validate())
+}
+
+func TestRollbackContextOpts_Execute(t *testing.T) {
+	testCases := map[string]struct {
+		results     []context.ProgressResult
+		expectedErr error
+	}{
+		"rollback success": {
+			results: []context.ProgressResult{{Context: testContextName1, Outputs: []string{"some message"}}},
+		},
+		"rollback failure": {
+			results:     []context.ProgressResult{{Context: testContextName1, Err: fmt.Errorf("some rollback error")}},
+			expectedErr: fmt.Errorf("some rollback error"),
+		},
+	}
+
+	for name, tt := range testCases {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			ctxMock := contextmocks.NewMockContextManager(ctrl)
+			ctxMock.EXPECT().Rollback(testContextName1, testDeploymentId).Return(tt.results)
+			opts := &rollbackContextOpts{
+				rollbackContextVars: rollbackContextVars{ContextName: testContextName1, DeploymentId: testDeploymentId},
+				ctxManager:          ctxMock,
+			}
+
+			err := opts.Execute()
+
+			if tt.expectedErr != nil {
+				assert.Equal(t, tt.expectedErr, err)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
@@ -18,7 +18,7 @@ func TestDescribeOutput(t *testing.T) {
 		},
 		"Workflow": {
 			output:              types.Workflow{},
-			expectedDescription: "Output of the command has following format:\nWORKFLOW: Name Source TypeLanguage TypeVersion\n",
+			expectedDescription: "Output of the command has following format:\nWORKFLOW: Description Name Source TypeLanguage TypeVersion Version\nWORKFLOWPARAMETER: Default Description Name Required Type\n",
 		},
 		"slice of WorkflowName": {
 			output:              []types.WorkflowName{},
@@ -30,8 +30,8 @@ func TestDescribeOutput(t *testing.T) {
 		},
 		"Context": {
 			output: types.Context{},
-			expectedDescription: "Output of the command has following format:\nCONTEXT: MaxVCpus Name RequestSpotInstances Status" +
-				" StatusReason\nINSTANCETYPE: Value\nOUTPUTLOCATION: Url\nWESENDPOINT: Url\n",
+			expectedDescription: "Output of the command has following format:\nCONTEXT: MaxVCpus Name Region RequestSpotInstances Status" +
+				" StatusReason\nCONTEXTDATA: BucketOwner KmsKeyArn Location PolicyHint ReadOnly RequesterPays\nINSTANCETYPE: Value\nOUTPUTLOCATION: Url\nCONTEXTTAG: Key Source Value\nWESENDPOINT: Url\n",
 		},
 	}
 
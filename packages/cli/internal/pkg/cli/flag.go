@@ -34,3 +34,14 @@ const (
 	AWSProfileFlagShort       = "p"
 	AWSProfileFlagDescription = "Use the provided AWS CLI profile."
 )
+
+const (
+	RoleArnFlag                    = "role-arn"
+	RoleArnFlagDescription         = "Assume the provided IAM role with the credentials of the AWS CLI profile."
+	ExternalIdFlag                 = "external-id"
+	ExternalIdFlagDescription      = "External id to use when assuming the role."
+	SessionNameFlag                = "session-name"
+	SessionNameFlagDescription     = "Session name to use when assuming the role."
+	SessionDurationFlag            = "session-duration"
+	SessionDurationFlagDescription = "Duration of the sessions of the assumed role, for example 2h. Defaults to 1h."
+)
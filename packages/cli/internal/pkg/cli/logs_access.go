@@ -4,9 +4,7 @@
 package cli
 
 import (
-	"github.com/aws/amazon-genomics-cli/internal/pkg/aws"
 	"github.com/aws/amazon-genomics-cli/internal/pkg/cli/clierror"
-	"github.com/aws/amazon-genomics-cli/internal/pkg/cli/context"
 	"github.com/rs/zerolog/log"
 	"github.com/spf13/cobra"
 )
@@ -23,10 +21,7 @@ type logsAccessOpts struct {
 func newLogsAccessOpts(vars logsAccessVars) (*logsAccessOpts, error) {
 	return &logsAccessOpts{
 		logsAccessVars: vars,
-		logsSharedOpts: logsSharedOpts{
-			ctxManager: context.NewManager(profile),
-			cwlClient:  aws.CwlClient(profile),
-		},
+		logsSharedOpts: newLogsSharedOpts(),
 	}, nil
 }
 
@@ -43,6 +38,7 @@ func (o *logsAccessOpts) Execute() error {
 	if err != nil {
 		return err
 	}
+	o.useTarget(contextInfo.TargetProfile)
 
 	logGroupName := contextInfo.AccessLogGroupName
 	if o.tail {
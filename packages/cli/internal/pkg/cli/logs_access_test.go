@@ -158,3 +158,31 @@ func TestLogsAccessOpts_Execute_StreamError(t *testing.T) {
 	err := opts.Execute()
 	assert.Equal(t, someErr, err)
 }
+
+func TestLogsAccessOpts_Execute_ContextTarget(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	defaultCwlMock := awsmocks.NewMockCwlClient(ctrl)
+	targetCwlMock := awsmocks.NewMockCwlClient(ctrl)
+	ctxMock := contextmocks.NewMockContextManager(ctrl)
+	logPaginatorMock := awsmocks.NewMockCwlLogPaginator(ctrl)
+	const targetProfile = "default@eu-west-1@"
+	opts := logsAccessOpts{
+		logsSharedOpts: logsSharedOpts{
+			cwlClient:  defaultCwlMock,
+			ctxManager: ctxMock,
+			targetCwlClient: func(profile string) cwl.Interface {
+				assert.Equal(t, targetProfile, profile)
+				return targetCwlMock
+			},
+		},
+		logsAccessVars: logsAccessVars{logsSharedVars{contextName: testContextName1}},
+	}
+
+	ctxMock.EXPECT().Info(testContextName1).Return(context.Detail{AccessLogGroupName: testLogGroupName, TargetProfile: targetProfile}, nil)
+	targetCwlMock.EXPECT().GetLogsPaginated(cwl.GetLogsInput{LogGroupName: testLogGroupName}).Return(logPaginatorMock)
+	logPaginatorMock.EXPECT().HasMoreLogs().Return(false)
+
+	err := opts.Execute()
+	assert.NoError(t, err)
+}
@@ -6,9 +6,7 @@ package cli
 import (
 	"fmt"
 
-	"github.com/aws/amazon-genomics-cli/internal/pkg/aws"
 	"github.com/aws/amazon-genomics-cli/internal/pkg/cli/clierror"
-	"github.com/aws/amazon-genomics-cli/internal/pkg/cli/context"
 	"github.com/aws/amazon-genomics-cli/internal/pkg/environment"
 	"github.com/rs/zerolog/log"
 	"github.com/spf13/cobra"
@@ -26,10 +24,7 @@ type logsAdapterOpts struct {
 func newLogsAdapterOpts(vars logsAdapterVars) (*logsAdapterOpts, error) {
 	return &logsAdapterOpts{
 		logsAdapterVars: vars,
-		logsSharedOpts: logsSharedOpts{
-			ctxManager: context.NewManager(profile),
-			cwlClient:  aws.CwlClient(profile),
-		},
+		logsSharedOpts:  newLogsSharedOpts(),
 	}, nil
 }
 
@@ -61,6 +56,7 @@ func (o *logsAdapterOpts) Execute() error {
 	if err != nil {
 		return err
 	}
+	o.useTarget(contextInfo.TargetProfile)
 
 	logGroupName := contextInfo.WesLogGroupName
 	if o.tail {
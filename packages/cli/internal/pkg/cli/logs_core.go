@@ -8,6 +8,7 @@ import (
 	"time"
 
 	"github.com/araddon/dateparse"
+	"github.com/aws/amazon-genomics-cli/internal/pkg/aws"
 	"github.com/aws/amazon-genomics-cli/internal/pkg/aws/cwl"
 	"github.com/aws/amazon-genomics-cli/internal/pkg/cli/context"
 	"github.com/rs/zerolog/log"
@@ -59,10 +60,27 @@ type logsSharedVars struct {
 }
 
 type logsSharedOpts struct {
-	startTime  *time.Time
-	endTime    *time.Time
-	ctxManager context.Interface
-	cwlClient  cwl.Interface
+	startTime       *time.Time
+	endTime         *time.Time
+	ctxManager      context.Interface
+	cwlClient       cwl.Interface
+	targetCwlClient func(targetProfile string) cwl.Interface
+}
+
+func newLogsSharedOpts() logsSharedOpts {
+	return logsSharedOpts{
+		ctxManager:      context.NewManager(profile),
+		cwlClient:       aws.CwlClient(profile),
+		targetCwlClient: func(targetProfile string) cwl.Interface { return aws.CwlClient(targetProfile) },
+	}
+}
+
+// useTarget switches the CloudWatch Logs client to the region and account that a context is deployed to
+func (o *logsSharedOpts) useTarget(targetProfile string) {
+	if o.targetCwlClient == nil || targetProfile == "" {
+		return
+	}
+	o.cwlClient = o.targetCwlClient(targetProfile)
 }
 
 var now = time.Now
@@ -84,7 +102,9 @@ func (v *logsSharedVars) setFilterFlags(cmd *cobra.Command) {
 
 func (v *logsSharedVars) setContextFlag(cmd *cobra.Command) {
 	cmd.Flags().StringVarP(&v.contextName, contextFlag, contextFlagShort, "", contextFlagDescription)
-	_ = cmd.MarkFlagRequired(contextFlag)
+	cmd.PreRunE = func(cmd *cobra.Command, args []string) error {
+		return useDefaultContext(&v.contextName)
+	}
 	_ = cmd.RegisterFlagCompletionFunc(contextFlag, NewContextAutoComplete().GetContextAutoComplete())
 }
 
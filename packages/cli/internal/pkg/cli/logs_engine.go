@@ -6,9 +6,7 @@ package cli
 import (
 	"fmt"
 
-	"github.com/aws/amazon-genomics-cli/internal/pkg/aws"
 	"github.com/aws/amazon-genomics-cli/internal/pkg/cli/clierror"
-	"github.com/aws/amazon-genomics-cli/internal/pkg/cli/context"
 	"github.com/aws/amazon-genomics-cli/internal/pkg/cli/workflow"
 	"github.com/aws/amazon-genomics-cli/internal/pkg/constants"
 	"github.com/aws/amazon-genomics-cli/internal/pkg/unicode"
@@ -37,11 +35,8 @@ type logsEngineOpts struct {
 
 func newLogsEngineOpts(vars logsEngineVars) (*logsEngineOpts, error) {
 	return &logsEngineOpts{
-		logsEngineVars: vars,
-		logsSharedOpts: logsSharedOpts{
-			ctxManager: context.NewManager(profile),
-			cwlClient:  aws.CwlClient(profile),
-		},
+		logsEngineVars:  vars,
+		logsSharedOpts:  newLogsSharedOpts(),
 		workflowManager: workflow.NewManager(profile),
 	}, nil
 }
@@ -75,6 +70,7 @@ func (o *logsEngineOpts) Execute() error {
 	if err != nil {
 		return err
 	}
+	o.useTarget(contextInfo.TargetProfile)
 
 	logGroupName := contextInfo.EngineLogGroupName
 	log.Debug().Msgf("Engine log group name: '%s'", logGroupName)
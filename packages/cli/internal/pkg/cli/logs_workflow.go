@@ -13,7 +13,6 @@ import (
 	"github.com/aws/amazon-genomics-cli/internal/pkg/aws"
 	"github.com/aws/amazon-genomics-cli/internal/pkg/aws/batch"
 	"github.com/aws/amazon-genomics-cli/internal/pkg/cli/clierror"
-	"github.com/aws/amazon-genomics-cli/internal/pkg/cli/context"
 	"github.com/aws/amazon-genomics-cli/internal/pkg/cli/format"
 	"github.com/aws/amazon-genomics-cli/internal/pkg/cli/workflow"
 	"github.com/rs/zerolog/log"
@@ -51,19 +50,18 @@ type logsWorkflowVars struct {
 type logsWorkflowOpts struct {
 	logsWorkflowVars
 	logsSharedOpts
-	batchClient     batch.Interface
-	workflowManager workflow.TasksManager
+	batchClient       batch.Interface
+	targetBatchClient func(targetProfile string) batch.Interface
+	workflowManager   workflow.TasksManager
 }
 
 func newLogsWorkflowOpts(vars logsWorkflowVars) (*logsWorkflowOpts, error) {
 	return &logsWorkflowOpts{
-		logsWorkflowVars: vars,
-		logsSharedOpts: logsSharedOpts{
-			ctxManager: context.NewManager(profile),
-			cwlClient:  aws.CwlClient(profile),
-		},
-		batchClient:     aws.BatchClient(profile),
-		workflowManager: workflow.NewManager(profile),
+		logsWorkflowVars:  vars,
+		logsSharedOpts:    newLogsSharedOpts(),
+		batchClient:       aws.BatchClient(profile),
+		targetBatchClient: func(targetProfile string) batch.Interface { return aws.BatchClient(targetProfile) },
+		workflowManager:   workflow.NewManager(profile),
 	}, nil
 }
 
@@ -90,6 +88,7 @@ func (o *logsWorkflowOpts) Execute() error {
 	if err != nil {
 		return err
 	}
+	o.useTarget(runLog.TargetProfile)
 
 	var jobIds []string
 	if o.taskId != "" {
@@ -212,6 +211,15 @@ func filterCachedJobIds(ids []string) []string {
 	return result
 }
 
+// useTarget switches the CloudWatch Logs and Batch clients to the region and account that the run took place in
+func (o *logsWorkflowOpts) useTarget(targetProfile string) {
+	o.logsSharedOpts.useTarget(targetProfile)
+	if o.targetBatchClient == nil || targetProfile == "" {
+		return
+	}
+	o.batchClient = o.targetBatchClient(targetProfile)
+}
+
 func (o *logsWorkflowOpts) setRunId() error {
 	if o.runId == "" {
 		instances, err := o.workflowManager.StatusWorkflowByName(o.workflowName, 1)
@@ -41,8 +41,15 @@ and contexts you are working with.`,
 	}
 
 	cmd.AddCommand(BuildProjectInitCommand())
+	cmd.AddCommand(buildProjectTemplatesCommand())
 	cmd.AddCommand(buildProjectDescribeCommand())
 	cmd.AddCommand(buildProjectValidateCommand())
+	cmd.AddCommand(buildProjectCheckDataCommand())
+	cmd.AddCommand(buildProjectMigrateCommand())
+	cmd.AddCommand(buildProjectAddWorkflowCommand())
+	cmd.AddCommand(buildProjectAddContextCommand())
+	cmd.AddCommand(buildProjectAddDataCommand())
+	cmd.AddCommand(buildProjectRemoveCommand())
 
 	cmd.SetUsageTemplate(template.Usage)
 	cmd.Annotations = map[string]string{
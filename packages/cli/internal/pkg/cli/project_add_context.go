@@ -0,0 +1,113 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"fmt"
+
+	"github.com/aws/amazon-genomics-cli/internal/pkg/cli/clierror"
+	"github.com/aws/amazon-genomics-cli/internal/pkg/cli/spec"
+	"github.com/aws/amazon-genomics-cli/internal/pkg/storage"
+	"github.com/rs/zerolog/log"
+	"github.com/spf13/cobra"
+)
+
+const (
+	engineFlag             = "engine"
+	engineFlagDescription  = "The engine that runs the workflows of the context. Defaults to the engine of the workflow type."
+	extendsFlag            = "extends"
+	extendsFlagDescription = "The name of a context whose settings the new context inherits."
+	spotFlag               = "spot"
+	spotFlagDescription    = "Run the workflows of the context on spot instances."
+)
+
+type addContextVars struct {
+	contextName  string
+	workflowType string
+	engine       string
+	extends      string
+	spot         bool
+}
+
+type addContextOpts struct {
+	addContextVars
+	projectClient storage.ProjectClient
+}
+
+func newAddContextOpts(vars addContextVars) (*addContextOpts, error) {
+	projectClient, err := storage.NewProjectClient()
+	if err != nil {
+		return nil, err
+	}
+	return &addContextOpts{
+		addContextVars: vars,
+		projectClient:  projectClient,
+	}, nil
+}
+
+func (o *addContextOpts) Validate() error {
+	if !contextNameRegexp.MatchString(o.contextName) {
+		return fmt.Errorf("context name '%s' must only contain letters and digits", o.contextName)
+	}
+	if o.workflowType == "" {
+		if o.engine != "" {
+			return fmt.Errorf("please specify the workflow type of the engine with the --%s flag", projectInitWorkflowTypeName)
+		}
+		if o.extends == "" {
+			return fmt.Errorf("please specify a workflow type with the --%s flag or a context to inherit from with the --%s flag", projectInitWorkflowTypeName, extendsFlag)
+		}
+		return nil
+	}
+	return validateWorkflowType(o.workflowType)
+}
+
+// Execute adds the context to the project specification
+func (o *addContextOpts) Execute() error {
+	context := spec.Context{Extends: o.extends, RequestSpotInstances: o.spot}
+	if o.workflowType != "" {
+		engine := o.engine
+		if engine == "" {
+			engine = getEngineForWorkflowType(o.workflowType)
+		}
+		context.Engines = []spec.Engine{{Type: o.workflowType, Engine: engine}}
+	}
+	return o.projectClient.Edit(func(editor *spec.Editor) error {
+		return editor.AddContext(o.contextName, context)
+	})
+}
+
+func buildProjectAddContextCommand() *cobra.Command {
+	vars := addContextVars{}
+	cmd := &cobra.Command{
+		Use:   "add-context context_name [--workflow-type {cwl|nextflow|snakemake|wdl}] [--engine engine] [--extends context_name]",
+		Short: "Add a context to the project",
+		Long: `Add a context to the current project specification.
+The context either runs an engine for a workflow type or inherits its engines from the context named by --extends.
+The comments and layout of agc-project.yaml are kept, and the project is validated before it is written.`,
+		Example: `
+/code agc project add-context spotCtx --workflow-type nextflow --spot
+/code agc project add-context bigCtx --extends spotCtx`,
+		Args: cobra.ExactArgs(1),
+		RunE: runCmdE(func(cmd *cobra.Command, args []string) error {
+			vars.contextName = args[0]
+			opts, err := newAddContextOpts(vars)
+			if err != nil {
+				return err
+			}
+			if err := opts.Validate(); err != nil {
+				return err
+			}
+			if err := opts.Execute(); err != nil {
+				return clierror.New("project add-context", vars, err)
+			}
+			log.Info().Msgf("Added context '%s' to the project", vars.contextName)
+			return nil
+		}),
+	}
+	cmd.Flags().StringVarP(&vars.workflowType, projectInitWorkflowTypeName, projectInitWorkflowTypeNameShort, "", getProjectInitWorkflowTypeNameDescription())
+	cmd.Flags().StringVar(&vars.engine, engineFlag, "", engineFlagDescription)
+	cmd.Flags().StringVar(&vars.extends, extendsFlag, "", extendsFlagDescription)
+	cmd.Flags().BoolVar(&vars.spot, spotFlag, false, spotFlagDescription)
+	return cmd
+}
@@ -0,0 +1,93 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"testing"
+
+	storagemocks "github.com/aws/amazon-genomics-cli/internal/pkg/mocks/storage"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAddContextOpts_Validate(t *testing.T) {
+	testCases := map[string]struct {
+		vars        addContextVars
+		expectedErr string
+	}{
+		"workflow type": {
+			vars: addContextVars{contextName: "ctx2", workflowType: "wdl"},
+		},
+		"extends": {
+			vars: addContextVars{contextName: "ctx2", extends: "ctx1"},
+		},
+		"invalid name": {
+			vars:        addContextVars{contextName: "ctx-2", workflowType: "wdl"},
+			expectedErr: "context name 'ctx-2' must only contain letters and digits",
+		},
+		"engine without workflow type": {
+			vars:        addContextVars{contextName: "ctx2", engine: "miniwdl"},
+			expectedErr: "please specify the workflow type of the engine with the --workflow-type flag",
+		},
+		"no engine": {
+			vars:        addContextVars{contextName: "ctx2"},
+			expectedErr: "please specify a workflow type with the --workflow-type flag or a context to inherit from with the --extends flag",
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			opts := &addContextOpts{addContextVars: tc.vars}
+			err := opts.Validate()
+			if tc.expectedErr == "" {
+				assert.NoError(t, err)
+			} else {
+				assert.EqualError(t, err, tc.expectedErr)
+			}
+		})
+	}
+}
+
+func TestAddContextOpts_Execute(t *testing.T) {
+	testCases := map[string]struct {
+		vars     addContextVars
+		expected string
+	}{
+		"default engine": {
+			vars: addContextVars{contextName: "ctx2", workflowType: "nextflow", spot: true},
+			expected: `  ctx2:
+    requestSpotInstances: true
+    engines:
+      - type: nextflow
+        engine: nextflow
+`,
+		},
+		"engine": {
+			vars: addContextVars{contextName: "ctx2", workflowType: "wdl", engine: "miniwdl"},
+			expected: `  ctx2:
+    engines:
+      - type: wdl
+        engine: miniwdl
+`,
+		},
+		"extends": {
+			vars: addContextVars{contextName: "ctx2", extends: "ctx1"},
+			expected: `  ctx2:
+    extends: ctx1
+`,
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			projectClient := storagemocks.NewMockProjectClient(ctrl)
+			edited := expectEdit(t, projectClient, editTestProject)
+			opts := &addContextOpts{addContextVars: tc.vars, projectClient: projectClient}
+
+			require.NoError(t, opts.Execute())
+			assert.Equal(t, editTestProject+tc.expected, *edited)
+		})
+	}
+}
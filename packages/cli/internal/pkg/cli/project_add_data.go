@@ -0,0 +1,110 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"fmt"
+
+	"github.com/aws/amazon-genomics-cli/internal/pkg/aws/s3"
+	"github.com/aws/amazon-genomics-cli/internal/pkg/cli/clierror"
+	"github.com/aws/amazon-genomics-cli/internal/pkg/cli/spec"
+	"github.com/aws/amazon-genomics-cli/internal/pkg/storage"
+	"github.com/rs/zerolog/log"
+	"github.com/spf13/cobra"
+)
+
+const (
+	readOnlyFlag                 = "read-only"
+	readOnlyFlagDescription      = "Grant contexts read access only to the data location."
+	requesterPaysFlag            = "requester-pays"
+	requesterPaysFlagDescription = "The bucket of the data location is a requester pays bucket."
+	kmsKeyArnFlag                = "kms-key-arn"
+	kmsKeyArnFlagDescription     = "The ARN of the KMS key that the data is encrypted with, which contexts are granted the use of."
+	bucketOwnerFlag              = "bucket-owner"
+	bucketOwnerFlagDescription   = "The ID of the account that owns the bucket, when it is not the account of the contexts."
+)
+
+type addDataVars struct {
+	location      string
+	readOnly      bool
+	requesterPays bool
+	kmsKeyArn     string
+	bucketOwner   string
+}
+
+type addDataOpts struct {
+	addDataVars
+	projectClient storage.ProjectClient
+}
+
+func newAddDataOpts(vars addDataVars) (*addDataOpts, error) {
+	projectClient, err := storage.NewProjectClient()
+	if err != nil {
+		return nil, err
+	}
+	return &addDataOpts{
+		addDataVars:   vars,
+		projectClient: projectClient,
+	}, nil
+}
+
+func (o *addDataOpts) Validate() error {
+	if err := s3.ValidateS3Uri(o.location); err != nil {
+		return fmt.Errorf("data location %w", err)
+	}
+	if err := (spec.Data{Location: o.location}).ValidateWildcard(); err != nil {
+		return fmt.Errorf("data location %w", err)
+	}
+	return nil
+}
+
+// Execute adds the data location to the project specification
+func (o *addDataOpts) Execute() error {
+	return o.projectClient.Edit(func(editor *spec.Editor) error {
+		return editor.AddData(spec.Data{
+			Location:      o.location,
+			ReadOnly:      o.readOnly,
+			RequesterPays: o.requesterPays,
+			KmsKeyArn:     o.kmsKeyArn,
+			BucketOwner:   o.bucketOwner,
+		})
+	})
+}
+
+func buildProjectAddDataCommand() *cobra.Command {
+	vars := addDataVars{}
+	cmd := &cobra.Command{
+		Use:   "add-data s3_uri [--read-only] [--requester-pays] [--kms-key-arn key_arn] [--bucket-owner account_id]",
+		Short: "Add a data location to the project",
+		Long: `Add an S3 location that the contexts of the project can access to the current project specification.
+A location with a key prefix only grants access to the objects under the prefix.
+The comments and layout of agc-project.yaml are kept, and the project is validated before it is written.`,
+		Example: `
+/code agc project add-data s3://my-bucket/reference --read-only
+
+Grant read access to a prefix of an encrypted bucket of another account
+/code agc project add-data s3://partner-bucket/cohortA --read-only --bucket-owner 210987654321 --kms-key-arn arn:aws:kms:us-east-1:210987654321:key/1234abcd-12ab-34cd-56ef-1234567890ab`,
+		Args: cobra.ExactArgs(1),
+		RunE: runCmdE(func(cmd *cobra.Command, args []string) error {
+			vars.location = args[0]
+			opts, err := newAddDataOpts(vars)
+			if err != nil {
+				return err
+			}
+			if err := opts.Validate(); err != nil {
+				return err
+			}
+			if err := opts.Execute(); err != nil {
+				return clierror.New("project add-data", vars, err)
+			}
+			log.Info().Msgf("Added data location '%s' to the project", vars.location)
+			return nil
+		}),
+	}
+	cmd.Flags().BoolVar(&vars.readOnly, readOnlyFlag, false, readOnlyFlagDescription)
+	cmd.Flags().BoolVar(&vars.requesterPays, requesterPaysFlag, false, requesterPaysFlagDescription)
+	cmd.Flags().StringVar(&vars.kmsKeyArn, kmsKeyArnFlag, "", kmsKeyArnFlagDescription)
+	cmd.Flags().StringVar(&vars.bucketOwner, bucketOwnerFlag, "", bucketOwnerFlagDescription)
+	return cmd
+}
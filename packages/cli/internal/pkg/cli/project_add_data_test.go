@@ -0,0 +1,59 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"testing"
+
+	storagemocks "github.com/aws/amazon-genomics-cli/internal/pkg/mocks/storage"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAddDataOpts_Validate(t *testing.T) {
+	assert.NoError(t, (&addDataOpts{addDataVars: addDataVars{location: "s3://my-bucket/reads"}}).Validate())
+	assert.EqualError(t, (&addDataOpts{addDataVars: addDataVars{location: "my-bucket/reads"}}).Validate(),
+		"data location 'my-bucket/reads' is not an S3 URI, it must start with 's3://'")
+	assert.EqualError(t, (&addDataOpts{addDataVars: addDataVars{location: "s3://my-bucket/*/reads"}}).Validate(),
+		"data location 's3://my-bucket/*/reads' can only end with a wildcard, as in 's3://bucket/prefix/*'")
+}
+
+func TestAddDataOpts_Execute(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	projectClient := storagemocks.NewMockProjectClient(ctrl)
+	edited := expectEdit(t, projectClient, editTestProject)
+	opts := &addDataOpts{addDataVars: addDataVars{location: "s3://my-bucket/reads", readOnly: true}, projectClient: projectClient}
+
+	require.NoError(t, opts.Execute())
+	assert.Contains(t, *edited, `data:
+  - location: s3://my-bucket/reference
+  - location: s3://my-bucket/reads
+    readOnly: true
+contexts:
+`)
+}
+
+func TestAddDataOpts_Execute_CrossAccount(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	projectClient := storagemocks.NewMockProjectClient(ctrl)
+	edited := expectEdit(t, projectClient, editTestProject)
+	opts := &addDataOpts{addDataVars: addDataVars{
+		location:      "s3://partner-bucket/cohortA",
+		readOnly:      true,
+		requesterPays: true,
+		kmsKeyArn:     "arn:aws:kms:us-east-1:210987654321:key/1234abcd-12ab-34cd-56ef-1234567890ab",
+		bucketOwner:   "210987654321",
+	}, projectClient: projectClient}
+
+	require.NoError(t, opts.Execute())
+	assert.Contains(t, *edited, `  - location: s3://partner-bucket/cohortA
+    readOnly: true
+    requesterPays: true
+    kmsKeyArn: arn:aws:kms:us-east-1:210987654321:key/1234abcd-12ab-34cd-56ef-1234567890ab
+    bucketOwner: "210987654321"
+`)
+}
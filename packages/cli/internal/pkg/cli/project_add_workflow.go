@@ -0,0 +1,97 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"fmt"
+
+	"github.com/aws/amazon-genomics-cli/internal/pkg/cli/clierror"
+	"github.com/aws/amazon-genomics-cli/internal/pkg/cli/spec"
+	"github.com/aws/amazon-genomics-cli/internal/pkg/storage"
+	"github.com/rs/zerolog/log"
+	"github.com/spf13/cobra"
+)
+
+const (
+	sourceUrlFlag                  = "source-url"
+	sourceUrlFlagDescription       = "The location of the workflow, a path relative to the project or a URL."
+	languageVersionFlag            = "language-version"
+	languageVersionFlagDescription = "The version of the workflow language, such as '1.0' for WDL or 'dsl2' for Nextflow."
+)
+
+type addWorkflowVars struct {
+	workflowName    string
+	workflowType    string
+	languageVersion string
+	sourceUrl       string
+}
+
+type addWorkflowOpts struct {
+	addWorkflowVars
+	projectClient storage.ProjectClient
+}
+
+func newAddWorkflowOpts(vars addWorkflowVars) (*addWorkflowOpts, error) {
+	projectClient, err := storage.NewProjectClient()
+	if err != nil {
+		return nil, err
+	}
+	return &addWorkflowOpts{
+		addWorkflowVars: vars,
+		projectClient:   projectClient,
+	}, nil
+}
+
+func (o *addWorkflowOpts) Validate() error {
+	if o.sourceUrl == "" {
+		return fmt.Errorf("please specify the location of the workflow with the --%s flag", sourceUrlFlag)
+	}
+	if o.languageVersion == "" {
+		return fmt.Errorf("please specify the version of the workflow language with the --%s flag", languageVersionFlag)
+	}
+	return validateWorkflowType(o.workflowType)
+}
+
+// Execute adds the workflow to the project specification
+func (o *addWorkflowOpts) Execute() error {
+	workflow := spec.Workflow{
+		Type:      spec.WorkflowType{Language: o.workflowType, Version: o.languageVersion},
+		SourceURL: o.sourceUrl,
+	}
+	return o.projectClient.Edit(func(editor *spec.Editor) error {
+		return editor.AddWorkflow(o.workflowName, workflow)
+	})
+}
+
+func buildProjectAddWorkflowCommand() *cobra.Command {
+	vars := addWorkflowVars{}
+	cmd := &cobra.Command{
+		Use:   "add-workflow workflow_name --workflow-type {cwl|nextflow|snakemake|wdl} --language-version version --source-url url",
+		Short: "Add a workflow to the project",
+		Long: `Add a workflow to the current project specification.
+The comments and layout of agc-project.yaml are kept, and the project is validated before it is written.`,
+		Example: `
+/code agc project add-workflow hello --workflow-type wdl --language-version 1.0 --source-url workflows/hello`,
+		Args: cobra.ExactArgs(1),
+		RunE: runCmdE(func(cmd *cobra.Command, args []string) error {
+			vars.workflowName = args[0]
+			opts, err := newAddWorkflowOpts(vars)
+			if err != nil {
+				return err
+			}
+			if err := opts.Validate(); err != nil {
+				return err
+			}
+			if err := opts.Execute(); err != nil {
+				return clierror.New("project add-workflow", vars, err)
+			}
+			log.Info().Msgf("Added workflow '%s' to the project", vars.workflowName)
+			return nil
+		}),
+	}
+	cmd.Flags().StringVarP(&vars.workflowType, projectInitWorkflowTypeName, projectInitWorkflowTypeNameShort, "", fmt.Sprintf("The language of the workflow. Valid values include %v", supportedWorkflowTypes))
+	cmd.Flags().StringVar(&vars.languageVersion, languageVersionFlag, "", languageVersionFlagDescription)
+	cmd.Flags().StringVar(&vars.sourceUrl, sourceUrlFlag, "", sourceUrlFlagDescription)
+	return cmd
+}
@@ -0,0 +1,122 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"testing"
+
+	"github.com/aws/amazon-genomics-cli/internal/pkg/cli/spec"
+	storagemocks "github.com/aws/amazon-genomics-cli/internal/pkg/mocks/storage"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const editTestProject = `name: demo
+schemaVersion: 1
+# the workflows of the team
+workflows:
+  hello:
+    type:
+      language: wdl
+      version: 1.0
+    sourceURL: workflows/hello
+data:
+  - location: s3://my-bucket/reference
+contexts:
+  ctx1:
+    engines:
+      - type: wdl
+        engine: cromwell
+`
+
+// expectEdit makes the project client apply an edit to the project and returns the edited project, or the edit error
+func expectEdit(t *testing.T, projectClient *storagemocks.MockProjectClient, projectYaml string) *string {
+	edited := new(string)
+	projectClient.EXPECT().Edit(gomock.Any()).DoAndReturn(func(edit func(editor *spec.Editor) error) error {
+		editor, err := spec.NewEditor([]byte(projectYaml))
+		require.NoError(t, err)
+		if err := edit(editor); err != nil {
+			return err
+		}
+		editedBytes, err := editor.Bytes()
+		require.NoError(t, err)
+		*edited = string(editedBytes)
+		return nil
+	})
+	return edited
+}
+
+func TestAddWorkflowOpts_Validate(t *testing.T) {
+	testCases := map[string]struct {
+		vars        addWorkflowVars
+		expectedErr string
+	}{
+		"valid": {
+			vars: addWorkflowVars{workflowName: "goodbye", workflowType: "nextflow", languageVersion: "dsl2", sourceUrl: "workflows/goodbye"},
+		},
+		"missing source": {
+			vars:        addWorkflowVars{workflowName: "goodbye", workflowType: "nextflow", languageVersion: "dsl2"},
+			expectedErr: "please specify the location of the workflow with the --source-url flag",
+		},
+		"missing version": {
+			vars:        addWorkflowVars{workflowName: "goodbye", workflowType: "nextflow", sourceUrl: "workflows/goodbye"},
+			expectedErr: "please specify the version of the workflow language with the --language-version flag",
+		},
+		"invalid type": {
+			vars:        addWorkflowVars{workflowName: "goodbye", workflowType: "bash", languageVersion: "1", sourceUrl: "workflows/goodbye"},
+			expectedErr: "invalid workflow type supplied: 'bash'. Supported workflow types are: [cwl nextflow snakemake wdl]",
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			opts := &addWorkflowOpts{addWorkflowVars: tc.vars}
+			err := opts.Validate()
+			if tc.expectedErr == "" {
+				assert.NoError(t, err)
+			} else {
+				assert.EqualError(t, err, tc.expectedErr)
+			}
+		})
+	}
+}
+
+func TestAddWorkflowOpts_Execute(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	projectClient := storagemocks.NewMockProjectClient(ctrl)
+	edited := expectEdit(t, projectClient, editTestProject)
+	opts := &addWorkflowOpts{
+		addWorkflowVars: addWorkflowVars{workflowName: "goodbye", workflowType: "nextflow", languageVersion: "dsl2", sourceUrl: "workflows/goodbye"},
+		projectClient:   projectClient,
+	}
+
+	require.NoError(t, opts.Execute())
+	assert.Contains(t, *edited, `# the workflows of the team
+workflows:
+  hello:
+    type:
+      language: wdl
+      version: 1.0
+    sourceURL: workflows/hello
+  goodbye:
+    type:
+      language: nextflow
+      version: dsl2
+    sourceURL: workflows/goodbye
+`)
+}
+
+func TestAddWorkflowOpts_Execute_Exists(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	projectClient := storagemocks.NewMockProjectClient(ctrl)
+	expectEdit(t, projectClient, editTestProject)
+	opts := &addWorkflowOpts{
+		addWorkflowVars: addWorkflowVars{workflowName: "hello", workflowType: "wdl", sourceUrl: "workflows/hello"},
+		projectClient:   projectClient,
+	}
+
+	assert.EqualError(t, opts.Execute(), "workflow 'hello' already exists in the project")
+}
@@ -0,0 +1,317 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/aws/amazon-genomics-cli/internal/pkg/aws"
+	"github.com/aws/amazon-genomics-cli/internal/pkg/aws/s3"
+	"github.com/aws/amazon-genomics-cli/internal/pkg/aws/ssm"
+	"github.com/aws/amazon-genomics-cli/internal/pkg/cli/clierror"
+	"github.com/aws/amazon-genomics-cli/internal/pkg/cli/clierror/actionableerror"
+	"github.com/aws/amazon-genomics-cli/internal/pkg/cli/format"
+	"github.com/aws/amazon-genomics-cli/internal/pkg/cli/spec"
+	"github.com/aws/amazon-genomics-cli/internal/pkg/cli/types"
+	"github.com/aws/amazon-genomics-cli/internal/pkg/storage"
+	"github.com/rs/zerolog/log"
+	"github.com/spf13/cobra"
+)
+
+const (
+	dataCheckStatusOk           = "OK"
+	dataCheckStatusMissing      = "MISSING"
+	dataCheckStatusUncovered    = "UNCOVERED"
+	dataCheckStatusInaccessible = "INACCESSIBLE"
+	s3KeyPatternCharacters      = "*?[{"
+)
+
+type checkDataVars struct {
+	inputsFile string
+}
+
+type checkDataOpts struct {
+	checkDataVars
+	projectClient storage.ProjectClient
+	ssmClient     ssm.Interface
+	s3Client      s3.Interface
+	checks        []types.DataCheck
+}
+
+// dataReference is an S3 URI found in the inputs of the project, along with where it was found
+type dataReference struct {
+	uri    string
+	source string
+}
+
+func newCheckDataOpts(vars checkDataVars) (*checkDataOpts, error) {
+	projectClient, err := storage.NewProjectClient()
+	if err != nil {
+		return nil, err
+	}
+	return &checkDataOpts{
+		checkDataVars: vars,
+		projectClient: projectClient,
+		ssmClient:     aws.SsmClient(profile),
+		s3Client:      aws.S3Client(profile),
+	}, nil
+}
+
+// Execute checks that each S3 URI referenced by the inputs file, the default inputs of the workflows and the input
+// files of their MANIFEST.json is within a data location of the project or the output bucket, and that the object
+// exists and can be read with the credentials of the caller
+func (o *checkDataOpts) Execute() error {
+	projectSpec, err := o.projectClient.Read()
+	if err != nil {
+		return err
+	}
+	references, err := o.collectReferences(projectSpec)
+	if err != nil {
+		return err
+	}
+	if len(references) == 0 {
+		return nil
+	}
+	outputBucket, err := o.ssmClient.GetOutputBucket()
+	if err != nil {
+		return err
+	}
+	outputData := spec.Data{Location: s3.RenderS3Uri(outputBucket, "")}
+
+	problemCount := 0
+	for _, reference := range references {
+		check := o.checkReference(reference, projectSpec.Data, outputData)
+		if check.Status != dataCheckStatusOk {
+			problemCount++
+		}
+		o.checks = append(o.checks, check)
+	}
+	if problemCount > 0 {
+		return actionableerror.New(
+			fmt.Errorf("%d of the %d S3 URIs referenced by the inputs cannot be read by the contexts of the project", problemCount, len(o.checks)),
+			"Please correct the missing URIs, add the uncovered locations with 'agc project add-data' and make sure the inaccessible objects can be read",
+		)
+	}
+	return nil
+}
+
+func (o *checkDataOpts) checkReference(reference dataReference, data []spec.Data, outputData spec.Data) types.DataCheck {
+	check := types.DataCheck{Uri: reference.uri, Source: reference.source, Status: dataCheckStatusOk}
+	bucketName, key := bucketOfUri(reference.uri), keyOfUri(reference.uri)
+	covered := outputData.Covers(reference.uri)
+	requesterPays := false
+	for _, dataItem := range data {
+		if dataItem.Covers(reference.uri) {
+			covered = true
+		}
+		if dataItem.RequesterPays && dataItem.BucketName() == bucketName {
+			requesterPays = true
+		}
+	}
+
+	var headErr error
+	if isS3KeyPattern(key) {
+		check.Detail = "the URI is a prefix or a pattern, only its data location is checked"
+	} else {
+		log.Debug().Msgf("Checking that '%s' exists", reference.uri)
+		exists, err := o.s3Client.ObjectExists(bucketName, key, requesterPays)
+		if err == nil && !exists {
+			check.Status = dataCheckStatusMissing
+			check.Detail = "the object does not exist"
+			return check
+		}
+		headErr = err
+	}
+	if !covered {
+		check.Status = dataCheckStatusUncovered
+		check.Detail = "the URI is not within a data location of the project or the output bucket"
+		return check
+	}
+	if headErr != nil {
+		check.Status = dataCheckStatusInaccessible
+		check.Detail = headErr.Error()
+	}
+	return check
+}
+
+// collectReferences returns the S3 URIs of the inputs file, of the default inputs of each workflow and of the input
+// files listed by the MANIFEST.json of local workflows. Each URI is only returned once, with the first place it was found.
+func (o *checkDataOpts) collectReferences(projectSpec spec.Project) ([]dataReference, error) {
+	var references []dataReference
+	seen := make(map[string]bool)
+	addReferences := func(value interface{}, source string) {
+		for _, uri := range collectS3Uris(value, nil) {
+			if !seen[uri] {
+				seen[uri] = true
+				references = append(references, dataReference{uri: uri, source: source})
+			}
+		}
+	}
+
+	if o.inputsFile != "" {
+		inputs, err := readJsonFile(o.inputsFile)
+		if err != nil {
+			return nil, err
+		}
+		addReferences(inputs, o.inputsFile)
+	}
+	for _, workflowName := range sortedWorkflowNames(projectSpec) {
+		workflowSpec := projectSpec.Workflows[workflowName]
+		addReferences(workflowSpec.DefaultInputs, fmt.Sprintf("defaultInputs of workflow '%s'", workflowName))
+		workflowPath, ok := o.localWorkflowDirectory(workflowSpec.SourceURL)
+		if !ok || !storage.DoesManifestExistInDirectory(workflowPath) {
+			continue
+		}
+		manifest, err := storage.ReadManifestInDirectory(workflowPath)
+		if err != nil {
+			return nil, err
+		}
+		for _, inputFileUrl := range manifest.InputFileUrls {
+			inputFilePath, ok := localPath(workflowPath, inputFileUrl)
+			if !ok {
+				continue
+			}
+			inputs, err := readJsonFile(inputFilePath)
+			if err != nil {
+				return nil, fmt.Errorf("the input file '%s' of workflow '%s' cannot be read: %w", inputFileUrl, workflowName, err)
+			}
+			addReferences(inputs, fmt.Sprintf("%s of workflow '%s'", inputFileUrl, workflowName))
+		}
+	}
+	return references, nil
+}
+
+// localWorkflowDirectory returns the path of the source of a workflow when it is a local directory
+func (o *checkDataOpts) localWorkflowDirectory(sourceURL string) (string, bool) {
+	sourcePath, ok := localPath(o.projectClient.GetLocation(), sourceURL)
+	if !ok {
+		return "", false
+	}
+	fileInfo, err := os.Stat(sourcePath)
+	if err != nil || !fileInfo.IsDir() {
+		return "", false
+	}
+	return sourcePath, true
+}
+
+// localPath returns the path of a location relative to a directory, unless the location is a remote URL
+func localPath(directory, location string) (string, bool) {
+	parsedURL, err := url.Parse(location)
+	if err != nil {
+		return "", false
+	}
+	if scheme := strings.ToLower(parsedURL.Scheme); scheme != "" {
+		if scheme != "file" {
+			return "", false
+		}
+		location = parsedURL.Path
+	}
+	if filepath.IsAbs(location) {
+		return location, true
+	}
+	return filepath.Join(directory, location), true
+}
+
+func readJsonFile(path string) (interface{}, error) {
+	bytes, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	var content interface{}
+	if err := json.Unmarshal(bytes, &content); err != nil {
+		return nil, fmt.Errorf("'%s' is not valid JSON: %w", path, err)
+	}
+	return content, nil
+}
+
+// collectS3Uris appends the S3 URIs among the values of a decoded JSON or YAML document, looking into its objects in
+// the order of their keys
+func collectS3Uris(value interface{}, uris []string) []string {
+	switch typedValue := value.(type) {
+	case string:
+		if s3.IsS3Uri(typedValue) {
+			uris = append(uris, typedValue)
+		}
+	case []interface{}:
+		for _, item := range typedValue {
+			uris = collectS3Uris(item, uris)
+		}
+	case map[string]interface{}:
+		keys := make([]string, 0, len(typedValue))
+		for key := range typedValue {
+			keys = append(keys, key)
+		}
+		sort.Strings(keys)
+		for _, key := range keys {
+			uris = collectS3Uris(typedValue[key], uris)
+		}
+	}
+	return uris
+}
+
+func bucketOfUri(uri string) string {
+	return spec.Data{Location: uri}.BucketName()
+}
+
+func keyOfUri(uri string) string {
+	parsedURL, err := url.Parse(uri)
+	if err != nil {
+		return ""
+	}
+	return strings.TrimPrefix(parsedURL.Path, "/")
+}
+
+// isS3KeyPattern returns true when the key names a prefix or a pattern, such as 'reads/*_{1,2}.fq.gz', rather than an object
+func isS3KeyPattern(key string) bool {
+	return key == "" || strings.HasSuffix(key, "/") || strings.ContainsAny(key, s3KeyPatternCharacters)
+}
+
+func buildProjectCheckDataCommand() *cobra.Command {
+	vars := checkDataVars{}
+	cmd := &cobra.Command{
+		Use:   "check-data [--inputsFile file]",
+		Short: "Check that the data referenced by the inputs can be read by the contexts",
+		Long: `Checks that the S3 URIs referenced by workflow inputs can be read before any workflow is submitted.
+The URIs are collected from the inputs file, from the default inputs of the workflows and from the input files
+listed by the MANIFEST.json of local workflows. Each URI is reported as:
+  OK            the URI is within a data location of the project or the output bucket and the object can be read
+  MISSING       the object does not exist
+  UNCOVERED     the URI is not within a data location of the project or the output bucket, so contexts cannot read it
+  INACCESSIBLE  the object cannot be read with the credentials of the caller
+Objects are checked with a HEAD request using the credentials of the caller, which may differ from the access
+of the roles of a context. URIs that are prefixes or patterns, such as 's3://bucket/reads/*.fq.gz', are only
+checked to be within a data location.
+` + DescribeOutput(types.DataCheck{}),
+		Example: `
+/code $ agc project check-data
+/code $ agc project check-data --inputsFile workflows/myworkflow/myworkflow.inputs.json`,
+		Args: cobra.NoArgs,
+		RunE: runCmdE(func(cmd *cobra.Command, args []string) error {
+			opts, err := newCheckDataOpts(vars)
+			if err != nil {
+				return err
+			}
+			err = opts.Execute()
+			if len(opts.checks) > 0 {
+				format.Default.Write(opts.checks)
+			}
+			if err != nil {
+				return clierror.New("project check-data", vars, err)
+			}
+			if len(opts.checks) == 0 {
+				log.Info().Msgf("No S3 URIs are referenced by the inputs.")
+			}
+			return nil
+		}),
+	}
+	cmd.Flags().StringVarP(&vars.inputsFile, inputsFileFlag, inputsFileFlagShort, "", inputsFileFlagDescription)
+	cmd.Flags().StringVarP(&profile, AWSProfileFlag, AWSProfileFlagShort, "", AWSProfileFlagDescription)
+	return cmd
+}
@@ -0,0 +1,130 @@
+package cli
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/aws/amazon-genomics-cli/internal/pkg/cli/clierror/actionableerror"
+	"github.com/aws/amazon-genomics-cli/internal/pkg/cli/spec"
+	"github.com/aws/amazon-genomics-cli/internal/pkg/cli/types"
+	awsmocks "github.com/aws/amazon-genomics-cli/internal/pkg/mocks/aws"
+	storagemocks "github.com/aws/amazon-genomics-cli/internal/pkg/mocks/storage"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const testCheckDataOutputBucket = "agc-output-bucket"
+
+type checkDataMocks struct {
+	projectClient *storagemocks.MockProjectClient
+	ssmClient     *awsmocks.MockSsmClient
+	s3Client      *awsmocks.MockS3Client
+}
+
+func newCheckDataTestOpts(t *testing.T, inputsFile string) (*checkDataOpts, checkDataMocks) {
+	ctrl := gomock.NewController(t)
+	mocks := checkDataMocks{
+		projectClient: storagemocks.NewMockProjectClient(ctrl),
+		ssmClient:     awsmocks.NewMockSsmClient(ctrl),
+		s3Client:      awsmocks.NewMockS3Client(ctrl),
+	}
+	return &checkDataOpts{
+		checkDataVars: checkDataVars{inputsFile: inputsFile},
+		projectClient: mocks.projectClient,
+		ssmClient:     mocks.ssmClient,
+		s3Client:      mocks.s3Client,
+	}, mocks
+}
+
+func writeCheckDataFile(t *testing.T, path, content string) {
+	require.NoError(t, os.MkdirAll(filepath.Dir(path), 0755))
+	require.NoError(t, os.WriteFile(path, []byte(content), 0644))
+}
+
+func TestCheckDataOpts_Execute(t *testing.T) {
+	projectLocation := t.TempDir()
+	workflowPath := filepath.Join(projectLocation, "workflows", "align")
+	writeCheckDataFile(t, filepath.Join(workflowPath, "MANIFEST.json"), `{"mainWorkflowURL": "align.wdl", "inputFileURLs": ["align.inputs.json", "s3://config-bucket/align.inputs.json"]}`)
+	writeCheckDataFile(t, filepath.Join(workflowPath, "align.inputs.json"), `{
+		"align.reference": "s3://reference-bucket/hg38/genome.fa",
+		"align.sample": {"bam": "s3://cohort-bucket/cohortA/sample.bam", "name": "sample"}
+	}`)
+	inputsFile := filepath.Join(projectLocation, "inputs.json")
+	writeCheckDataFile(t, inputsFile, `{
+		"align.reads": ["s3://cohort-bucket/cohortA/reads_1.fq.gz", "s3://public-bucket/reads_2.fq.gz"],
+		"align.reference": "s3://reference-bucket/hg38/genome.fa",
+		"align.previous": "s3://agc-output-bucket/project/align/run.bam"
+	}`)
+	projectSpec := spec.Project{
+		Workflows: map[string]spec.Workflow{
+			"align":  {SourceURL: "./workflows/align", DefaultInputs: map[string]interface{}{"align.glob": "s3://cohort-bucket/cohortB/*.bam"}},
+			"remote": {SourceURL: "https://example.com/remote.wdl"},
+		},
+		Data: []spec.Data{
+			{Location: "s3://reference-bucket", ReadOnly: true, RequesterPays: true},
+			{Location: "s3://cohort-bucket/cohortA/"},
+		},
+	}
+
+	opts, mocks := newCheckDataTestOpts(t, inputsFile)
+	mocks.projectClient.EXPECT().Read().Return(projectSpec, nil)
+	mocks.projectClient.EXPECT().GetLocation().Return(projectLocation).AnyTimes()
+	mocks.ssmClient.EXPECT().GetOutputBucket().Return(testCheckDataOutputBucket, nil)
+	mocks.s3Client.EXPECT().ObjectExists("agc-output-bucket", "project/align/run.bam", false).Return(true, nil)
+	mocks.s3Client.EXPECT().ObjectExists("cohort-bucket", "cohortA/reads_1.fq.gz", false).Return(false, nil)
+	mocks.s3Client.EXPECT().ObjectExists("public-bucket", "reads_2.fq.gz", false).Return(true, nil)
+	mocks.s3Client.EXPECT().ObjectExists("reference-bucket", "hg38/genome.fa", true).Return(true, nil)
+	mocks.s3Client.EXPECT().ObjectExists("cohort-bucket", "cohortA/sample.bam", false).Return(false, errors.New("forbidden"))
+
+	err := opts.Execute()
+	var actionableError *actionableerror.Error
+	require.True(t, errors.As(err, &actionableError))
+	assert.EqualError(t, actionableError.Cause, "4 of the 6 S3 URIs referenced by the inputs cannot be read by the contexts of the project")
+	assert.Equal(t, []types.DataCheck{
+		{Uri: "s3://agc-output-bucket/project/align/run.bam", Source: inputsFile, Status: "OK"},
+		{Uri: "s3://cohort-bucket/cohortA/reads_1.fq.gz", Source: inputsFile, Status: "MISSING", Detail: "the object does not exist"},
+		{Uri: "s3://public-bucket/reads_2.fq.gz", Source: inputsFile, Status: "UNCOVERED", Detail: "the URI is not within a data location of the project or the output bucket"},
+		{Uri: "s3://reference-bucket/hg38/genome.fa", Source: inputsFile, Status: "OK"},
+		{Uri: "s3://cohort-bucket/cohortB/*.bam", Source: "defaultInputs of workflow 'align'", Status: "UNCOVERED", Detail: "the URI is not within a data location of the project or the output bucket"},
+		{Uri: "s3://cohort-bucket/cohortA/sample.bam", Source: "align.inputs.json of workflow 'align'", Status: "INACCESSIBLE", Detail: "forbidden"},
+	}, opts.checks)
+}
+
+func TestCheckDataOpts_Execute_AllReadable(t *testing.T) {
+	inputsFile := filepath.Join(t.TempDir(), "inputs.json")
+	writeCheckDataFile(t, inputsFile, `{"hello.reads": "s3://cohort-bucket/cohortA/reads/", "hello.sample": "s3://cohort-bucket/cohortA/sample.bam"}`)
+	projectSpec := spec.Project{Data: []spec.Data{{Location: "s3://cohort-bucket/cohortA/*"}}}
+
+	opts, mocks := newCheckDataTestOpts(t, inputsFile)
+	mocks.projectClient.EXPECT().Read().Return(projectSpec, nil)
+	mocks.ssmClient.EXPECT().GetOutputBucket().Return(testCheckDataOutputBucket, nil)
+	mocks.s3Client.EXPECT().ObjectExists("cohort-bucket", "cohortA/sample.bam", false).Return(true, nil)
+
+	require.NoError(t, opts.Execute())
+	assert.Equal(t, []types.DataCheck{
+		{Uri: "s3://cohort-bucket/cohortA/reads/", Source: inputsFile, Status: "OK", Detail: "the URI is a prefix or a pattern, only its data location is checked"},
+		{Uri: "s3://cohort-bucket/cohortA/sample.bam", Source: inputsFile, Status: "OK"},
+	}, opts.checks)
+}
+
+func TestCheckDataOpts_Execute_NoReferences(t *testing.T) {
+	opts, mocks := newCheckDataTestOpts(t, "")
+	mocks.projectClient.EXPECT().Read().Return(spec.Project{}, nil)
+
+	require.NoError(t, opts.Execute())
+	assert.Empty(t, opts.checks)
+}
+
+func TestCheckDataOpts_Execute_InvalidInputsFile(t *testing.T) {
+	inputsFile := filepath.Join(t.TempDir(), "inputs.json")
+	writeCheckDataFile(t, inputsFile, `{"hello.sample": `)
+
+	opts, mocks := newCheckDataTestOpts(t, inputsFile)
+	mocks.projectClient.EXPECT().Read().Return(spec.Project{}, nil)
+
+	err := opts.Execute()
+	assert.EqualError(t, err, "'"+inputsFile+"' is not valid JSON: unexpected end of JSON input")
+}
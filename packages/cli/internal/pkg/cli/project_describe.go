@@ -13,9 +13,16 @@ import (
 	"github.com/aws/amazon-genomics-cli/internal/pkg/storage"
 	"github.com/rs/zerolog/log"
 	"github.com/spf13/cobra"
+	"gopkg.in/yaml.v3"
+)
+
+const (
+	resolvedFlag            = "resolved"
+	resolvedFlagDescription = "Print the project specification with included files, environment variables, context defaults and inheritance resolved."
 )
 
 type describeProjectVars struct {
+	resolved bool
 }
 
 type describeProjectOpts struct {
@@ -61,6 +68,20 @@ func (o *describeProjectOpts) Execute() (types.Project, error) {
 	}, nil
 }
 
+// ExecuteResolved returns the project specification with every context merged with its defaults and parents
+func (o *describeProjectOpts) ExecuteResolved() (string, error) {
+	projectSpec, err := o.projectClient.Read()
+	if err != nil {
+		return "", err
+	}
+	projectSpec.ContextDefaults = nil
+	resolvedYaml, err := yaml.Marshal(projectSpec)
+	if err != nil {
+		return "", err
+	}
+	return string(resolvedYaml), nil
+}
+
 func buildDataRefs(projectSpec spec.Project) []types.Data {
 	var dataList []types.Data
 	for _, data := range projectSpec.Data {
@@ -79,7 +100,10 @@ func buildProjectDescribeCommand() *cobra.Command {
 
 ` + DescribeOutput(types.Project{}),
 		Example: `
-/code agc project describe`,
+/code agc project describe
+
+Print the project specification with included files, environment variables and context inheritance resolved
+/code agc project describe --resolved`,
 		Args: cobra.NoArgs,
 		RunE: runCmdE(func(cmd *cobra.Command, args []string) error {
 			opts, err := newDescribeProjectOpts(vars)
@@ -90,6 +114,14 @@ func buildProjectDescribeCommand() *cobra.Command {
 			if err := opts.Validate(); err != nil {
 				return err
 			}
+			if vars.resolved {
+				resolvedSpec, err := opts.ExecuteResolved()
+				if err != nil {
+					return clierror.New("project describe", vars, err)
+				}
+				printLn(resolvedSpec)
+				return nil
+			}
 			project, err := opts.Execute()
 			if err != nil {
 				return clierror.New("project describe", vars, err)
@@ -98,5 +130,6 @@ func buildProjectDescribeCommand() *cobra.Command {
 			return nil
 		}),
 	}
+	cmd.Flags().BoolVar(&vars.resolved, resolvedFlag, false, resolvedFlagDescription)
 	return cmd
 }
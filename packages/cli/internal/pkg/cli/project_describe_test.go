@@ -59,3 +59,35 @@ func TestProjectDescribe_Execute(t *testing.T) {
 		})
 	}
 }
+
+func TestProjectDescribe_ExecuteResolved(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	mockProjectClient := storagemocks.NewMockProjectClient(ctrl)
+	opts := &describeProjectOpts{
+		projectClient:       mockProjectClient,
+		describeProjectVars: describeProjectVars{resolved: true},
+	}
+	mockProjectClient.EXPECT().Read().Return(spec.Project{
+		Name:            testProjectName,
+		SchemaVersion:   1,
+		ContextDefaults: &spec.Context{RequestSpotInstances: true},
+		Contexts: map[string]spec.Context{
+			"child": {Extends: "parent", RequestSpotInstances: true, Engines: []spec.Engine{{Type: "wdl", Engine: "cromwell"}}},
+		},
+	}, nil)
+
+	resolvedSpec, err := opts.ExecuteResolved()
+
+	require.NoError(t, err)
+	require.Equal(t, `name: testProjectName1
+schemaVersion: 1
+contexts:
+    child:
+        extends: parent
+        requestSpotInstances: true
+        engines:
+            - type: wdl
+              engine: cromwell
+`, resolvedSpec)
+}
@@ -0,0 +1,291 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/url"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/aws/amazon-genomics-cli/internal/pkg/aws/s3"
+	"github.com/aws/amazon-genomics-cli/internal/pkg/cli/spec"
+	"github.com/aws/amazon-genomics-cli/internal/pkg/cli/types"
+	"github.com/aws/amazon-genomics-cli/internal/pkg/storage"
+	"github.com/rs/zerolog/log"
+)
+
+const (
+	diagnosticSeverityError   = "error"
+	diagnosticSeverityWarning = "warning"
+)
+
+// engineFilesystems lists the filesystem types that each engine can be deployed with
+var engineFilesystems = map[string][]string{
+	"cromwell":  {"S3"},
+	"nextflow":  {"S3"},
+	"miniwdl":   {"EFS"},
+	"snakemake": {"EFS"},
+	"toil":      {"S3", "EFS"},
+}
+
+type projectDiagnostic struct {
+	severity string
+	path     []string
+	message  string
+}
+
+// projectDiagnostics collects the problems of a project that pass schema validation but would fail when contexts
+// are deployed or workflows are run
+type projectDiagnostics struct {
+	projectLocation string
+	diagnostics     []projectDiagnostic
+}
+
+func (d *projectDiagnostics) add(severity string, path []string, format string, args ...interface{}) {
+	d.diagnostics = append(d.diagnostics, projectDiagnostic{severity: severity, path: path, message: fmt.Sprintf(format, args...)})
+}
+
+func (d *projectDiagnostics) check(projectSpec spec.Project) {
+	d.checkWorkflowEngines(projectSpec)
+	d.checkWorkflowSources(projectSpec)
+	d.checkDataLocations(projectSpec)
+	d.checkFilesystems(projectSpec)
+}
+
+func (d *projectDiagnostics) checkWorkflowEngines(projectSpec spec.Project) {
+	contextLanguages := make(map[string]bool)
+	for _, contextSpec := range projectSpec.Contexts {
+		for _, engine := range contextSpec.Engines {
+			contextLanguages[strings.ToLower(engine.Type)] = true
+		}
+	}
+
+	for _, workflowName := range sortedWorkflowNames(projectSpec) {
+		language := strings.ToLower(projectSpec.Workflows[workflowName].Type.Language)
+		path := []string{"workflows", workflowName, "type", "language"}
+		defaultEngine, ok := workflowTypeToEngineMap[language]
+		if !ok {
+			d.add(diagnosticSeverityError, path, "workflow '%s' uses language '%s' which is not supported. Supported languages are %v", workflowName, language, supportedWorkflowTypes)
+			continue
+		}
+		if !contextLanguages[language] {
+			d.add(diagnosticSeverityError, path, "no context has an engine for the '%s' workflow '%s'. Add an engine such as '{type: %s, engine: %s}' to a context", language, workflowName, language, defaultEngine)
+		}
+	}
+}
+
+func (d *projectDiagnostics) checkWorkflowSources(projectSpec spec.Project) {
+	for _, workflowName := range sortedWorkflowNames(projectSpec) {
+		sourceURL := projectSpec.Workflows[workflowName].SourceURL
+		path := []string{"workflows", workflowName, "sourceURL"}
+		parsedURL, err := url.Parse(sourceURL)
+		if err != nil {
+			d.add(diagnosticSeverityError, path, "workflow '%s' has an invalid sourceURL: %v", workflowName, err)
+			continue
+		}
+		if scheme := strings.ToLower(parsedURL.Scheme); scheme != "" && scheme != "file" {
+			continue
+		}
+		sourcePath := filepath.Join(d.projectLocation, parsedURL.Path)
+		fileInfo, err := os.Stat(sourcePath)
+		if err != nil {
+			d.add(diagnosticSeverityError, path, "the source of workflow '%s' does not exist at '%s'", workflowName, sourcePath)
+			continue
+		}
+		if fileInfo.IsDir() {
+			d.checkManifest(workflowName, path, sourcePath)
+		}
+	}
+}
+
+func (d *projectDiagnostics) checkManifest(workflowName string, path []string, workflowPath string) {
+	manifestPath := filepath.Join(workflowPath, storage.ManifestFileName)
+	if _, err := os.Stat(manifestPath); err != nil {
+		d.add(diagnosticSeverityWarning, path, "the directory of workflow '%s' has no %s, the engine will look for the main workflow file by convention", workflowName, storage.ManifestFileName)
+		return
+	}
+	manifest, err := spec.FromJson(manifestPath)
+	if err != nil {
+		d.add(diagnosticSeverityError, path, "the %s of workflow '%s' cannot be parsed: %v", storage.ManifestFileName, workflowName, err)
+		return
+	}
+	if manifest.MainWorkflowUrl == "" {
+		d.add(diagnosticSeverityError, path, "the %s of workflow '%s' does not set mainWorkflowURL", storage.ManifestFileName, workflowName)
+	} else if !localFileExists(workflowPath, manifest.MainWorkflowUrl) {
+		d.add(diagnosticSeverityError, path, "the mainWorkflowURL '%s' in the %s of workflow '%s' does not exist", manifest.MainWorkflowUrl, storage.ManifestFileName, workflowName)
+	}
+	for _, inputFileUrl := range manifest.InputFileUrls {
+		if !localFileExists(workflowPath, inputFileUrl) {
+			d.add(diagnosticSeverityError, path, "the input file '%s' in the %s of workflow '%s' does not exist", inputFileUrl, storage.ManifestFileName, workflowName)
+		} else if err := validateJsonFile(workflowPath, inputFileUrl); err != nil {
+			d.add(diagnosticSeverityError, path, "the input file '%s' in the %s of workflow '%s' is not valid JSON: %v", inputFileUrl, storage.ManifestFileName, workflowName, err)
+		}
+	}
+}
+
+func (d *projectDiagnostics) checkDataLocations(projectSpec spec.Project) {
+	for i, data := range projectSpec.Data {
+		if err := s3.ValidateS3Uri(data.Location); err != nil {
+			d.add(diagnosticSeverityError, []string{"data", strconv.Itoa(i), "location"}, "data location %v", err)
+		} else if err := data.ValidateWildcard(); err != nil {
+			d.add(diagnosticSeverityError, []string{"data", strconv.Itoa(i), "location"}, "data location %v", err)
+		}
+	}
+}
+
+func (d *projectDiagnostics) checkFilesystems(projectSpec spec.Project) {
+	for _, contextName := range sortedContextNames(projectSpec) {
+		for i, engine := range projectSpec.Contexts[contextName].Engines {
+			fsType := engine.Filesystem.FSType
+			filesystems, ok := engineFilesystems[engine.Engine]
+			if fsType == "" || !ok || containsString(filesystems, fsType) {
+				continue
+			}
+			path := []string{"contexts", contextName, "engines", strconv.Itoa(i), "filesystem", "fsType"}
+			d.add(diagnosticSeverityError, path, "engine '%s' of context '%s' cannot use filesystem type '%s', it requires %s", engine.Engine, contextName, fsType, strings.Join(filesystems, " or "))
+		}
+	}
+}
+
+// located returns the diagnostics at their position in the project specification file, ordered by line
+func (d *projectDiagnostics) located(fileName string, yamlBytes []byte) []types.ProjectDiagnostic {
+	locator, err := spec.NewLocator(yamlBytes)
+	if err != nil {
+		log.Debug().Err(err).Msg("unable to locate diagnostics in the project specification")
+	}
+	located := make([]types.ProjectDiagnostic, 0, len(d.diagnostics))
+	for _, diagnostic := range d.diagnostics {
+		line, column := locator.Locate(diagnostic.path...)
+		located = append(located, types.ProjectDiagnostic{
+			Severity: diagnostic.severity,
+			File:     fileName,
+			Line:     line,
+			Column:   column,
+			Path:     strings.Join(diagnostic.path, "."),
+			Message:  diagnostic.message,
+		})
+	}
+	sortDiagnostics(located)
+	return located
+}
+
+// schemaDiagnostics returns the problems of a project specification that does not follow the schema as error diagnostics.
+// Problems of values declared in included files are reported in those files.
+func schemaDiagnostics(fileName string, validationErr *spec.ValidationError) []types.ProjectDiagnostic {
+	diagnostics := make([]types.ProjectDiagnostic, 0, len(validationErr.Problems))
+	for _, problem := range validationErr.Problems {
+		problemFileName := fileName
+		if problem.File != "" {
+			problemFileName = filepath.Join(filepath.Dir(fileName), problem.File)
+		}
+		diagnostics = append(diagnostics, types.ProjectDiagnostic{
+			Severity: diagnosticSeverityError,
+			File:     problemFileName,
+			Line:     problem.Line,
+			Column:   problem.Column,
+			Path:     problem.Path,
+			Message:  problem.Message,
+		})
+	}
+	sortDiagnostics(diagnostics)
+	return diagnostics
+}
+
+func sortDiagnostics(diagnostics []types.ProjectDiagnostic) {
+	sort.SliceStable(diagnostics, func(i, j int) bool {
+		if diagnostics[i].File != diagnostics[j].File {
+			return diagnostics[i].File < diagnostics[j].File
+		}
+		if diagnostics[i].Line != diagnostics[j].Line {
+			return diagnostics[i].Line < diagnostics[j].Line
+		}
+		return diagnostics[i].Column < diagnostics[j].Column
+	})
+}
+
+// renderDiagnostic formats a diagnostic as file:line:column followed by the lines of the source around it, the way
+// compilers report errors so that editors can jump to them
+func renderDiagnostic(diagnostic types.ProjectDiagnostic, source []byte) []string {
+	position := diagnostic.File
+	if diagnostic.Line > 0 {
+		position = fmt.Sprintf("%s:%d:%d", diagnostic.File, diagnostic.Line, diagnostic.Column)
+	}
+	lines := []string{fmt.Sprintf("%s: %s: %s: %s", position, diagnostic.Severity, diagnostic.Path, diagnostic.Message)}
+	for _, frameLine := range spec.CodeFrame(source, diagnostic.Line, diagnostic.Column) {
+		lines = append(lines, "    "+frameLine)
+	}
+	return lines
+}
+
+func countErrors(diagnostics []types.ProjectDiagnostic) int {
+	count := 0
+	for _, diagnostic := range diagnostics {
+		if diagnostic.Severity == diagnosticSeverityError {
+			count++
+		}
+	}
+	return count
+}
+
+// localFileExists returns true when the location is a URL, which is not checked, or a file relative to the directory
+func localFileExists(directory, location string) bool {
+	parsedURL, err := url.Parse(location)
+	if err == nil && parsedURL.Scheme != "" {
+		if parsedURL.Scheme != "file" {
+			return true
+		}
+		location = parsedURL.Path
+	}
+	_, err = os.Stat(filepath.Join(directory, location))
+	return !errors.Is(err, os.ErrNotExist)
+}
+
+func validateJsonFile(directory, location string) error {
+	parsedURL, err := url.Parse(location)
+	if err == nil && parsedURL.Scheme != "" {
+		if parsedURL.Scheme != "file" {
+			return nil
+		}
+		location = parsedURL.Path
+	}
+	bytes, err := os.ReadFile(filepath.Join(directory, location))
+	if err != nil {
+		return err
+	}
+	var content interface{}
+	return json.Unmarshal(bytes, &content)
+}
+
+func containsString(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
+
+func sortedWorkflowNames(projectSpec spec.Project) []string {
+	names := make([]string, 0, len(projectSpec.Workflows))
+	for name := range projectSpec.Workflows {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+func sortedContextNames(projectSpec spec.Project) []string {
+	names := make([]string, 0, len(projectSpec.Contexts))
+	for name := range projectSpec.Contexts {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
@@ -0,0 +1,177 @@
+package cli
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/aws/amazon-genomics-cli/internal/pkg/cli/spec"
+	"github.com/aws/amazon-genomics-cli/internal/pkg/cli/types"
+	awsmocks "github.com/aws/amazon-genomics-cli/internal/pkg/mocks/aws"
+	storagemocks "github.com/aws/amazon-genomics-cli/internal/pkg/mocks/storage"
+	"github.com/aws/amazon-genomics-cli/internal/pkg/storage"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const diagnosticsTestProject = `name: diagnostics
+schemaVersion: 1
+workflows:
+  good:
+    type:
+      language: wdl
+      version: 1.0
+    sourceURL: workflows/good
+  broken:
+    type:
+      language: wdl
+      version: 1.0
+    sourceURL: workflows/broken
+  incomplete:
+    type:
+      language: wdl
+      version: 1.0
+    sourceURL: workflows/incomplete
+  conventional:
+    type:
+      language: nextflow
+      version: 1.0
+    sourceURL: workflows/conventional
+  missing:
+    type:
+      language: cwl
+      version: 1.0
+    sourceURL: workflows/missing.cwl
+  remote:
+    type:
+      language: wdl
+      version: 1.0
+    sourceURL: https://example.com/workflow.wdl
+data:
+  - location: s3://my-bucket/reference
+  - location: my-bucket/reads
+  - location: s3://my-bucket/cohort*/reads
+contexts:
+  ctx1:
+    engines:
+      - type: wdl
+        engine: cromwell
+  ctx2:
+    engines:
+      - type: nextflow
+        engine: nextflow
+        filesystem:
+          fsType: EFS
+`
+
+func writeDiagnosticsTestProject(t *testing.T) string {
+	projectDir := t.TempDir()
+	files := map[string]string{
+		storage.ProjectSpecFileName:          "",
+		"workflows/good/MANIFEST.json":       `{"mainWorkflowURL": "main.wdl", "inputFileURLs": ["inputs.json"]}`,
+		"workflows/good/main.wdl":            "version 1.0",
+		"workflows/good/inputs.json":         `{"main.name": "world"}`,
+		"workflows/broken/MANIFEST.json":     `{"mainWorkflowURL": `,
+		"workflows/incomplete/MANIFEST.json": `{"mainWorkflowURL": "main.wdl", "inputFileURLs": ["missing.json", "invalid.json"]}`,
+		"workflows/incomplete/invalid.json":  `{"main.name": `,
+		"workflows/conventional/main.nf":     "",
+	}
+	files[storage.ProjectSpecFileName] = diagnosticsTestProject
+	for name, content := range files {
+		path := filepath.Join(projectDir, name)
+		require.NoError(t, os.MkdirAll(filepath.Dir(path), 0755))
+		require.NoError(t, os.WriteFile(path, []byte(content), 0644))
+	}
+	return projectDir
+}
+
+func TestProjectValidate_Diagnostics(t *testing.T) {
+	projectDir := writeDiagnosticsTestProject(t)
+	projectSpec, err := spec.FromYaml(filepath.Join(projectDir, storage.ProjectSpecFileName))
+	require.NoError(t, err)
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	projectClient := storagemocks.NewMockProjectClient(ctrl)
+	projectClient.EXPECT().Read().Return(projectSpec, nil)
+	projectClient.EXPECT().GetLocation().Return(projectDir).AnyTimes()
+	opts := &validateProjectOpts{
+		projectClient:        projectClient,
+		ssmClient:            awsmocks.NewMockSsmClient(ctrl),
+		secretsManagerClient: awsmocks.NewMockSecretsManagerClient(ctrl),
+	}
+
+	err = opts.Execute()
+	specFile := filepath.Join(projectDir, storage.ProjectSpecFileName)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "found 9 errors in the project specification")
+	assert.Equal(t, []types.ProjectDiagnostic{
+		{Severity: "error", File: specFile, Line: 13, Column: 16, Path: "workflows.broken.sourceURL", Message: "the MANIFEST.json of workflow 'broken' cannot be parsed: unexpected end of JSON input"},
+		{Severity: "error", File: specFile, Line: 18, Column: 16, Path: "workflows.incomplete.sourceURL", Message: "the mainWorkflowURL 'main.wdl' in the MANIFEST.json of workflow 'incomplete' does not exist"},
+		{Severity: "error", File: specFile, Line: 18, Column: 16, Path: "workflows.incomplete.sourceURL", Message: "the input file 'missing.json' in the MANIFEST.json of workflow 'incomplete' does not exist"},
+		{Severity: "error", File: specFile, Line: 18, Column: 16, Path: "workflows.incomplete.sourceURL", Message: "the input file 'invalid.json' in the MANIFEST.json of workflow 'incomplete' is not valid JSON: unexpected end of JSON input"},
+		{Severity: "warning", File: specFile, Line: 23, Column: 16, Path: "workflows.conventional.sourceURL", Message: "the directory of workflow 'conventional' has no MANIFEST.json, the engine will look for the main workflow file by convention"},
+		{Severity: "error", File: specFile, Line: 26, Column: 17, Path: "workflows.missing.type.language", Message: "no context has an engine for the 'cwl' workflow 'missing'. Add an engine such as '{type: cwl, engine: toil}' to a context"},
+		{Severity: "error", File: specFile, Line: 28, Column: 16, Path: "workflows.missing.sourceURL", Message: "the source of workflow 'missing' does not exist at '" + filepath.Join(projectDir, "workflows/missing.cwl") + "'"},
+		{Severity: "error", File: specFile, Line: 36, Column: 15, Path: "data.1.location", Message: "data location 'my-bucket/reads' is not an S3 URI, it must start with 's3://'"},
+		{Severity: "error", File: specFile, Line: 37, Column: 15, Path: "data.2.location", Message: "data location 's3://my-bucket/cohort*/reads' can only end with a wildcard, as in 's3://bucket/prefix/*'"},
+		{Severity: "error", File: specFile, Line: 48, Column: 19, Path: "contexts.ctx2.engines.0.filesystem.fsType", Message: "engine 'nextflow' of context 'ctx2' cannot use filesystem type 'EFS', it requires S3"},
+	}, opts.diagnostics)
+}
+
+func TestProjectValidate_NoDiagnostics(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	projectClient := storagemocks.NewMockProjectClient(ctrl)
+	projectClient.EXPECT().Read().Return(spec.Project{
+		Data: []spec.Data{{Location: "s3://my-bucket/reference"}},
+		Contexts: map[string]spec.Context{
+			"ctx1": {Engines: []spec.Engine{{Type: "wdl", Engine: "miniwdl", Filesystem: spec.Filesystem{FSType: "EFS"}}}},
+		},
+	}, nil)
+	opts := &validateProjectOpts{projectClient: projectClient}
+
+	require.NoError(t, opts.Execute())
+	assert.Empty(t, opts.diagnostics)
+}
+
+func TestProjectValidate_SchemaDiagnostics(t *testing.T) {
+	projectDir := t.TempDir()
+	specFile := filepath.Join(projectDir, storage.ProjectSpecFileName)
+	require.NoError(t, os.WriteFile(specFile, []byte("name: invalid\nschemaVersion: 1\ncontexts:\n  ctx1:\n    engines: foo\n"), 0644))
+	_, readErr := spec.FromYaml(specFile)
+	require.Error(t, readErr)
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	projectClient := storagemocks.NewMockProjectClient(ctrl)
+	projectClient.EXPECT().Read().Return(spec.Project{}, readErr)
+	projectClient.EXPECT().GetLocation().Return(projectDir).AnyTimes()
+	opts := &validateProjectOpts{projectClient: projectClient}
+
+	err := opts.Execute()
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "found 1 errors in the project specification")
+	assert.Equal(t, []types.ProjectDiagnostic{
+		{Severity: "error", File: specFile, Line: 5, Column: 14, Path: "contexts.ctx1.engines", Message: "Invalid type. Expected: array, given: string"},
+	}, opts.diagnostics)
+}
+
+func TestRenderDiagnostic(t *testing.T) {
+	source := []byte("name: invalid\nschemaVersion: 1\ndata:\n  - location: my-bucket\n")
+	diagnostic := types.ProjectDiagnostic{Severity: "error", File: "agc-project.yaml", Line: 4, Column: 15, Path: "data.0.location", Message: "not an S3 URI"}
+
+	assert.Equal(t, []string{
+		"agc-project.yaml:4:15: error: data.0.location: not an S3 URI",
+		"      3 | data:",
+		"    > 4 |   - location: my-bucket",
+		"        |               ^",
+	}, renderDiagnostic(diagnostic, source))
+}
+
+func TestRenderDiagnostic_NotLocated(t *testing.T) {
+	diagnostic := types.ProjectDiagnostic{Severity: "error", File: "agc-project.yaml", Path: "(root)", Message: "name is required"}
+
+	assert.Equal(t, []string{"agc-project.yaml: error: (root): name is required"}, renderDiagnostic(diagnostic, nil))
+}
@@ -5,11 +5,17 @@ package cli
 
 import (
 	"fmt"
+	"os"
+	"path/filepath"
 	"sort"
 
 	"github.com/aws/amazon-genomics-cli/cmd/application/template"
+	"github.com/aws/amazon-genomics-cli/internal/pkg/aws"
+	"github.com/aws/amazon-genomics-cli/internal/pkg/aws/s3"
 	"github.com/aws/amazon-genomics-cli/internal/pkg/cli/clierror"
+	"github.com/aws/amazon-genomics-cli/internal/pkg/cli/clierror/actionableerror"
 	"github.com/aws/amazon-genomics-cli/internal/pkg/cli/group"
+	"github.com/aws/amazon-genomics-cli/internal/pkg/cli/projecttemplate"
 	"github.com/aws/amazon-genomics-cli/internal/pkg/cli/spec"
 	"github.com/aws/amazon-genomics-cli/internal/pkg/storage"
 	"github.com/spf13/cobra"
@@ -20,6 +26,17 @@ const initContextName = "ctx1"
 const (
 	projectInitWorkflowTypeName      = "workflow-type"
 	projectInitWorkflowTypeNameShort = "w"
+	projectInitTemplateFlag          = "template"
+	projectInitTemplateFlagShort     = "t"
+	projectInitVarsFlag              = "vars"
+)
+
+const (
+	projectInitTemplateDescription = `creates the project from a template instead of an empty specification. The template may be the name of a
+built-in template, listed by 'agc project templates', a local directory, a Git repository URL or an S3 prefix. A
+directory of a Git repository may be given after '//', as in 'https://host/repo.git//templates/wdl'.`
+	projectInitVarsDescription = `A list of comma separated key=value pairs that replace the '{{key}}' placeholders in the files of the template.
+The '{{project_name}}' placeholder is always replaced with the name of the project.`
 )
 
 var (
@@ -44,16 +61,27 @@ func init() {
 type initProjectVars struct {
 	ProjectName  string
 	workflowType string
+	template     string
+	variables    map[string]string
+}
+
+type templateFetcher interface {
+	Fetch(source, directory string) error
 }
 
 type initProjectOpts struct {
 	initProjectVars
-	projectClient storage.ProjectClient
+	projectClient   storage.ProjectClient
+	templateFetcher templateFetcher
 }
 
 func (o *initProjectOpts) validateWorkflowType() error {
-	if _, ok := workflowTypeToEngineMap[o.workflowType]; !ok {
-		return fmt.Errorf("invalid workflow type supplied: '%s'. Supported workflow types are: %v", o.workflowType, supportedWorkflowTypes)
+	return validateWorkflowType(o.workflowType)
+}
+
+func validateWorkflowType(workflowType string) error {
+	if _, ok := workflowTypeToEngineMap[workflowType]; !ok {
+		return fmt.Errorf("invalid workflow type supplied: '%s'. Supported workflow types are: %v", workflowType, supportedWorkflowTypes)
 	}
 	return nil
 }
@@ -79,6 +107,7 @@ func newInitProjectOpts(vars initProjectVars) (*initProjectOpts, error) {
 	return &initProjectOpts{
 		initProjectVars: vars,
 		projectClient:   projectClient,
+		templateFetcher: projecttemplate.NewFetcher(func() s3.Interface { return aws.S3Client(profile) }),
 	}, nil
 }
 
@@ -90,12 +119,68 @@ func (o *initProjectOpts) Validate() error {
 	return o.validateProject()
 }
 
-// Execute creates a new empty project specification.
+// Execute creates a new empty project specification, or a project from a template.
 func (o *initProjectOpts) Execute() error {
+	if o.template != "" {
+		return o.createProjectFromTemplate()
+	}
 	newProject := o.createInitialProject()
 	return o.projectClient.Write(newProject)
 }
 
+// createProjectFromTemplate stages the template in a temporary directory, where it is rendered and validated before
+// being copied into the project directory
+func (o *initProjectOpts) createProjectFromTemplate() error {
+	stagedDirectory, err := os.MkdirTemp("", "agc-project-*")
+	if err != nil {
+		return err
+	}
+	defer os.RemoveAll(stagedDirectory)
+
+	if err := o.templateFetcher.Fetch(o.template, stagedDirectory); err != nil {
+		return err
+	}
+	projectPath := filepath.Join(stagedDirectory, storage.ProjectSpecFileName)
+	if _, err := os.Stat(projectPath); err != nil {
+		return actionableerror.New(
+			fmt.Errorf("template '%s' does not contain an '%s' file", o.template, storage.ProjectSpecFileName),
+			"Please check that the template is the directory of an AGC project")
+	}
+	variables := map[string]string{projecttemplate.ProjectNameVariable: o.ProjectName}
+	for key, value := range o.variables {
+		if key != projecttemplate.ProjectNameVariable {
+			variables[key] = value
+		}
+	}
+	if err := projecttemplate.Render(stagedDirectory, variables); err != nil {
+		return err
+	}
+	if err := o.nameTemplateProject(stagedDirectory, projectPath); err != nil {
+		return err
+	}
+	return projecttemplate.Install(stagedDirectory, o.projectClient.GetLocation())
+}
+
+func (o *initProjectOpts) nameTemplateProject(stagedDirectory, projectPath string) error {
+	projectBytes, err := os.ReadFile(projectPath)
+	if err != nil {
+		return err
+	}
+	editor, err := spec.NewEditor(projectBytes)
+	if err != nil {
+		return fmt.Errorf("unable to read the project of template '%s': %w", o.template, err)
+	}
+	editor.SetName(o.ProjectName)
+	projectBytes, err = editor.Bytes()
+	if err != nil {
+		return err
+	}
+	if err := spec.ValidateProjectInDirectory(stagedDirectory, projectBytes); err != nil {
+		return fmt.Errorf("the project of template '%s' is not valid: %w", o.template, err)
+	}
+	return os.WriteFile(projectPath, projectBytes, 0644)
+}
+
 func (o *initProjectOpts) createInitialProject() spec.Project {
 	return spec.Project{
 		Name:          o.ProjectName,
@@ -107,12 +192,22 @@ func (o *initProjectOpts) createInitialProject() spec.Project {
 }
 
 func (o *initProjectOpts) validateProject() error {
+	if o.template != "" && o.workflowType != "" {
+		return fmt.Errorf("please specify either a workflow type with the --%s flag or a template with the --%s flag, not both", projectInitWorkflowTypeName, projectInitTemplateFlag)
+	}
+	if o.template != "" {
+		return o.validateNotInitialized()
+	}
 	if o.workflowType == "" {
 		return fmt.Errorf("please specify a workflow type with the --%s flag", projectInitWorkflowTypeName)
 	}
 	if err := o.validateWorkflowType(); err != nil {
 		return err
 	}
+	return o.validateNotInitialized()
+}
+
+func (o *initProjectOpts) validateNotInitialized() error {
 	isInitialized, err := o.projectClient.IsInitialized()
 	if err != nil {
 		return err
@@ -126,13 +221,23 @@ func (o *initProjectOpts) validateProject() error {
 func BuildProjectInitCommand() *cobra.Command {
 	vars := initProjectVars{}
 	cmd := &cobra.Command{
-		Use:   "init project_name --workflow-type {cwl|nextflow|snakemake|wdl}",
-		Short: "Initialize current directory with a new empty AGC project for a particular workflow type.",
+		Use:   "init project_name {--workflow-type {cwl|nextflow|snakemake|wdl} | --template template [--vars key=value,...]}",
+		Short: "Initialize current directory with a new empty AGC project for a particular workflow type, or from a template.",
 		Long: `Initialize current directory with a new empty AGC project for a particular workflow type.
-Project specification file 'agc-project.yaml' will be created in the current directory.`,
+Project specification file 'agc-project.yaml' will be created in the current directory.
+
+With --template the project is created from the files of a template instead. The '{{key}}' placeholders of the
+template are replaced with the values given with --vars, and the name of the project specification is set to
+project_name. No file is written when the template would overwrite a file of the current directory.`,
 		Example: `
 Initialize a new project named "myProject".
-/code $ agc project init myProject --workflow-type my_workflow_type`,
+/code $ agc project init myProject --workflow-type my_workflow_type
+
+Initialize a new project from the built-in WDL demo.
+/code $ agc project init myProject --template demo-wdl-project
+
+Initialize a new project from a directory of a Git repository.
+/code $ agc project init myProject --template https://github.com/my-org/agc-templates.git//wdl --vars owner=genomics`,
 		Args: cobra.ExactArgs(1),
 		RunE: runCmdE(func(cmd *cobra.Command, args []string) error {
 			vars.ProjectName = args[0]
@@ -155,5 +260,8 @@ Initialize a new project named "myProject".
 	cmd.SetUsageTemplate(template.Usage)
 
 	cmd.Flags().StringVarP(&vars.workflowType, projectInitWorkflowTypeName, projectInitWorkflowTypeNameShort, "", getProjectInitWorkflowTypeNameDescription())
+	cmd.Flags().StringVarP(&vars.template, projectInitTemplateFlag, projectInitTemplateFlagShort, "", projectInitTemplateDescription)
+	cmd.Flags().StringToStringVar(&vars.variables, projectInitVarsFlag, nil, projectInitVarsDescription)
+	cmd.Flags().StringVarP(&profile, AWSProfileFlag, AWSProfileFlagShort, "", AWSProfileFlagDescription)
 	return cmd
 }
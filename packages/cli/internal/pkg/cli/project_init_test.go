@@ -60,7 +60,7 @@ func TestProjectInit_Validate(t *testing.T) {
 			mockProj := storagemocks.NewMockProjectClient(ctrl)
 			opts := &initProjectOpts{
 				projectClient:   mockProj,
-				initProjectVars: initProjectVars{tc.projectName, tc.workflowType},
+				initProjectVars: initProjectVars{ProjectName: tc.projectName, workflowType: tc.workflowType},
 			}
 			mockProj.EXPECT().IsInitialized().AnyTimes().Return(false, nil)
 			err := opts.Validate()
@@ -114,7 +114,7 @@ func TestProjectInit_Execute(t *testing.T) {
 
 			opts := &initProjectOpts{
 				projectClient:   mockProj,
-				initProjectVars: initProjectVars{tc.projectName, tc.engineName},
+				initProjectVars: initProjectVars{ProjectName: tc.projectName, workflowType: tc.engineName},
 			}
 			mockProj.EXPECT().Write(expectedProject).AnyTimes().Return(nil)
 
@@ -155,3 +155,126 @@ func TestProjectInit_CreateInitialProject_ValidateSchema(t *testing.T) {
 	err = spec.ValidateProject(bytes)
 	require.NoError(t, err)
 }
+
+type fakeTemplateFetcher struct {
+	files map[string]string
+	err   error
+}
+
+func (f fakeTemplateFetcher) Fetch(_, directory string) error {
+	for name, content := range f.files {
+		path := filepath.Join(directory, name)
+		if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+			return err
+		}
+		if err := os.WriteFile(path, []byte(content), 0644); err != nil {
+			return err
+		}
+	}
+	return f.err
+}
+
+const testTemplateProject = `# {{project_name}} owned by {{owner}}
+name: Template
+schemaVersion: 1
+workflows:
+  hello:
+    type:
+      language: wdl
+      version: 1.0
+    sourceURL: workflows/hello.wdl
+contexts:
+  ctx1:
+    engines:
+      - type: wdl
+        engine: cromwell
+`
+
+func TestProjectInit_ValidateTemplate(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	mockProj := storagemocks.NewMockProjectClient(ctrl)
+	mockProj.EXPECT().IsInitialized().Return(false, nil)
+
+	opts := &initProjectOpts{
+		projectClient:   mockProj,
+		initProjectVars: initProjectVars{ProjectName: testProjectName, template: "demo-wdl-project"},
+	}
+	assert.NoError(t, opts.Validate())
+
+	opts.workflowType = "wdl"
+	assert.EqualError(t, opts.Validate(), "please specify either a workflow type with the --workflow-type flag or a template with the --template flag, not both")
+}
+
+func TestProjectInit_ExecuteTemplate(t *testing.T) {
+	projectDirectory := t.TempDir()
+	client, err := storage.NewProjectClientWithLocation(projectDirectory)
+	require.NoError(t, err)
+	opts := &initProjectOpts{
+		projectClient: client,
+		templateFetcher: fakeTemplateFetcher{files: map[string]string{
+			storage.ProjectSpecFileName: testTemplateProject,
+			"workflows/hello.wdl":       "# {{ project_name }}\nworkflow hello {}\n",
+		}},
+		initProjectVars: initProjectVars{
+			ProjectName: "myProject",
+			template:    "team-template",
+			variables:   map[string]string{"owner": "genomics", "project_name": "ignored"},
+		},
+	}
+
+	require.NoError(t, opts.Execute())
+	projectBytes, err := os.ReadFile(filepath.Join(projectDirectory, storage.ProjectSpecFileName))
+	require.NoError(t, err)
+	assert.Contains(t, string(projectBytes), "# myProject owned by genomics\nname: myProject\n")
+	workflowBytes, err := os.ReadFile(filepath.Join(projectDirectory, "workflows", "hello.wdl"))
+	require.NoError(t, err)
+	assert.Equal(t, "# myProject\nworkflow hello {}\n", string(workflowBytes))
+}
+
+func TestProjectInit_ExecuteTemplate_Errors(t *testing.T) {
+	testCases := map[string]struct {
+		fetcher       fakeTemplateFetcher
+		existingFiles []string
+		expectedErr   string
+	}{
+		"fetch failure": {
+			fetcher:     fakeTemplateFetcher{err: fmt.Errorf("unable to clone")},
+			expectedErr: "unable to clone",
+		},
+		"missing project specification": {
+			fetcher:     fakeTemplateFetcher{files: map[string]string{"README.md": "readme"}},
+			expectedErr: "an error occurred caused by: template 'team-template' does not contain an 'agc-project.yaml' file\nsuggestion: Please check that the template is the directory of an AGC project\n",
+		},
+		"invalid project specification": {
+			fetcher:     fakeTemplateFetcher{files: map[string]string{storage.ProjectSpecFileName: "name: Template\nschemaVersion: one\n"}},
+			expectedErr: "the project of template 'team-template' is not valid",
+		},
+		"existing files": {
+			fetcher:       fakeTemplateFetcher{files: map[string]string{storage.ProjectSpecFileName: testTemplateProject, "README.md": "readme"}},
+			existingFiles: []string{"README.md"},
+			expectedErr:   "the template would overwrite existing files",
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			projectDirectory := t.TempDir()
+			for _, existingFile := range tc.existingFiles {
+				require.NoError(t, os.WriteFile(filepath.Join(projectDirectory, existingFile), []byte("existing"), 0644))
+			}
+			client, err := storage.NewProjectClientWithLocation(projectDirectory)
+			require.NoError(t, err)
+			opts := &initProjectOpts{
+				projectClient:   client,
+				templateFetcher: tc.fetcher,
+				initProjectVars: initProjectVars{ProjectName: "myProject", template: "team-template"},
+			}
+
+			err = opts.Execute()
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), tc.expectedErr)
+			assert.NoFileExists(t, filepath.Join(projectDirectory, storage.ProjectSpecFileName))
+		})
+	}
+}
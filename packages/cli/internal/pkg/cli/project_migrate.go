@@ -0,0 +1,131 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/aws/amazon-genomics-cli/internal/pkg/cli/clierror"
+	"github.com/aws/amazon-genomics-cli/internal/pkg/cli/spec"
+	"github.com/aws/amazon-genomics-cli/internal/pkg/storage"
+	"github.com/pmezard/go-difflib/difflib"
+	"github.com/rs/zerolog/log"
+	"github.com/spf13/cobra"
+)
+
+const (
+	dryRunFlag            = "dry-run"
+	dryRunFlagDescription = "Show the changes to the project specification without writing them."
+	migrateDiffContext    = 3
+)
+
+type migrateProjectVars struct {
+	dryRun bool
+}
+
+type migrateProjectOpts struct {
+	migrateProjectVars
+	projectClient storage.ProjectClient
+	migrate       func(yamlBytes []byte) ([]byte, []spec.Migration, error)
+}
+
+func newMigrateProjectOpts(vars migrateProjectVars) (*migrateProjectOpts, error) {
+	projectClient, err := storage.NewProjectClient()
+	if err != nil {
+		return nil, err
+	}
+	return &migrateProjectOpts{
+		migrateProjectVars: vars,
+		projectClient:      projectClient,
+		migrate:            spec.Migrate,
+	}, nil
+}
+
+// Execute upgrades the project specification to the latest schema version and returns a unified diff of the changes.
+// The file is rewritten unless this is a dry run. An empty diff means the specification is already up to date.
+func (o *migrateProjectOpts) Execute() (string, error) {
+	specFilePath := filepath.Join(o.projectClient.GetLocation(), storage.ProjectSpecFileName)
+	fileInfo, err := os.Stat(specFilePath)
+	if err != nil {
+		return "", err
+	}
+	yamlBytes, err := os.ReadFile(specFilePath)
+	if err != nil {
+		return "", err
+	}
+	migratedBytes, applied, err := o.migrate(yamlBytes)
+	if err != nil {
+		return "", err
+	}
+	if len(applied) == 0 {
+		return "", nil
+	}
+	for _, migration := range applied {
+		log.Info().Msgf("Upgrading schema version %d to version %d: %s", migration.FromVersion, migration.FromVersion+1, migration.Description)
+	}
+
+	diff, err := difflib.GetUnifiedDiffString(difflib.UnifiedDiff{
+		A:        diffLines(yamlBytes),
+		B:        diffLines(migratedBytes),
+		FromFile: "a/" + storage.ProjectSpecFileName,
+		ToFile:   "b/" + storage.ProjectSpecFileName,
+		Context:  migrateDiffContext,
+	})
+	if err != nil {
+		return "", err
+	}
+	if o.dryRun {
+		return diff, nil
+	}
+	return diff, os.WriteFile(specFilePath, migratedBytes, fileInfo.Mode().Perm())
+}
+
+// diffLines splits text into lines that keep their line ending, without the empty line after the final line ending
+func diffLines(text []byte) []string {
+	lines := strings.SplitAfter(string(text), "\n")
+	if lines[len(lines)-1] == "" {
+		lines = lines[:len(lines)-1]
+	}
+	return lines
+}
+
+func buildProjectMigrateCommand() *cobra.Command {
+	vars := migrateProjectVars{}
+	cmd := &cobra.Command{
+		Use:   "migrate",
+		Short: "Upgrade agc-project.yaml to the latest schema version",
+		Long: `Migrate rewrites the current project specification in place so that it uses the latest schema version
+supported by this CLI. Comments and the order of keys are kept. The changes are printed as a unified diff.
+Use --dry-run to preview the changes without writing them.
+The current project specification is determined to be the agc-project.yaml file in the current working directory or a parent of the current directory.`,
+		Example: `
+/code agc project migrate --dry-run
+/code agc project migrate`,
+		Args: cobra.NoArgs,
+		RunE: runCmdE(func(cmd *cobra.Command, args []string) error {
+			opts, err := newMigrateProjectOpts(vars)
+			if err != nil {
+				return err
+			}
+			log.Info().Msgf("Migrating specification at project root: '%s'", opts.projectClient.GetLocation())
+			diff, err := opts.Execute()
+			if err != nil {
+				return clierror.New("project migrate", vars, err)
+			}
+			if diff == "" {
+				log.Info().Msgf("The project specification already uses schema version %d", spec.LatestVersion)
+				return nil
+			}
+			printLn(strings.TrimSuffix(diff, "\n"))
+			if !vars.dryRun {
+				log.Info().Msgf("Project specification upgraded to schema version %d", spec.LatestVersion)
+			}
+			return nil
+		}),
+	}
+	cmd.Flags().BoolVar(&vars.dryRun, dryRunFlag, false, dryRunFlagDescription)
+	return cmd
+}
@@ -0,0 +1,96 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/aws/amazon-genomics-cli/internal/pkg/cli/spec"
+	storagemocks "github.com/aws/amazon-genomics-cli/internal/pkg/mocks/storage"
+	"github.com/aws/amazon-genomics-cli/internal/pkg/storage"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const migrateTestProject = "name: demo\n# the schema\nschemaVersion: 1\n"
+
+func fakeMigration(yamlBytes []byte) ([]byte, []spec.Migration, error) {
+	migrated := strings.Replace(string(yamlBytes), "schemaVersion: 1", "schemaVersion: 2", 1)
+	return []byte(migrated), []spec.Migration{{FromVersion: 1, Description: "test migration"}}, nil
+}
+
+func TestProjectMigrate_Execute(t *testing.T) {
+	const expectedDiff = `--- a/agc-project.yaml
++++ b/agc-project.yaml
+@@ -1,3 +1,3 @@
+ name: demo
+ # the schema
+-schemaVersion: 1
++schemaVersion: 2
+`
+	testCases := map[string]struct {
+		dryRun       bool
+		migrate      func(yamlBytes []byte) ([]byte, []spec.Migration, error)
+		expectedDiff string
+		expectedFile string
+		expectedErr  string
+	}{
+		"migrates the file": {
+			migrate:      fakeMigration,
+			expectedDiff: expectedDiff,
+			expectedFile: "name: demo\n# the schema\nschemaVersion: 2\n",
+		},
+		"dry run keeps the file": {
+			dryRun:       true,
+			migrate:      fakeMigration,
+			expectedDiff: expectedDiff,
+			expectedFile: migrateTestProject,
+		},
+		"already up to date": {
+			migrate:      spec.Migrate,
+			expectedFile: migrateTestProject,
+		},
+		"migration error": {
+			migrate: func(yamlBytes []byte) ([]byte, []spec.Migration, error) {
+				return nil, nil, fmt.Errorf("some migration error")
+			},
+			expectedErr:  "some migration error",
+			expectedFile: migrateTestProject,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			projectDir := t.TempDir()
+			specFilePath := filepath.Join(projectDir, storage.ProjectSpecFileName)
+			require.NoError(t, os.WriteFile(specFilePath, []byte(migrateTestProject), 0644))
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			projectClient := storagemocks.NewMockProjectClient(ctrl)
+			projectClient.EXPECT().GetLocation().Return(projectDir)
+			opts := &migrateProjectOpts{
+				migrateProjectVars: migrateProjectVars{dryRun: tc.dryRun},
+				projectClient:      projectClient,
+				migrate:            tc.migrate,
+			}
+
+			diff, err := opts.Execute()
+			if tc.expectedErr != "" {
+				require.EqualError(t, err, tc.expectedErr)
+			} else {
+				require.NoError(t, err)
+				assert.Equal(t, tc.expectedDiff, diff)
+			}
+			fileBytes, err := os.ReadFile(specFilePath)
+			require.NoError(t, err)
+			assert.Equal(t, tc.expectedFile, string(fileBytes))
+		})
+	}
+}
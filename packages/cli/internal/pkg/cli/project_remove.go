@@ -0,0 +1,89 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"github.com/aws/amazon-genomics-cli/internal/pkg/cli/clierror"
+	"github.com/aws/amazon-genomics-cli/internal/pkg/cli/spec"
+	"github.com/aws/amazon-genomics-cli/internal/pkg/storage"
+	"github.com/rs/zerolog/log"
+	"github.com/spf13/cobra"
+)
+
+const (
+	removeKindWorkflow = "workflow"
+	removeKindContext  = "context"
+	removeKindData     = "data"
+)
+
+type removeProjectVars struct {
+	kind string
+	name string
+}
+
+type removeProjectOpts struct {
+	removeProjectVars
+	projectClient storage.ProjectClient
+}
+
+func newRemoveProjectOpts(vars removeProjectVars) (*removeProjectOpts, error) {
+	projectClient, err := storage.NewProjectClient()
+	if err != nil {
+		return nil, err
+	}
+	return &removeProjectOpts{
+		removeProjectVars: vars,
+		projectClient:     projectClient,
+	}, nil
+}
+
+// Execute removes the workflow, context or data location from the project specification
+func (o *removeProjectOpts) Execute() error {
+	return o.projectClient.Edit(func(editor *spec.Editor) error {
+		switch o.kind {
+		case removeKindWorkflow:
+			return editor.RemoveWorkflow(o.name)
+		case removeKindContext:
+			return editor.RemoveContext(o.name)
+		default:
+			return editor.RemoveData(o.name)
+		}
+	})
+}
+
+func buildProjectRemoveCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "remove",
+		Short: "Remove a workflow, context or data location from the project",
+		Long: `Remove a workflow, context or data location from the current project specification.
+The comments and layout of agc-project.yaml are kept, and the project is validated before it is written,
+so a context that other contexts extend cannot be removed.`,
+	}
+	cmd.AddCommand(buildProjectRemoveKindCommand(removeKindWorkflow, "workflow_name", "Remove a workflow from the project", "hello"))
+	cmd.AddCommand(buildProjectRemoveKindCommand(removeKindContext, "context_name", "Remove a context from the project", "spotCtx"))
+	cmd.AddCommand(buildProjectRemoveKindCommand(removeKindData, "s3_uri", "Remove a data location from the project", "s3://my-bucket/reference"))
+	return cmd
+}
+
+func buildProjectRemoveKindCommand(kind, argName, short, exampleArg string) *cobra.Command {
+	return &cobra.Command{
+		Use:   kind + " " + argName,
+		Short: short,
+		Example: `
+/code agc project remove ` + kind + " " + exampleArg,
+		Args: cobra.ExactArgs(1),
+		RunE: runCmdE(func(cmd *cobra.Command, args []string) error {
+			vars := removeProjectVars{kind: kind, name: args[0]}
+			opts, err := newRemoveProjectOpts(vars)
+			if err != nil {
+				return err
+			}
+			if err := opts.Execute(); err != nil {
+				return clierror.New("project remove "+kind, vars, err)
+			}
+			log.Info().Msgf("Removed %s '%s' from the project", kind, vars.name)
+			return nil
+		}),
+	}
+}
@@ -0,0 +1,55 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"testing"
+
+	storagemocks "github.com/aws/amazon-genomics-cli/internal/pkg/mocks/storage"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRemoveProjectOpts_Execute(t *testing.T) {
+	testCases := map[string]struct {
+		vars        removeProjectVars
+		notExpected string
+		expectedErr string
+	}{
+		"workflow": {
+			vars:        removeProjectVars{kind: removeKindWorkflow, name: "hello"},
+			notExpected: "workflows:",
+		},
+		"context": {
+			vars:        removeProjectVars{kind: removeKindContext, name: "ctx1"},
+			notExpected: "contexts:",
+		},
+		"data": {
+			vars:        removeProjectVars{kind: removeKindData, name: "s3://my-bucket/reference"},
+			notExpected: "data:",
+		},
+		"missing workflow": {
+			vars:        removeProjectVars{kind: removeKindWorkflow, name: "goodbye"},
+			expectedErr: "workflow 'goodbye' does not exist in the project",
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			projectClient := storagemocks.NewMockProjectClient(ctrl)
+			edited := expectEdit(t, projectClient, editTestProject)
+			opts := &removeProjectOpts{removeProjectVars: tc.vars, projectClient: projectClient}
+
+			err := opts.Execute()
+			if tc.expectedErr != "" {
+				assert.EqualError(t, err, tc.expectedErr)
+				return
+			}
+			assert.NoError(t, err)
+			assert.NotContains(t, *edited, tc.notExpected)
+			assert.Contains(t, *edited, "name: demo\n")
+		})
+	}
+}
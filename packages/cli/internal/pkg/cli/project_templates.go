@@ -0,0 +1,74 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"github.com/aws/amazon-genomics-cli/internal/pkg/cli/clierror"
+	"github.com/aws/amazon-genomics-cli/internal/pkg/cli/format"
+	"github.com/aws/amazon-genomics-cli/internal/pkg/cli/projecttemplate"
+	"github.com/aws/amazon-genomics-cli/internal/pkg/cli/types"
+	"github.com/spf13/cobra"
+)
+
+type projectTemplatesVars struct{}
+
+type projectTemplatesOpts struct {
+	projectTemplatesVars
+	builtins func() ([]projecttemplate.Template, error)
+}
+
+func newProjectTemplatesOpts(vars projectTemplatesVars) (*projectTemplatesOpts, error) {
+	return &projectTemplatesOpts{
+		projectTemplatesVars: vars,
+		builtins:             projecttemplate.Builtins,
+	}, nil
+}
+
+func (o *projectTemplatesOpts) Validate() error {
+	return nil
+}
+
+// Execute returns the built-in project templates
+func (o *projectTemplatesOpts) Execute() ([]types.ProjectTemplate, error) {
+	builtins, err := o.builtins()
+	if err != nil {
+		return nil, err
+	}
+	templates := make([]types.ProjectTemplate, 0, len(builtins))
+	for _, builtin := range builtins {
+		templates = append(templates, types.ProjectTemplate{Name: builtin.Name, Description: builtin.Description})
+	}
+	return templates, nil
+}
+
+func buildProjectTemplatesCommand() *cobra.Command {
+	vars := projectTemplatesVars{}
+	cmd := &cobra.Command{
+		Use:   "templates",
+		Short: "List the built-in project templates",
+		Long: `templates lists the built-in templates that can be given to 'agc project init --template'.
+The built-in templates are the example projects of Amazon Genomics CLI.
+
+` + DescribeOutput([]types.ProjectTemplate{}),
+		Example: `
+/code agc project templates`,
+		Args: cobra.NoArgs,
+		RunE: runCmdE(func(cmd *cobra.Command, args []string) error {
+			opts, err := newProjectTemplatesOpts(vars)
+			if err != nil {
+				return err
+			}
+			if err := opts.Validate(); err != nil {
+				return err
+			}
+			templates, err := opts.Execute()
+			if err != nil {
+				return clierror.New("project templates", vars, err)
+			}
+			format.Default.Write(templates)
+			return nil
+		}),
+	}
+	return cmd
+}
@@ -0,0 +1,25 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"testing"
+
+	"github.com/aws/amazon-genomics-cli/internal/pkg/cli/projecttemplate"
+	"github.com/aws/amazon-genomics-cli/internal/pkg/cli/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestProjectTemplatesOpts_Execute(t *testing.T) {
+	opts := &projectTemplatesOpts{
+		builtins: func() ([]projecttemplate.Template, error) {
+			return []projecttemplate.Template{{Name: "demo-wdl-project", Description: "wdl workflows: hello"}}, nil
+		},
+	}
+
+	templates, err := opts.Execute()
+	require.NoError(t, err)
+	assert.Equal(t, []types.ProjectTemplate{{Name: "demo-wdl-project", Description: "wdl workflows: hello"}}, templates)
+}
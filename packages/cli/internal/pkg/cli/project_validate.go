@@ -4,7 +4,19 @@
 package cli
 
 import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/aws/amazon-genomics-cli/internal/pkg/aws"
+	"github.com/aws/amazon-genomics-cli/internal/pkg/aws/secretsmanager"
+	"github.com/aws/amazon-genomics-cli/internal/pkg/aws/ssm"
 	"github.com/aws/amazon-genomics-cli/internal/pkg/cli/clierror"
+	"github.com/aws/amazon-genomics-cli/internal/pkg/cli/clierror/actionableerror"
+	"github.com/aws/amazon-genomics-cli/internal/pkg/cli/format"
+	"github.com/aws/amazon-genomics-cli/internal/pkg/cli/spec"
 	"github.com/aws/amazon-genomics-cli/internal/pkg/cli/types"
 	"github.com/aws/amazon-genomics-cli/internal/pkg/storage"
 	"github.com/rs/zerolog/log"
@@ -12,7 +24,11 @@ import (
 )
 
 type validateProjectOpts struct {
-	projectClient storage.ProjectClient
+	projectClient        storage.ProjectClient
+	ssmClient            ssm.Interface
+	secretsManagerClient secretsmanager.Interface
+	diagnostics          []types.ProjectDiagnostic
+	specSource           []byte
 }
 
 func newValidateProjectOpts() (*validateProjectOpts, error) {
@@ -21,13 +37,134 @@ func newValidateProjectOpts() (*validateProjectOpts, error) {
 		return nil, err
 	}
 	return &validateProjectOpts{
-		projectClient: projectClient,
+		projectClient:        projectClient,
+		ssmClient:            aws.SsmClient(profile),
+		secretsManagerClient: aws.SecretsManagerClient(profile),
 	}, nil
 }
 
+// Execute validates the project specification. Schema violations and problems that the schema cannot catch are
+// collected as diagnostics
 func (o *validateProjectOpts) Execute() error {
-	_, err := o.projectClient.Read()
-	return err
+	projectSpec, err := o.projectClient.Read()
+	var validationErr *spec.ValidationError
+	if errors.As(err, &validationErr) {
+		if err := o.readSpecSource(); err != nil {
+			return err
+		}
+		o.diagnostics = schemaDiagnostics(o.specFilePath(), validationErr)
+		return diagnosticsError(o.diagnostics)
+	}
+	if err != nil {
+		return err
+	}
+	if err := o.diagnose(projectSpec); err != nil {
+		return err
+	}
+	if err := o.validateSecrets(projectSpec); err != nil {
+		return err
+	}
+	return diagnosticsError(o.diagnostics)
+}
+
+func diagnosticsError(diagnostics []types.ProjectDiagnostic) error {
+	if errorCount := countErrors(diagnostics); errorCount > 0 {
+		return actionableerror.New(
+			fmt.Errorf("found %d errors in the project specification", errorCount),
+			"Please correct the errors listed by the diagnostics",
+		)
+	}
+	return nil
+}
+
+func (o *validateProjectOpts) specFilePath() string {
+	return filepath.Join(o.projectClient.GetLocation(), storage.ProjectSpecFileName)
+}
+
+func (o *validateProjectOpts) readSpecSource() error {
+	specSource, err := os.ReadFile(o.specFilePath())
+	if err != nil {
+		return err
+	}
+	o.specSource = specSource
+	return nil
+}
+
+// diagnosticSource returns the contents of the file of a diagnostic, which is an included file for some schema problems
+func (o *validateProjectOpts) diagnosticSource(fileName string) []byte {
+	if fileName == o.specFilePath() {
+		return o.specSource
+	}
+	source, err := os.ReadFile(fileName)
+	if err != nil {
+		log.Debug().Err(err).Msgf("unable to read '%s' to show the location of a diagnostic", fileName)
+		return nil
+	}
+	return source
+}
+
+func (o *validateProjectOpts) diagnose(projectSpec spec.Project) error {
+	diagnostics := projectDiagnostics{}
+	if len(projectSpec.Workflows) > 0 {
+		diagnostics.projectLocation = o.projectClient.GetLocation()
+	}
+	diagnostics.check(projectSpec)
+	if len(diagnostics.diagnostics) == 0 {
+		return nil
+	}
+	if err := o.readSpecSource(); err != nil {
+		return err
+	}
+	o.diagnostics = diagnostics.located(o.specFilePath(), o.specSource)
+	return nil
+}
+
+// writeDiagnostics prints each diagnostic with a snippet of the project specification in text format. Other formats
+// write the diagnostics as data for editors and pre-commit hooks, including an empty list for a valid project.
+func (o *validateProjectOpts) writeDiagnostics() {
+	if _, ok := format.Default.(*format.Text); !ok {
+		diagnostics := o.diagnostics
+		if diagnostics == nil {
+			diagnostics = []types.ProjectDiagnostic{}
+		}
+		format.Default.Write(diagnostics)
+		return
+	}
+	for _, diagnostic := range o.diagnostics {
+		for _, line := range renderDiagnostic(diagnostic, o.diagnosticSource(diagnostic.File)) {
+			printLn(line)
+		}
+	}
+}
+
+func (o *validateProjectOpts) validateSecrets(projectSpec spec.Project) error {
+	var missingSecrets []string
+	for _, contextName := range sortedContextNames(projectSpec) {
+		for _, secret := range projectSpec.Contexts[contextName].Secrets {
+			exists, err := o.secretExists(secret)
+			if err != nil {
+				return err
+			}
+			if !exists {
+				missingSecrets = append(missingSecrets, fmt.Sprintf("context '%s' secret '%s' references %s '%s' which does not exist", contextName, secret.Name, secret.GetSource(), secret.ValueFrom))
+			}
+		}
+	}
+
+	if len(missingSecrets) > 0 {
+		return actionableerror.New(
+			fmt.Errorf("\n\t%s", strings.Join(missingSecrets, "\n\t")),
+			"Please create the referenced parameters or secrets in the current account and region, or correct their names in the project specification",
+		)
+	}
+	return nil
+}
+
+func (o *validateProjectOpts) secretExists(secret spec.Secret) (bool, error) {
+	if secret.GetSource() == spec.SecretSourceSecretsManager {
+		return o.secretsManagerClient.SecretExists(secret.ValueFrom)
+	}
+	return o.ssmClient.ParameterExists(secret.ValueFrom)
 }
 
 func buildProjectValidateCommand() *cobra.Command {
@@ -35,10 +172,17 @@ func buildProjectValidateCommand() *cobra.Command {
 		Use:   "validate",
 		Short: "Validate an agc-project.yaml file",
 		Long: `Determines if the current project specification follows the required format and lists any syntax errors. 
-The current project specification is determined to be the agc-project.yaml file in the current working directory or a parent of the current directory
-` + DescribeOutput(types.Project{}),
+The current project specification is determined to be the agc-project.yaml file in the current working directory or a parent of the current directory.
+Secrets referenced by contexts are checked to exist in SSM Parameter Store or Secrets Manager.
+Workflows are checked to have an engine in a context and local workflow sources are checked to exist, along
+with the files listed by their MANIFEST.json. Data locations must be S3 URIs and engines must use a filesystem
+type they support. Problems are listed as diagnostics with the file, line and column of the offending value. In text
+format each diagnostic is followed by the lines of the project specification around it. Use '--format json' to read
+the diagnostics from editors and pre-commit hooks.
+` + DescribeOutput(types.ProjectDiagnostic{}),
 		Example: `
-/code agc project describe`,
+/code agc project validate
+/code agc project validate --format json`,
 		Args: cobra.NoArgs,
 		RunE: runCmdE(func(cmd *cobra.Command, args []string) error {
 			opts, err := newValidateProjectOpts()
@@ -47,12 +191,16 @@ The current project specification is determined to be the agc-project.yaml file
 			}
 			log.Info().Msgf("Validating specification at project root: '%s'", opts.projectClient.GetLocation())
 			err = opts.Execute()
+			if err == nil || len(opts.diagnostics) > 0 {
+				opts.writeDiagnostics()
+			}
 			if err != nil {
-				return clierror.New("project describe", "", err)
+				return clierror.New("project validate", "", err)
 			}
 			log.Info().Msgf("No errors found.")
 			return nil
 		}),
 	}
+	cmd.Flags().StringVarP(&profile, AWSProfileFlag, AWSProfileFlagShort, "", AWSProfileFlagDescription)
 	return cmd
 }
@@ -2,15 +2,31 @@ package cli
 
 import (
 	"fmt"
+	"io"
+	"os"
 	"testing"
 
+	"github.com/aws/amazon-genomics-cli/internal/pkg/cli/clierror/actionableerror"
+	"github.com/aws/amazon-genomics-cli/internal/pkg/cli/format"
 	"github.com/aws/amazon-genomics-cli/internal/pkg/cli/spec"
+	awsmocks "github.com/aws/amazon-genomics-cli/internal/pkg/mocks/aws"
 	storagemocks "github.com/aws/amazon-genomics-cli/internal/pkg/mocks/storage"
 	"github.com/golang/mock/gomock"
 	"github.com/stretchr/testify/require"
 )
 
 func TestProjectValidate_Execute(t *testing.T) {
+	projectWithSecrets := spec.Project{
+		Contexts: map[string]spec.Context{
+			"ctx1": {
+				Secrets: []spec.Secret{
+					{Name: "LICENSE_SERVER", ValueFrom: "/license/server"},
+					{Name: "API_TOKEN", ValueFrom: "api-token", Source: spec.SecretSourceSecretsManager},
+				},
+			},
+		},
+	}
+
 	testCases := map[string]struct {
 		expectedErr error
 		createMocks func(opts *validateProjectOpts)
@@ -27,6 +43,32 @@ func TestProjectValidate_Execute(t *testing.T) {
 				opts.projectClient.(*storagemocks.MockProjectClient).EXPECT().Read().Return(spec.Project{}, fmt.Errorf("invalid spec"))
 			},
 		},
+		"secrets exist": {
+			expectedErr: nil,
+			createMocks: func(opts *validateProjectOpts) {
+				opts.projectClient.(*storagemocks.MockProjectClient).EXPECT().Read().Return(projectWithSecrets, nil)
+				opts.ssmClient.(*awsmocks.MockSsmClient).EXPECT().ParameterExists("/license/server").Return(true, nil)
+				opts.secretsManagerClient.(*awsmocks.MockSecretsManagerClient).EXPECT().SecretExists("api-token").Return(true, nil)
+			},
+		},
+		"secrets missing": {
+			expectedErr: actionableerror.New(
+				fmt.Errorf("\n\tcontext 'ctx1' secret 'LICENSE_SERVER' references parameterStore '/license/server' which does not exist\n\tcontext 'ctx1' secret 'API_TOKEN' references secretsManager 'api-token' which does not exist"),
+				"Please create the referenced parameters or secrets in the current account and region, or correct their names in the project specification",
+			),
+			createMocks: func(opts *validateProjectOpts) {
+				opts.projectClient.(*storagemocks.MockProjectClient).EXPECT().Read().Return(projectWithSecrets, nil)
+				opts.ssmClient.(*awsmocks.MockSsmClient).EXPECT().ParameterExists("/license/server").Return(false, nil)
+				opts.secretsManagerClient.(*awsmocks.MockSecretsManagerClient).EXPECT().SecretExists("api-token").Return(false, nil)
+			},
+		},
+		"secret lookup error": {
+			expectedErr: fmt.Errorf("access denied"),
+			createMocks: func(opts *validateProjectOpts) {
+				opts.projectClient.(*storagemocks.MockProjectClient).EXPECT().Read().Return(projectWithSecrets, nil)
+				opts.ssmClient.(*awsmocks.MockSsmClient).EXPECT().ParameterExists("/license/server").Return(false, fmt.Errorf("access denied"))
+			},
+		},
 	}
 
 	for name, tc := range testCases {
@@ -34,7 +76,11 @@ func TestProjectValidate_Execute(t *testing.T) {
 			ctrl := gomock.NewController(t)
 			defer ctrl.Finish()
 			mockProjClient := storagemocks.NewMockProjectClient(ctrl)
-			opts := &validateProjectOpts{projectClient: mockProjClient}
+			opts := &validateProjectOpts{
+				projectClient:        mockProjClient,
+				ssmClient:            awsmocks.NewMockSsmClient(ctrl),
+				secretsManagerClient: awsmocks.NewMockSecretsManagerClient(ctrl),
+			}
 
 			tc.createMocks(opts)
 			err := opts.Execute()
@@ -46,3 +92,23 @@ func TestProjectValidate_Execute(t *testing.T) {
 		})
 	}
 }
+
+func TestProjectValidate_WriteDiagnostics_JsonWithoutDiagnostics(t *testing.T) {
+	origStdout := os.Stdout
+	reader, writer, err := os.Pipe()
+	require.NoError(t, err)
+	os.Stdout = writer
+	format.SetFormatter("json")
+	defer func() {
+		os.Stdout = origStdout
+		format.SetFormatter(format.DefaultFormat)
+	}()
+
+	opts := &validateProjectOpts{}
+	opts.writeDiagnostics()
+	require.NoError(t, writer.Close())
+	output, err := io.ReadAll(reader)
+
+	require.NoError(t, err)
+	require.Equal(t, "[]\n", string(output))
+}
@@ -0,0 +1,99 @@
+// Package projecttemplate fetches the project scaffolds used by 'agc project init --template' and fills in their
+// placeholders.
+package projecttemplate
+
+import (
+	"embed"
+	"fmt"
+	"io/fs"
+	"path"
+	"sort"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+//go:generate go run copyexamples.go
+
+// examples are the example projects of the repository, which are the built-in templates. They are copied by
+// 'go generate', which the Makefile runs before building and testing, and are not checked in. Until they are
+// copied the package does not compile, so that no binary is built without templates.
+//
+//go:embed all:examples
+var examples embed.FS
+
+const (
+	examplesDirectory = "examples"
+	ProjectFileName   = "agc-project.yaml"
+)
+
+// Template describes a built-in template
+type Template struct {
+	Name        string
+	Description string
+}
+
+// Builtins returns the built-in templates in alphabetical order
+func Builtins() ([]Template, error) {
+	entries, err := fs.ReadDir(examples, examplesDirectory)
+	if err != nil {
+		return nil, err
+	}
+	var templates []Template
+	for _, entry := range entries {
+		if !entry.IsDir() {
+			continue
+		}
+		description, err := describeBuiltin(entry.Name())
+		if err != nil {
+			return nil, err
+		}
+		templates = append(templates, Template{Name: entry.Name(), Description: description})
+	}
+	sort.Slice(templates, func(i, j int) bool { return templates[i].Name < templates[j].Name })
+	return templates, nil
+}
+
+// IsBuiltin returns true when name is the name of a built-in template
+func IsBuiltin(name string) bool {
+	if !fs.ValidPath(name) || strings.ContainsAny(name, `/\`) || name == "." {
+		return false
+	}
+	info, err := fs.Stat(examples, path.Join(examplesDirectory, name))
+	return err == nil && info.IsDir()
+}
+
+func builtinFS(name string) (fs.FS, error) {
+	return fs.Sub(examples, path.Join(examplesDirectory, name))
+}
+
+// describeBuiltin summarizes the workflows of a built-in template from its project specification
+func describeBuiltin(name string) (string, error) {
+	projectBytes, err := examples.ReadFile(path.Join(examplesDirectory, name, ProjectFileName))
+	if err != nil {
+		return "", err
+	}
+	var project struct {
+		Workflows map[string]struct {
+			Type struct {
+				Language string `yaml:"language"`
+			} `yaml:"type"`
+		} `yaml:"workflows"`
+	}
+	if err := yaml.Unmarshal(projectBytes, &project); err != nil {
+		return "", fmt.Errorf("unable to read the project of template '%s': %w", name, err)
+	}
+	languages := make(map[string]bool)
+	workflowNames := make([]string, 0, len(project.Workflows))
+	for workflowName, workflow := range project.Workflows {
+		languages[strings.ToLower(workflow.Type.Language)] = true
+		workflowNames = append(workflowNames, workflowName)
+	}
+	languageNames := make([]string, 0, len(languages))
+	for language := range languages {
+		languageNames = append(languageNames, language)
+	}
+	sort.Strings(languageNames)
+	sort.Strings(workflowNames)
+	return fmt.Sprintf("%s workflows: %s", strings.Join(languageNames, ", "), strings.Join(workflowNames, ", ")), nil
+}
@@ -0,0 +1,75 @@
+package projecttemplate
+
+import (
+	"bytes"
+	"io/fs"
+	"os"
+	"path"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const repositoryExamplesDirectory = "../../../../../../examples"
+
+func TestBuiltins(t *testing.T) {
+	templates, err := Builtins()
+	require.NoError(t, err)
+	require.NotEmpty(t, templates, "no built-in templates are embedded, please run 'go generate'")
+
+	var names []string
+	for _, template := range templates {
+		names = append(names, template.Name)
+	}
+	assert.Equal(t, []string{
+		"demo-cwl-project",
+		"demo-nextflow-project",
+		"demo-snakemake-project",
+		"demo-wdl-project",
+		"gatk-best-practices-project",
+		"gatk-best-practices-project-miniwdl",
+		"nf-core-project",
+	}, names)
+	assert.Equal(t, Template{Name: "demo-wdl-project", Description: "wdl workflows: hello, read, words-with-vowels"}, templates[3])
+}
+
+func TestIsBuiltin(t *testing.T) {
+	assert.True(t, IsBuiltin("demo-wdl-project"))
+	assert.False(t, IsBuiltin(""))
+	assert.False(t, IsBuiltin("."))
+	assert.False(t, IsBuiltin(".."))
+	assert.False(t, IsBuiltin("demo-wdl-project/workflows"))
+	assert.False(t, IsBuiltin("missing-project"))
+}
+
+// TestBuiltins_MatchExamples fails when the examples of the repository change without running 'go generate'
+func TestBuiltins_MatchExamples(t *testing.T) {
+	if _, err := os.Stat(repositoryExamplesDirectory); err != nil {
+		t.Skip("the examples of the repository are not available")
+	}
+	repositoryExamples := os.DirFS(repositoryExamplesDirectory)
+	embeddedFiles := make(map[string]bool)
+	err := fs.WalkDir(examples, examplesDirectory, func(embeddedPath string, entry fs.DirEntry, err error) error {
+		if err != nil || entry.IsDir() {
+			return err
+		}
+		relativePath := embeddedPath[len(examplesDirectory)+1:]
+		embeddedFiles[relativePath] = true
+		embedded, err := examples.ReadFile(embeddedPath)
+		require.NoError(t, err)
+		original, err := fs.ReadFile(repositoryExamples, relativePath)
+		require.NoError(t, err, "%s was removed from the examples, please run 'go generate'", relativePath)
+		assert.True(t, bytes.Equal(original, embedded), "%s differs from the examples, please run 'go generate'", relativePath)
+		return nil
+	})
+	require.NoError(t, err)
+	err = fs.WalkDir(repositoryExamples, ".", func(examplePath string, entry fs.DirEntry, err error) error {
+		if err != nil || entry.IsDir() {
+			return err
+		}
+		assert.True(t, embeddedFiles[examplePath], "%s is missing from the built-in templates, please run 'go generate'", path.Clean(examplePath))
+		return nil
+	})
+	require.NoError(t, err)
+}
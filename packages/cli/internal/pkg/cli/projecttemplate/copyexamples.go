@@ -0,0 +1,48 @@
+//go:build ignore
+
+// copyexamples replaces the built-in templates with the example projects of the repository. It is run by
+// 'go generate' from the directory of the projecttemplate package.
+package main
+
+import (
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+)
+
+const (
+	repositoryExamplesDirectory = "../../../../../../examples"
+	examplesDirectory           = "examples"
+)
+
+func main() {
+	if err := copyExamples(); err != nil {
+		fmt.Fprintf(os.Stderr, "unable to copy the examples of the repository: %v\n", err)
+		os.Exit(1)
+	}
+}
+
+func copyExamples() error {
+	if err := os.RemoveAll(examplesDirectory); err != nil {
+		return err
+	}
+	return filepath.WalkDir(repositoryExamplesDirectory, func(sourcePath string, entry fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		relativePath, err := filepath.Rel(repositoryExamplesDirectory, sourcePath)
+		if err != nil {
+			return err
+		}
+		targetPath := filepath.Join(examplesDirectory, relativePath)
+		if entry.IsDir() {
+			return os.MkdirAll(targetPath, 0755)
+		}
+		content, err := os.ReadFile(sourcePath)
+		if err != nil {
+			return err
+		}
+		return os.WriteFile(targetPath, content, 0644)
+	})
+}
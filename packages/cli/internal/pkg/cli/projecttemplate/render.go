@@ -0,0 +1,122 @@
+package projecttemplate
+
+import (
+	"bytes"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strings"
+)
+
+// ProjectNameVariable is the placeholder that is always replaced with the name of the new project
+const ProjectNameVariable = "project_name"
+
+// binaryProbeLength is the number of leading bytes searched for a NUL byte to skip binary files, as Git does
+const binaryProbeLength = 8000
+
+// placeholderRegexp matches placeholders such as '{{ project_name }}'
+var placeholderRegexp = regexp.MustCompile(`\{\{\s*([A-Za-z_][A-Za-z0-9_]*)\s*\}\}`)
+
+// copyTree copies the files of a file system into a directory, leaving out Git metadata
+func copyTree(source fs.FS, directory string) error {
+	return fs.WalkDir(source, ".", func(path string, entry fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if entry.Name() == gitDirectory {
+			if entry.IsDir() {
+				return fs.SkipDir
+			}
+			return nil
+		}
+		target := filepath.Join(directory, filepath.FromSlash(path))
+		if entry.IsDir() {
+			return os.MkdirAll(target, 0755)
+		}
+		if !entry.Type().IsRegular() {
+			return nil
+		}
+		content, err := fs.ReadFile(source, path)
+		if err != nil {
+			return err
+		}
+		info, err := entry.Info()
+		if err != nil {
+			return err
+		}
+		return os.WriteFile(target, content, 0644|info.Mode().Perm()&0111)
+	})
+}
+
+// Render replaces the placeholders of the variables in the text files of a directory. Placeholders of other names are
+// left untouched.
+func Render(directory string, variables map[string]string) error {
+	return filepath.WalkDir(directory, func(path string, entry fs.DirEntry, err error) error {
+		if err != nil || entry.IsDir() {
+			return err
+		}
+		content, err := os.ReadFile(path)
+		if err != nil {
+			return err
+		}
+		if isBinary(content) {
+			return nil
+		}
+		rendered := renderPlaceholders(content, variables)
+		if bytes.Equal(rendered, content) {
+			return nil
+		}
+		info, err := entry.Info()
+		if err != nil {
+			return err
+		}
+		return os.WriteFile(path, rendered, info.Mode().Perm())
+	})
+}
+
+func renderPlaceholders(content []byte, variables map[string]string) []byte {
+	return placeholderRegexp.ReplaceAllFunc(content, func(placeholder []byte) []byte {
+		name := string(placeholderRegexp.FindSubmatch(placeholder)[1])
+		if value, ok := variables[name]; ok {
+			return []byte(value)
+		}
+		return placeholder
+	})
+}
+
+func isBinary(content []byte) bool {
+	if len(content) > binaryProbeLength {
+		content = content[:binaryProbeLength]
+	}
+	return bytes.IndexByte(content, 0) >= 0
+}
+
+// Install copies the files of a staged template into a directory. Nothing is copied when a file of the template
+// already exists in the directory.
+func Install(stagedDirectory, directory string) error {
+	var conflicts []string
+	err := filepath.WalkDir(stagedDirectory, func(path string, entry fs.DirEntry, err error) error {
+		if err != nil || entry.IsDir() {
+			return err
+		}
+		relativePath, err := filepath.Rel(stagedDirectory, path)
+		if err != nil {
+			return err
+		}
+		if _, err := os.Stat(filepath.Join(directory, relativePath)); err == nil {
+			conflicts = append(conflicts, relativePath)
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+	if len(conflicts) > 0 {
+		sort.Strings(conflicts)
+		return fmt.Errorf("the template would overwrite existing files in '%s': %s", directory, strings.Join(conflicts, ", "))
+	}
+	return copyTree(os.DirFS(stagedDirectory), directory)
+}
@@ -0,0 +1,70 @@
+package projecttemplate
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func writeTestFiles(t *testing.T, files map[string]string) string {
+	directory := t.TempDir()
+	for name, content := range files {
+		path := filepath.Join(directory, name)
+		require.NoError(t, os.MkdirAll(filepath.Dir(path), 0755))
+		require.NoError(t, os.WriteFile(path, []byte(content), 0644))
+	}
+	return directory
+}
+
+func readTestFile(t *testing.T, path string) string {
+	content, err := os.ReadFile(path)
+	require.NoError(t, err)
+	return string(content)
+}
+
+func TestRender(t *testing.T) {
+	directory := writeTestFiles(t, map[string]string{
+		"agc-project.yaml":      "name: {{project_name}}\n",
+		"workflows/main.wdl":    "# {{ project_name }} by {{ author }} for {{ unknown }}\n",
+		"workflows/binary.data": "{{project_name}}\x00",
+	})
+
+	err := Render(directory, map[string]string{ProjectNameVariable: "demo", "author": "genomics"})
+	require.NoError(t, err)
+	assert.Equal(t, "name: demo\n", readTestFile(t, filepath.Join(directory, "agc-project.yaml")))
+	assert.Equal(t, "# demo by genomics for {{ unknown }}\n", readTestFile(t, filepath.Join(directory, "workflows", "main.wdl")))
+	assert.Equal(t, "{{project_name}}\x00", readTestFile(t, filepath.Join(directory, "workflows", "binary.data")))
+}
+
+func TestInstall(t *testing.T) {
+	staged := writeTestFiles(t, map[string]string{
+		"agc-project.yaml":   "name: demo\n",
+		"workflows/main.wdl": "workflow main {}\n",
+	})
+	directory := writeTestFiles(t, map[string]string{"README.md": "notes\n"})
+
+	require.NoError(t, Install(staged, directory))
+	assert.Equal(t, "name: demo\n", readTestFile(t, filepath.Join(directory, "agc-project.yaml")))
+	assert.Equal(t, "workflow main {}\n", readTestFile(t, filepath.Join(directory, "workflows", "main.wdl")))
+	assert.Equal(t, "notes\n", readTestFile(t, filepath.Join(directory, "README.md")))
+}
+
+func TestInstall_Conflicts(t *testing.T) {
+	staged := writeTestFiles(t, map[string]string{
+		"agc-project.yaml":   "name: demo\n",
+		"workflows/main.wdl": "workflow main {}\n",
+		"workflows/new.wdl":  "workflow new {}\n",
+	})
+	directory := writeTestFiles(t, map[string]string{
+		"workflows/main.wdl": "workflow existing {}\n",
+		"agc-project.yaml":   "name: existing\n",
+	})
+
+	err := Install(staged, directory)
+	assert.EqualError(t, err, "the template would overwrite existing files in '"+directory+"': agc-project.yaml, "+filepath.Join("workflows", "main.wdl"))
+	assert.NoFileExists(t, filepath.Join(directory, "workflows", "new.wdl"))
+	assert.Equal(t, "workflow existing {}\n", readTestFile(t, filepath.Join(directory, "workflows", "main.wdl")))
+}
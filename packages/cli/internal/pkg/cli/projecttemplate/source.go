@@ -0,0 +1,138 @@
+package projecttemplate
+
+import (
+	"fmt"
+	"io/fs"
+	"net/url"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+
+	"github.com/aws/amazon-genomics-cli/internal/pkg/aws/s3"
+	"github.com/aws/amazon-genomics-cli/internal/pkg/cli/clierror/actionableerror"
+	"github.com/rs/zerolog/log"
+)
+
+const gitDirectory = ".git"
+
+// gitSubdirectorySeparator separates a repository from a directory inside of it, as in 'https://host/repo.git//path'
+const gitSubdirectorySeparator = "//"
+
+var gitSchemes = map[string]bool{"http": true, "https": true, "ssh": true, "git": true}
+
+// These can be swapped out to use fake versions during tests
+var gitClone = func(repository, directory string) error {
+	output, err := exec.Command("git", "clone", "--depth", "1", "--quiet", repository, directory).CombinedOutput()
+	if err != nil {
+		return fmt.Errorf("unable to clone '%s': %w: %s", repository, err, strings.TrimSpace(string(output)))
+	}
+	return nil
+}
+var createTempDir = os.MkdirTemp
+
+// Fetcher copies a template from a built-in name, a local directory, a Git repository or an S3 prefix
+type Fetcher struct {
+	s3Client func() s3.Interface
+}
+
+// NewFetcher returns a fetcher that creates the S3 client only when a template is fetched from S3
+func NewFetcher(s3Client func() s3.Interface) *Fetcher {
+	return &Fetcher{s3Client: s3Client}
+}
+
+// Fetch copies the files of a template into a directory
+func (f *Fetcher) Fetch(source, directory string) error {
+	switch {
+	case s3.IsS3Uri(source):
+		return f.fetchS3(source, directory)
+	case isGitSource(source):
+		return fetchGit(source, directory)
+	case isLocalDirectory(source):
+		log.Debug().Msgf("Copying template directory '%s'", source)
+		return copyTree(os.DirFS(source), directory)
+	case IsBuiltin(source):
+		log.Debug().Msgf("Copying built-in template '%s'", source)
+		templateFS, err := builtinFS(source)
+		if err != nil {
+			return err
+		}
+		return copyTree(templateFS, directory)
+	}
+	return actionableerror.New(
+		fmt.Errorf("template '%s' is not a built-in template, a directory, a Git repository or an S3 prefix", source),
+		"Please list the built-in templates with 'agc project templates'")
+}
+
+func (f *Fetcher) fetchS3(source, directory string) error {
+	if err := s3.ValidateS3Uri(source); err != nil {
+		return err
+	}
+	sourceURL, err := url.Parse(source)
+	if err != nil {
+		return err
+	}
+	log.Debug().Msgf("Downloading template '%s'", source)
+	count, err := f.s3Client().DownloadPrefix(sourceURL.Host, strings.TrimPrefix(sourceURL.Path, "/"), directory)
+	if err != nil {
+		return err
+	}
+	if count == 0 {
+		return fmt.Errorf("no objects were found under '%s'", source)
+	}
+	return nil
+}
+
+func fetchGit(source, directory string) error {
+	repository, subdirectory := splitGitSource(source)
+	if subdirectory == "" {
+		subdirectory = "."
+	}
+	if !fs.ValidPath(subdirectory) {
+		return fmt.Errorf("'%s' is not a valid directory of a repository", subdirectory)
+	}
+	cloneDirectory, err := createTempDir("", "agc-template-*")
+	if err != nil {
+		return err
+	}
+	defer os.RemoveAll(cloneDirectory)
+	log.Debug().Msgf("Cloning template repository '%s'", repository)
+	if err := gitClone(repository, cloneDirectory); err != nil {
+		return err
+	}
+	templateDirectory := filepath.Join(cloneDirectory, filepath.FromSlash(subdirectory))
+	if !isLocalDirectory(templateDirectory) {
+		return fmt.Errorf("directory '%s' does not exist in '%s'", subdirectory, repository)
+	}
+	return copyTree(os.DirFS(templateDirectory), directory)
+}
+
+func isGitSource(source string) bool {
+	if strings.HasPrefix(source, "git@") {
+		return true
+	}
+	sourceURL, err := url.Parse(source)
+	if err != nil {
+		return false
+	}
+	return gitSchemes[strings.ToLower(sourceURL.Scheme)]
+}
+
+// splitGitSource splits a source into the repository to clone and the directory of the template in the repository
+func splitGitSource(source string) (string, string) {
+	searchFrom := 0
+	if schemeEnd := strings.Index(source, "://"); schemeEnd >= 0 {
+		searchFrom = schemeEnd + len("://")
+	}
+	separator := strings.Index(source[searchFrom:], gitSubdirectorySeparator)
+	if separator < 0 {
+		return source, ""
+	}
+	separator += searchFrom
+	return source[:separator], strings.Trim(source[separator+len(gitSubdirectorySeparator):], "/")
+}
+
+func isLocalDirectory(source string) bool {
+	info, err := os.Stat(source)
+	return err == nil && info.IsDir()
+}
@@ -0,0 +1,109 @@
+package projecttemplate
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/aws/amazon-genomics-cli/internal/pkg/aws/s3"
+	"github.com/aws/amazon-genomics-cli/internal/pkg/cli/clierror/actionableerror"
+	awsmocks "github.com/aws/amazon-genomics-cli/internal/pkg/mocks/aws"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func noS3Client(t *testing.T) func() s3.Interface {
+	return func() s3.Interface {
+		t.Fatal("the S3 client must not be created")
+		return nil
+	}
+}
+
+func TestFetcher_Fetch_Builtin(t *testing.T) {
+	directory := t.TempDir()
+
+	err := NewFetcher(noS3Client(t)).Fetch("demo-wdl-project", directory)
+	require.NoError(t, err)
+	assert.FileExists(t, filepath.Join(directory, ProjectFileName))
+	assert.FileExists(t, filepath.Join(directory, "workflows", "hello", "hello.wdl"))
+}
+
+func TestFetcher_Fetch_LocalDirectory(t *testing.T) {
+	source := writeTestFiles(t, map[string]string{
+		ProjectFileName:      "name: local\n",
+		"workflows/main.wdl": "workflow main {}\n",
+		".git/HEAD":          "ref: refs/heads/main\n",
+	})
+	directory := t.TempDir()
+
+	err := NewFetcher(noS3Client(t)).Fetch(source, directory)
+	require.NoError(t, err)
+	assert.Equal(t, "name: local\n", readTestFile(t, filepath.Join(directory, ProjectFileName)))
+	assert.FileExists(t, filepath.Join(directory, "workflows", "main.wdl"))
+	assert.NoDirExists(t, filepath.Join(directory, ".git"))
+}
+
+func TestFetcher_Fetch_S3(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	s3Client := awsmocks.NewMockS3Client(ctrl)
+	directory := t.TempDir()
+	fetcher := NewFetcher(func() s3.Interface { return s3Client })
+
+	s3Client.EXPECT().DownloadPrefix("templates-bucket", "teams/genomics", directory).Return(2, nil)
+	assert.NoError(t, fetcher.Fetch("s3://templates-bucket/teams/genomics", directory))
+
+	s3Client.EXPECT().DownloadPrefix("templates-bucket", "empty", directory).Return(0, nil)
+	assert.EqualError(t, fetcher.Fetch("s3://templates-bucket/empty", directory), "no objects were found under 's3://templates-bucket/empty'")
+
+	s3Client.EXPECT().DownloadPrefix("templates-bucket", "denied", directory).Return(0, errors.New("access denied"))
+	assert.EqualError(t, fetcher.Fetch("s3://templates-bucket/denied", directory), "access denied")
+}
+
+func TestFetcher_Fetch_Git(t *testing.T) {
+	origGitClone := gitClone
+	defer func() { gitClone = origGitClone }()
+	var clonedRepository string
+	gitClone = func(repository, directory string) error {
+		clonedRepository = repository
+		files := map[string]string{
+			"templates/wdl/" + ProjectFileName: "name: git\n",
+			".git/HEAD":                        "ref: refs/heads/main\n",
+		}
+		for name, content := range files {
+			path := filepath.Join(directory, name)
+			require.NoError(t, os.MkdirAll(filepath.Dir(path), 0755))
+			require.NoError(t, os.WriteFile(path, []byte(content), 0644))
+		}
+		return nil
+	}
+	fetcher := NewFetcher(noS3Client(t))
+
+	directory := t.TempDir()
+	err := fetcher.Fetch("https://example.com/org/templates.git//templates/wdl", directory)
+	require.NoError(t, err)
+	assert.Equal(t, "https://example.com/org/templates.git", clonedRepository)
+	assert.Equal(t, "name: git\n", readTestFile(t, filepath.Join(directory, ProjectFileName)))
+
+	directory = t.TempDir()
+	err = fetcher.Fetch("git@example.com:org/templates.git", directory)
+	require.NoError(t, err)
+	assert.Equal(t, "git@example.com:org/templates.git", clonedRepository)
+	assert.FileExists(t, filepath.Join(directory, "templates", "wdl", ProjectFileName))
+	assert.NoDirExists(t, filepath.Join(directory, ".git"))
+
+	err = fetcher.Fetch("https://example.com/org/templates.git//templates/cwl", t.TempDir())
+	assert.EqualError(t, err, "directory 'templates/cwl' does not exist in 'https://example.com/org/templates.git'")
+	err = fetcher.Fetch("https://example.com/org/templates.git//../outside", t.TempDir())
+	assert.EqualError(t, err, "'../outside' is not a valid directory of a repository")
+}
+
+func TestFetcher_Fetch_Unknown(t *testing.T) {
+	err := NewFetcher(noS3Client(t)).Fetch("missing-project", t.TempDir())
+
+	var actionableError *actionableerror.Error
+	require.True(t, errors.As(err, &actionableError))
+	assert.EqualError(t, actionableError.Cause, "template 'missing-project' is not a built-in template, a directory, a Git repository or an S3 prefix")
+}
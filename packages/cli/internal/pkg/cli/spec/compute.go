@@ -0,0 +1,76 @@
+package spec
+
+import (
+	"fmt"
+	"sort"
+)
+
+const (
+	requestSpotInstancesKey = "requestSpotInstances"
+	allocationStrategyKey   = "allocationStrategy"
+	scratchVolumeKey        = "scratchVolume"
+	minVCpusKey             = "minVCpus"
+	maxVCpusKey             = "maxVCpus"
+	enginesKey              = "engines"
+	engineKey               = "engine"
+
+	spotCapacityOptimized = "SPOT_CAPACITY_OPTIMIZED"
+)
+
+// efsEngines are the engines whose tasks use EFS, rather than the auto-scaled EBS scratch volume, as scratch space
+var efsEngines = map[string]bool{"miniwdl": true, "snakemake": true}
+
+// validateCompute checks the compute environment settings of the contexts that the project schema cannot express.
+// The returned errors are formatted like schema validation errors.
+func validateCompute(document interface{}) []string {
+	projectMap, ok := document.(map[string]interface{})
+	if !ok {
+		return nil
+	}
+	contexts, _ := projectMap[contextsKey].(map[string]interface{})
+	contextNames := make([]string, 0, len(contexts))
+	for contextName := range contexts {
+		contextNames = append(contextNames, contextName)
+	}
+	sort.Strings(contextNames)
+
+	var errors []string
+	for _, contextName := range contextNames {
+		context, ok := contexts[contextName].(map[string]interface{})
+		if !ok {
+			continue
+		}
+		path := fmt.Sprintf("%s.%s", contextsKey, contextName)
+		if context[allocationStrategyKey] == spotCapacityOptimized && context[requestSpotInstancesKey] != true {
+			errors = append(errors, fmt.Sprintf("%s.%s: %s requires %s", path, allocationStrategyKey, spotCapacityOptimized, requestSpotInstancesKey))
+		}
+		maxVCpus, hasMaxVCpus := context[maxVCpusKey].(int)
+		if !hasMaxVCpus {
+			maxVCpus = DefaultMaxVCpus
+		}
+		if minVCpus, ok := context[minVCpusKey].(int); ok && minVCpus > maxVCpus {
+			errors = append(errors, fmt.Sprintf("%s.%s: Must be less than or equal to %s (%d)", path, minVCpusKey, maxVCpusKey, maxVCpus))
+		}
+		if _, ok := context[scratchVolumeKey]; ok {
+			for _, engine := range contextEngines(context) {
+				if efsEngines[engine] {
+					errors = append(errors, fmt.Sprintf("%s.%s: the %s engine uses EFS as scratch space", path, scratchVolumeKey, engine))
+				}
+			}
+		}
+	}
+	return errors
+}
+
+func contextEngines(context map[string]interface{}) []string {
+	engineList, _ := context[enginesKey].([]interface{})
+	var engines []string
+	for _, item := range engineList {
+		if engineMap, ok := item.(map[string]interface{}); ok {
+			if engine, ok := engineMap[engineKey].(string); ok {
+				engines = append(engines, engine)
+			}
+		}
+	}
+	return engines
+}
@@ -1,7 +1,17 @@
 package spec
 
+import "strings"
+
 const DefaultMaxVCpus = 256
 
+// GpuInstanceFamilies are the accelerated computing instance families removed from a context when excludeGpuInstances is set
+var GpuInstanceFamilies = []string{"dl1", "f1", "g3", "g3s", "g4ad", "g4dn", "g5", "g5g", "inf1", "inf2", "p2", "p3", "p3dn", "p4d", "p4de", "p5", "trn1", "trn1n", "vt1"}
+
+const (
+	SecretSourceParameterStore = "parameterStore"
+	SecretSourceSecretsManager = "secretsManager"
+)
+
 type FSConfig struct {
 	FSProvisionedThroughput int `yaml:"provisionedThroughput"`
 }
@@ -14,12 +24,97 @@ type Engine struct {
 	Engine     string     `yaml:"engine"`
 	Filesystem Filesystem `yaml:"filesystem,omitempty"`
 }
+type Volume struct {
+	Size       int    `yaml:"size,omitempty"`
+	VolumeType string `yaml:"volumeType,omitempty"`
+}
+
+// Budget limits the month-to-date spend, in USD, of the resources tagged with a context
+type Budget struct {
+	MonthlyLimit  float64 `yaml:"monthlyLimit"`
+	WarnThreshold float64 `yaml:"warnThreshold,omitempty"`
+}
+
+// DefaultBudgetWarnThreshold is the percentage of the monthly limit past which runs are warned about the spend of a context
+const DefaultBudgetWarnThreshold = 80
+
+// IsSet returns true when the budget has a monthly limit
+func (budget Budget) IsSet() bool {
+	return budget.MonthlyLimit > 0
+}
+
+// GetWarnAmount returns the spend past which runs are warned about, defaulting to 80% of the monthly limit
+func (budget Budget) GetWarnAmount() float64 {
+	warnThreshold := budget.WarnThreshold
+	if warnThreshold == 0 {
+		warnThreshold = DefaultBudgetWarnThreshold
+	}
+	return budget.MonthlyLimit * warnThreshold / 100
+}
+
+type Secret struct {
+	Name      string `yaml:"name" json:"name"`
+	ValueFrom string `yaml:"valueFrom" json:"valueFrom"`
+	Source    string `yaml:"source,omitempty" json:"source"`
+}
+
+// GetSource returns the store the secret is read from, defaulting to SSM Parameter Store
+func (secret Secret) GetSource() string {
+	if secret.Source == "" {
+		return SecretSourceParameterStore
+	}
+	return secret.Source
+}
+
 type Context struct {
-	InstanceTypes        []string `yaml:"instanceTypes,omitempty"`
-	RequestSpotInstances bool     `yaml:"requestSpotInstances,omitempty"`
-	MaxVCpus             int      `yaml:"maxVCpus,omitempty"`
-	UsePublicSubnets     bool     `yaml:"usePublicSubnets,omitempty"`
-	Engines              []Engine `yaml:"engines"`
+	Extends                 string            `yaml:"extends,omitempty"`
+	Shared                  bool              `yaml:"shared,omitempty"`
+	Region                  string            `yaml:"region,omitempty"`
+	AwsProfile              string            `yaml:"awsProfile,omitempty"`
+	RoleArn                 string            `yaml:"roleArn,omitempty"`
+	Tags                    map[string]string `yaml:"tags,omitempty"`
+	Budget                  Budget            `yaml:"budget,omitempty"`
+	InstanceTypes           []string          `yaml:"instanceTypes,omitempty"`
+	ExcludeInstanceFamilies []string          `yaml:"excludeInstanceFamilies,omitempty"`
+	ExcludeGpuInstances     bool              `yaml:"excludeGpuInstances,omitempty"`
+	RequestSpotInstances    bool              `yaml:"requestSpotInstances,omitempty"`
+	SpotBidPercentage       int               `yaml:"spotBidPercentage,omitempty"`
+	AllocationStrategy      string            `yaml:"allocationStrategy,omitempty"`
+	MinVCpus                int               `yaml:"minVCpus,omitempty"`
+	MaxVCpus                int               `yaml:"maxVCpus,omitempty"`
+	RootVolume              Volume            `yaml:"rootVolume,omitempty"`
+	ScratchVolume           Volume            `yaml:"scratchVolume,omitempty"`
+	AmiId                   string            `yaml:"amiId,omitempty"`
+	UsePublicSubnets        bool              `yaml:"usePublicSubnets,omitempty"`
+	Environment             map[string]string `yaml:"environment,omitempty"`
+	Secrets                 []Secret          `yaml:"secrets,omitempty"`
+	Engines                 []Engine          `yaml:"engines,omitempty"`
+}
+
+// GetExcludedInstanceFamilies returns the instance families that must not be used by the context's compute environment
+func (context Context) GetExcludedInstanceFamilies() []string {
+	excludedFamilies := append([]string{}, context.ExcludeInstanceFamilies...)
+	if context.ExcludeGpuInstances {
+		excludedFamilies = append(excludedFamilies, GpuInstanceFamilies...)
+	}
+	return excludedFamilies
+}
+
+// GetInstanceTypes returns the context instance types with any excluded instance families removed
+func (context Context) GetInstanceTypes() []string {
+	excludedFamilies := make(map[string]bool)
+	for _, family := range context.GetExcludedInstanceFamilies() {
+		excludedFamilies[strings.ToLower(family)] = true
+	}
+
+	var instanceTypes []string
+	for _, instanceType := range context.InstanceTypes {
+		family := strings.ToLower(strings.SplitN(instanceType, ".", 2)[0])
+		if !excludedFamilies[family] {
+			instanceTypes = append(instanceTypes, instanceType)
+		}
+	}
+	return instanceTypes
 }
 
 func (context *Context) UnmarshalYAML(unmarshal func(interface{}) error) error {
@@ -0,0 +1,51 @@
+package spec
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestContext_GetInstanceTypes(t *testing.T) {
+	tests := map[string]struct {
+		context  Context
+		expected []string
+	}{
+		"no exclusions": {
+			context:  Context{InstanceTypes: []string{"c5", "p3.2xlarge"}},
+			expected: []string{"c5", "p3.2xlarge"},
+		},
+		"excluded families": {
+			context:  Context{InstanceTypes: []string{"c5", "m5.large", "r5"}, ExcludeInstanceFamilies: []string{"m5"}},
+			expected: []string{"c5", "r5"},
+		},
+		"exclude gpu instances": {
+			context:  Context{InstanceTypes: []string{"c5", "p3.2xlarge", "G4dn.xlarge"}, ExcludeGpuInstances: true},
+			expected: []string{"c5"},
+		},
+		"no instance types": {
+			context:  Context{ExcludeGpuInstances: true},
+			expected: nil,
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, tt.context.GetInstanceTypes())
+		})
+	}
+}
+
+func TestContext_GetExcludedInstanceFamilies(t *testing.T) {
+	context := Context{ExcludeInstanceFamilies: []string{"m5"}, ExcludeGpuInstances: true}
+
+	assert.Equal(t, append([]string{"m5"}, GpuInstanceFamilies...), context.GetExcludedInstanceFamilies())
+	assert.Empty(t, Context{}.GetExcludedInstanceFamilies())
+}
+
+func TestBudget_GetWarnAmount(t *testing.T) {
+	assert.Equal(t, float64(400), Budget{MonthlyLimit: 500}.GetWarnAmount())
+	assert.Equal(t, float64(250), Budget{MonthlyLimit: 500, WarnThreshold: 50}.GetWarnAmount())
+	assert.True(t, Budget{MonthlyLimit: 500}.IsSet())
+	assert.False(t, Budget{}.IsSet())
+}
@@ -1,6 +1,171 @@
 package spec
 
+import (
+	"fmt"
+	"net/url"
+	"sort"
+	"strings"
+)
+
+// Data is an S3 location that the contexts of the project are granted access to. A location ending with '/' or '/*',
+// such as 's3://bucket/cohortA/', only grants access to the objects under that prefix, and any other location with a
+// key only grants access to that object.
 type Data struct {
-	Location string `yaml:"location"`
-	ReadOnly bool   `yaml:"readOnly,omitempty"`
+	Location      string `yaml:"location"`
+	ReadOnly      bool   `yaml:"readOnly,omitempty"`
+	RequesterPays bool   `yaml:"requesterPays,omitempty"`
+	KmsKeyArn     string `yaml:"kmsKeyArn,omitempty"`
+	BucketOwner   string `yaml:"bucketOwner,omitempty"`
+}
+
+const (
+	bucketOwnerKey   = "bucketOwner"
+	requesterPaysKey = "requesterPays"
+	s3ArnPrefix      = "arn:aws:s3:::"
+	s3Scheme         = "s3"
+	anyKeyPattern    = "*"
+)
+
+// requesterPaysEngines are the engines that send the requester pays header when reading and writing S3 objects
+var requesterPaysEngines = map[string]bool{"nextflow": true}
+
+// BucketName returns the name of the bucket of the data location
+func (d Data) BucketName() string {
+	locationUrl, err := url.Parse(d.Location)
+	if err != nil {
+		return ""
+	}
+	return locationUrl.Host
+}
+
+// KeyPattern returns the pattern of the object keys that access is limited to: '*' for the whole bucket, the prefix
+// followed by '*' for a prefix, or the key of a single object
+func (d Data) KeyPattern() string {
+	locationUrl, err := url.Parse(d.Location)
+	if err != nil {
+		return anyKeyPattern
+	}
+	key := strings.TrimSuffix(strings.TrimPrefix(locationUrl.Path, "/"), anyKeyPattern)
+	if key == "" || key == "/" {
+		return anyKeyPattern
+	}
+	if strings.HasSuffix(key, "/") {
+		return key + anyKeyPattern
+	}
+	return key
+}
+
+// Covers returns true when the object at the S3 URI is within the data location
+func (d Data) Covers(uri string) bool {
+	uriUrl, err := url.Parse(uri)
+	if err != nil || uriUrl.Scheme != s3Scheme || uriUrl.Host != d.BucketName() {
+		return false
+	}
+	key := strings.TrimPrefix(uriUrl.Path, "/")
+	keyPattern := d.KeyPattern()
+	if strings.HasSuffix(keyPattern, anyKeyPattern) {
+		return strings.HasPrefix(key, strings.TrimSuffix(keyPattern, anyKeyPattern))
+	}
+	return key == keyPattern
+}
+
+// ValidateWildcard returns an error when the location uses a wildcard other than at the end of a prefix
+func (d Data) ValidateWildcard() error {
+	if strings.Contains(strings.TrimSuffix(d.Location, "/"+anyKeyPattern), anyKeyPattern) {
+		return fmt.Errorf("'%s' can only end with a wildcard, as in 's3://bucket/prefix/*'", d.Location)
+	}
+	return nil
+}
+
+// BucketArn returns the ARN of the bucket of the data location
+func (d Data) BucketArn() string {
+	return s3ArnPrefix + d.BucketName()
+}
+
+// ObjectsArn returns the ARN of the objects of the data location
+func (d Data) ObjectsArn() string {
+	return d.BucketArn() + "/" + d.KeyPattern()
+}
+
+// AccessArn returns the ARN that access is granted on, which is the bucket or the objects of the location
+func (d Data) AccessArn() string {
+	if d.KeyPattern() == anyKeyPattern {
+		return d.BucketArn()
+	}
+	return d.ObjectsArn()
+}
+
+// validateData checks that the data locations of a bucket agree on its owner and on whether the requester pays, and
+// that requester pays data is only used by contexts whose engines support it.
+// The returned errors are formatted like schema validation errors.
+func validateData(document interface{}) []string {
+	projectMap, ok := document.(map[string]interface{})
+	if !ok {
+		return nil
+	}
+	dataList, _ := projectMap[dataKey].([]interface{})
+	firstIndexes := make(map[string]int)
+	var errors []string
+	for i, item := range dataList {
+		dataMap, ok := item.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		location, _ := dataMap[locationKey].(string)
+		bucketName := Data{Location: location}.BucketName()
+		first, seen := firstIndexes[bucketName]
+		if !seen {
+			firstIndexes[bucketName] = i
+			continue
+		}
+		firstMap := dataList[first].(map[string]interface{})
+		for _, key := range []string{bucketOwnerKey, requesterPaysKey} {
+			if !sameDataValue(dataMap[key], firstMap[key]) {
+				errors = append(errors, fmt.Sprintf("%s.%d.%s: bucket '%s' must have the same %s as in %s.%d", dataKey, i, key, bucketName, key, dataKey, first))
+			}
+		}
+	}
+	return append(errors, validateRequesterPays(projectMap, dataList)...)
+}
+
+// validateRequesterPays reports the contexts with engines that cannot read requester pays buckets, since the
+// project data is available to every context
+func validateRequesterPays(projectMap map[string]interface{}, dataList []interface{}) []string {
+	contexts, _ := projectMap[contextsKey].(map[string]interface{})
+	contextNames := make([]string, 0, len(contexts))
+	for contextName := range contexts {
+		contextNames = append(contextNames, contextName)
+	}
+	sort.Strings(contextNames)
+
+	var errors []string
+	for i, item := range dataList {
+		dataMap, ok := item.(map[string]interface{})
+		if !ok || dataMap[requesterPaysKey] != true {
+			continue
+		}
+		for _, contextName := range contextNames {
+			context, ok := contexts[contextName].(map[string]interface{})
+			if !ok {
+				continue
+			}
+			for _, engine := range contextEngines(context) {
+				if !requesterPaysEngines[engine] {
+					errors = append(errors, fmt.Sprintf("%s.%d.%s: the %s engine of context '%s' cannot read requester pays buckets", dataKey, i, requesterPaysKey, engine, contextName))
+				}
+			}
+		}
+	}
+	return errors
+}
+
+// sameDataValue compares the values of a key in two data locations, an unset value being equal to false
+func sameDataValue(value, other interface{}) bool {
+	if value == false {
+		value = nil
+	}
+	if other == false {
+		other = nil
+	}
+	return value == other
 }
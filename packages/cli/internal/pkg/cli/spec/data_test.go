@@ -0,0 +1,91 @@
+package spec
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestData_AccessArn(t *testing.T) {
+	testCases := map[string]struct {
+		location           string
+		expectedBucket     string
+		expectedKeyPattern string
+		expectedAccessArn  string
+		expectedObjectsArn string
+	}{
+		"bucket": {
+			location:           "s3://reference-bucket",
+			expectedBucket:     "reference-bucket",
+			expectedKeyPattern: "*",
+			expectedAccessArn:  "arn:aws:s3:::reference-bucket",
+			expectedObjectsArn: "arn:aws:s3:::reference-bucket/*",
+		},
+		"bucket wildcard": {
+			location:           "s3://reference-bucket/*",
+			expectedBucket:     "reference-bucket",
+			expectedKeyPattern: "*",
+			expectedAccessArn:  "arn:aws:s3:::reference-bucket",
+			expectedObjectsArn: "arn:aws:s3:::reference-bucket/*",
+		},
+		"prefix": {
+			location:           "s3://cohort-bucket/cohortA/",
+			expectedBucket:     "cohort-bucket",
+			expectedKeyPattern: "cohortA/*",
+			expectedAccessArn:  "arn:aws:s3:::cohort-bucket/cohortA/*",
+			expectedObjectsArn: "arn:aws:s3:::cohort-bucket/cohortA/*",
+		},
+		"prefix wildcard": {
+			location:           "s3://cohort-bucket/cohorts/A/*",
+			expectedBucket:     "cohort-bucket",
+			expectedKeyPattern: "cohorts/A/*",
+			expectedAccessArn:  "arn:aws:s3:::cohort-bucket/cohorts/A/*",
+			expectedObjectsArn: "arn:aws:s3:::cohort-bucket/cohorts/A/*",
+		},
+		"object": {
+			location:           "s3://cohort-bucket/cohortA/sample.bam",
+			expectedBucket:     "cohort-bucket",
+			expectedKeyPattern: "cohortA/sample.bam",
+			expectedAccessArn:  "arn:aws:s3:::cohort-bucket/cohortA/sample.bam",
+			expectedObjectsArn: "arn:aws:s3:::cohort-bucket/cohortA/sample.bam",
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			data := Data{Location: tc.location}
+			assert.Equal(t, tc.expectedBucket, data.BucketName())
+			assert.Equal(t, tc.expectedKeyPattern, data.KeyPattern())
+			assert.Equal(t, tc.expectedAccessArn, data.AccessArn())
+			assert.Equal(t, tc.expectedObjectsArn, data.ObjectsArn())
+		})
+	}
+}
+
+func TestData_ValidateWildcard(t *testing.T) {
+	assert.NoError(t, Data{Location: "s3://cohort-bucket/cohortA/*"}.ValidateWildcard())
+	assert.NoError(t, Data{Location: "s3://cohort-bucket/cohortA/"}.ValidateWildcard())
+	assert.EqualError(t, Data{Location: "s3://cohort-bucket/cohort*/reads"}.ValidateWildcard(),
+		"'s3://cohort-bucket/cohort*/reads' can only end with a wildcard, as in 's3://bucket/prefix/*'")
+}
+
+func TestData_Covers(t *testing.T) {
+	testCases := map[string]struct {
+		location string
+		uri      string
+		expected bool
+	}{
+		"bucket":             {location: "s3://reference-bucket", uri: "s3://reference-bucket/hg38/genome.fa", expected: true},
+		"other bucket":       {location: "s3://reference-bucket", uri: "s3://reference-bucket-2/hg38/genome.fa", expected: false},
+		"prefix":             {location: "s3://cohort-bucket/cohortA/", uri: "s3://cohort-bucket/cohortA/sample.bam", expected: true},
+		"prefix wildcard":    {location: "s3://cohort-bucket/cohortA/*", uri: "s3://cohort-bucket/cohortA/2021/sample.bam", expected: true},
+		"other prefix":       {location: "s3://cohort-bucket/cohortA/", uri: "s3://cohort-bucket/cohortAB/sample.bam", expected: false},
+		"object":             {location: "s3://cohort-bucket/cohortA/sample.bam", uri: "s3://cohort-bucket/cohortA/sample.bam", expected: true},
+		"other object":       {location: "s3://cohort-bucket/cohortA/sample.bam", uri: "s3://cohort-bucket/cohortA/sample.bam.bai", expected: false},
+		"not an S3 location": {location: "s3://cohort-bucket", uri: "https://cohort-bucket/sample.bam", expected: false},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			assert.Equal(t, tc.expected, Data{Location: tc.location}.Covers(tc.uri))
+		})
+	}
+}
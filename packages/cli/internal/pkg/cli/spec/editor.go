@@ -0,0 +1,239 @@
+package spec
+
+import (
+	"fmt"
+
+	"gopkg.in/yaml.v3"
+)
+
+const (
+	nameKey      = "name"
+	workflowsKey = "workflows"
+	dataKey      = "data"
+	locationKey  = "location"
+)
+
+// Editor changes a project specification through its yaml nodes so that the comments, key order and formatting of
+// the parts that are not changed are kept
+type Editor struct {
+	document yaml.Node
+	indent   int
+}
+
+// NewEditor returns an editor of a project specification. Empty bytes start an empty specification.
+func NewEditor(yamlBytes []byte) (*Editor, error) {
+	editor := &Editor{indent: detectIndent(yamlBytes)}
+	if err := yaml.Unmarshal(yamlBytes, &editor.document); err != nil {
+		return nil, err
+	}
+	if editor.document.Kind == 0 {
+		editor.document = yaml.Node{Kind: yaml.DocumentNode, Content: []*yaml.Node{{Kind: yaml.MappingNode, Tag: "!!map"}}}
+	}
+	if editor.root().Kind != yaml.MappingNode {
+		return nil, fmt.Errorf("the project specification must be a mapping")
+	}
+	return editor, nil
+}
+
+// Bytes returns the edited project specification
+func (e *Editor) Bytes() ([]byte, error) {
+	return encodeDocument(&e.document, e.indent)
+}
+
+// Update replaces the values of the specification with those of a project. Values that are equal keep their comments
+// and position, new keys are appended and keys that the project does not set are removed.
+func (e *Editor) Update(projectSpec Project) error {
+	var updated yaml.Node
+	if err := updated.Encode(projectSpec); err != nil {
+		return err
+	}
+	mergeNode(e.root(), &updated)
+	return nil
+}
+
+// SetName sets the name of the project
+func (e *Editor) SetName(name string) {
+	if _, value := childNode(e.root(), nameKey); value != nil {
+		value.Kind, value.Tag, value.Style, value.Value = yaml.ScalarNode, "!!str", 0, name
+		return
+	}
+	root := e.root()
+	root.Content = append([]*yaml.Node{stringNode(nameKey), stringNode(name)}, root.Content...)
+}
+
+// AddWorkflow adds a workflow to the workflows of the project
+func (e *Editor) AddWorkflow(name string, workflow Workflow) error {
+	return e.addMappingEntry(workflowsKey, "workflow", name, workflow)
+}
+
+// AddContext adds a context to the contexts of the project
+func (e *Editor) AddContext(name string, context Context) error {
+	return e.addMappingEntry(contextsKey, "context", name, context)
+}
+
+// AddData adds a data location to the project
+func (e *Editor) AddData(data Data) error {
+	if e.dataIndex(data.Location) >= 0 {
+		return fmt.Errorf("data location '%s' already exists in the project", data.Location)
+	}
+	var value yaml.Node
+	if err := value.Encode(data); err != nil {
+		return err
+	}
+	section, err := e.section(dataKey, yaml.SequenceNode)
+	if err != nil {
+		return err
+	}
+	section.Content = append(section.Content, &value)
+	return nil
+}
+
+// RemoveWorkflow removes a workflow from the project
+func (e *Editor) RemoveWorkflow(name string) error {
+	return e.removeMappingEntry(workflowsKey, "workflow", name)
+}
+
+// RemoveContext removes a context from the project
+func (e *Editor) RemoveContext(name string) error {
+	return e.removeMappingEntry(contextsKey, "context", name)
+}
+
+// RemoveData removes a data location from the project
+func (e *Editor) RemoveData(location string) error {
+	index := e.dataIndex(location)
+	if index < 0 {
+		return fmt.Errorf("data location '%s' does not exist in the project", location)
+	}
+	_, section := childNode(e.root(), dataKey)
+	section.Content = append(section.Content[:index], section.Content[index+1:]...)
+	if len(section.Content) == 0 {
+		removeMappingKey(e.root(), dataKey)
+	}
+	return nil
+}
+
+func (e *Editor) root() *yaml.Node {
+	return e.document.Content[0]
+}
+
+func (e *Editor) addMappingEntry(sectionKey, kind, name string, value interface{}) error {
+	if _, section := childNode(e.root(), sectionKey); section != nil {
+		if key, _ := childNode(section, name); key != nil {
+			return fmt.Errorf("%s '%s' already exists in the project", kind, name)
+		}
+	}
+	var valueNode yaml.Node
+	if err := valueNode.Encode(value); err != nil {
+		return err
+	}
+	section, err := e.section(sectionKey, yaml.MappingNode)
+	if err != nil {
+		return err
+	}
+	section.Content = append(section.Content, stringNode(name), &valueNode)
+	return nil
+}
+
+func (e *Editor) removeMappingEntry(sectionKey, kind, name string) error {
+	_, section := childNode(e.root(), sectionKey)
+	if section == nil || !removeMappingKey(section, name) {
+		return fmt.Errorf("%s '%s' does not exist in the project", kind, name)
+	}
+	if len(section.Content) == 0 {
+		removeMappingKey(e.root(), sectionKey)
+	}
+	return nil
+}
+
+// section returns the value of a top level key, adding the key with an empty value of the kind when it is missing or
+// empty. Sections included from other files cannot be edited.
+func (e *Editor) section(key string, kind yaml.Kind) (*yaml.Node, error) {
+	_, value := childNode(e.root(), key)
+	if value != nil && value.Kind == kind {
+		return value, nil
+	}
+	if value != nil && value.Tag == includeTag {
+		return nil, fmt.Errorf("'%s' is included from '%s', edit that file instead", key, value.Value)
+	}
+	section := &yaml.Node{Kind: kind}
+	if kind == yaml.SequenceNode {
+		section.Tag = "!!seq"
+	} else {
+		section.Tag = "!!map"
+	}
+	if value != nil {
+		*value = *section
+		return value, nil
+	}
+	root := e.root()
+	root.Content = append(root.Content, stringNode(key), section)
+	return section, nil
+}
+
+func (e *Editor) dataIndex(location string) int {
+	_, section := childNode(e.root(), dataKey)
+	if section == nil || section.Kind != yaml.SequenceNode {
+		return -1
+	}
+	for i, item := range section.Content {
+		if _, value := childNode(item, locationKey); value != nil && value.Value == location {
+			return i
+		}
+	}
+	return -1
+}
+
+func removeMappingKey(mapping *yaml.Node, key string) bool {
+	for i := 0; i+1 < len(mapping.Content); i += 2 {
+		if mapping.Content[i].Value == key {
+			mapping.Content = append(mapping.Content[:i], mapping.Content[i+2:]...)
+			return true
+		}
+	}
+	return false
+}
+
+func stringNode(value string) *yaml.Node {
+	return &yaml.Node{Kind: yaml.ScalarNode, Tag: "!!str", Value: value}
+}
+
+// mergeNode changes a node into another while keeping the comments and style of the parts that exist in both
+func mergeNode(node, updated *yaml.Node) {
+	if node.Kind != updated.Kind {
+		headComment, lineComment, footComment := node.HeadComment, node.LineComment, node.FootComment
+		*node = *updated
+		node.HeadComment, node.LineComment, node.FootComment = headComment, lineComment, footComment
+		return
+	}
+	switch node.Kind {
+	case yaml.MappingNode:
+		merged := make([]*yaml.Node, 0, len(updated.Content))
+		for i := 0; i+1 < len(node.Content); i += 2 {
+			if _, value := childNode(updated, node.Content[i].Value); value != nil {
+				mergeNode(node.Content[i+1], value)
+				merged = append(merged, node.Content[i], node.Content[i+1])
+			}
+		}
+		for i := 0; i+1 < len(updated.Content); i += 2 {
+			if key, _ := childNode(node, updated.Content[i].Value); key == nil {
+				merged = append(merged, updated.Content[i], updated.Content[i+1])
+			}
+		}
+		node.Content = merged
+	case yaml.SequenceNode:
+		for i, item := range updated.Content {
+			if i < len(node.Content) {
+				mergeNode(node.Content[i], item)
+			} else {
+				node.Content = append(node.Content, item)
+			}
+		}
+		if len(node.Content) > len(updated.Content) {
+			node.Content = node.Content[:len(updated.Content)]
+		}
+	case yaml.ScalarNode:
+		if node.Value != updated.Value {
+			node.Value, node.Tag, node.Style = updated.Value, updated.Tag, updated.Style
+		}
+	}
+}
@@ -0,0 +1,181 @@
+package spec
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const editorTestYaml = `# Project of the genomics team
+name: demo
+schemaVersion: 1
+workflows:
+  # says hello
+  hello:
+    type:
+      language: wdl
+      version: 1.0
+    sourceURL: workflows/hello
+data:
+  - location: s3://bucket/reference # shared reference data
+    readOnly: true
+contexts:
+  ctx1:
+    engines:
+      - type: wdl
+        engine: cromwell
+`
+
+func editedYaml(t *testing.T, yamlText string, edit func(editor *Editor) error) string {
+	editor, err := NewEditor([]byte(yamlText))
+	require.NoError(t, err)
+	require.NoError(t, edit(editor))
+	editedBytes, err := editor.Bytes()
+	require.NoError(t, err)
+	return string(editedBytes)
+}
+
+func TestEditor_AddWorkflow(t *testing.T) {
+	edited := editedYaml(t, editorTestYaml, func(editor *Editor) error {
+		return editor.AddWorkflow("goodbye", Workflow{Type: WorkflowType{Language: "nextflow", Version: "dsl2"}, SourceURL: "workflows/goodbye"})
+	})
+
+	assert.Contains(t, edited, `    sourceURL: workflows/hello
+  goodbye:
+    type:
+      language: nextflow
+      version: dsl2
+    sourceURL: workflows/goodbye
+data:
+  - location: s3://bucket/reference # shared reference data
+`)
+	assert.Contains(t, edited, "# Project of the genomics team\n")
+	assert.Contains(t, edited, "  # says hello\n")
+}
+
+func TestEditor_SetName(t *testing.T) {
+	edited := editedYaml(t, editorTestYaml, func(editor *Editor) error {
+		editor.SetName("renamed")
+		return nil
+	})
+	assert.Contains(t, edited, "# Project of the genomics team\nname: renamed\nschemaVersion: 1\n")
+
+	edited = editedYaml(t, "schemaVersion: 1\n", func(editor *Editor) error {
+		editor.SetName("named")
+		return nil
+	})
+	assert.Equal(t, "name: named\nschemaVersion: 1\n", edited)
+}
+
+func TestEditor_AddContext(t *testing.T) {
+	edited := editedYaml(t, editorTestYaml, func(editor *Editor) error {
+		return editor.AddContext("spot", Context{RequestSpotInstances: true, Engines: []Engine{{Type: "wdl", Engine: "cromwell"}}})
+	})
+
+	assert.Equal(t, editorTestYaml+`  spot:
+    requestSpotInstances: true
+    engines:
+      - type: wdl
+        engine: cromwell
+`, edited)
+}
+
+func TestEditor_AddData_NewSection(t *testing.T) {
+	edited := editedYaml(t, "name: demo # the name\nschemaVersion: 1\n", func(editor *Editor) error {
+		return editor.AddData(Data{Location: "s3://bucket/reads", ReadOnly: true})
+	})
+
+	assert.Equal(t, "name: demo # the name\nschemaVersion: 1\ndata:\n  - location: s3://bucket/reads\n    readOnly: true\n", edited)
+}
+
+func TestEditor_Add_Exists(t *testing.T) {
+	editor, err := NewEditor([]byte(editorTestYaml))
+	require.NoError(t, err)
+
+	assert.EqualError(t, editor.AddWorkflow("hello", Workflow{}), "workflow 'hello' already exists in the project")
+	assert.EqualError(t, editor.AddContext("ctx1", Context{}), "context 'ctx1' already exists in the project")
+	assert.EqualError(t, editor.AddData(Data{Location: "s3://bucket/reference"}), "data location 's3://bucket/reference' already exists in the project")
+}
+
+func TestEditor_Remove(t *testing.T) {
+	edited := editedYaml(t, editorTestYaml, func(editor *Editor) error {
+		if err := editor.RemoveWorkflow("hello"); err != nil {
+			return err
+		}
+		return editor.RemoveData("s3://bucket/reference")
+	})
+
+	assert.Equal(t, `# Project of the genomics team
+name: demo
+schemaVersion: 1
+contexts:
+  ctx1:
+    engines:
+      - type: wdl
+        engine: cromwell
+`, edited)
+}
+
+func TestEditor_Remove_Missing(t *testing.T) {
+	editor, err := NewEditor([]byte(editorTestYaml))
+	require.NoError(t, err)
+
+	assert.EqualError(t, editor.RemoveWorkflow("goodbye"), "workflow 'goodbye' does not exist in the project")
+	assert.EqualError(t, editor.RemoveContext("ctx2"), "context 'ctx2' does not exist in the project")
+	assert.EqualError(t, editor.RemoveData("s3://bucket/reads"), "data location 's3://bucket/reads' does not exist in the project")
+}
+
+func TestEditor_Update(t *testing.T) {
+	projectSpec := Project{
+		Name:          "demo",
+		SchemaVersion: 1,
+		Workflows: map[string]Workflow{
+			"hello": {Type: WorkflowType{Language: "wdl", Version: "1.0"}, SourceURL: "workflows/hello-v2"},
+		},
+		Contexts: map[string]Context{
+			"ctx1": {Engines: []Engine{{Type: "wdl", Engine: "cromwell"}}},
+		},
+	}
+
+	edited := editedYaml(t, editorTestYaml, func(editor *Editor) error {
+		return editor.Update(projectSpec)
+	})
+
+	assert.Equal(t, `# Project of the genomics team
+name: demo
+schemaVersion: 1
+workflows:
+  # says hello
+  hello:
+    type:
+      language: wdl
+      version: 1.0
+    sourceURL: workflows/hello-v2
+contexts:
+  ctx1:
+    engines:
+      - type: wdl
+        engine: cromwell
+`, edited)
+}
+
+func TestEditor_EmptyDocument(t *testing.T) {
+	edited := editedYaml(t, "", func(editor *Editor) error {
+		return editor.Update(Project{Name: "demo", SchemaVersion: 1})
+	})
+
+	assert.Equal(t, "name: demo\nschemaVersion: 1\n", edited)
+}
+
+func TestNewEditor_NotMapping(t *testing.T) {
+	_, err := NewEditor([]byte("- a\n- b\n"))
+	assert.EqualError(t, err, "the project specification must be a mapping")
+}
+
+func TestEditor_IncludedSection(t *testing.T) {
+	editor, err := NewEditor([]byte("name: demo\ncontexts: !include contexts.yaml\n"))
+	require.NoError(t, err)
+
+	assert.EqualError(t, editor.AddContext("ctx2", Context{}), "'contexts' is included from 'contexts.yaml', edit that file instead")
+}
@@ -0,0 +1,157 @@
+package spec
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+)
+
+const (
+	contextsKey        = "contexts"
+	contextDefaultsKey = "contextDefaults"
+	extendsKey         = "extends"
+)
+
+// resolveContextInheritance merges the project contextDefaults and the contexts named by 'extends' into every context
+// of a converted yaml document. Maps are merged key by key while lists and scalars of a child replace those of its parent.
+// The returned errors are formatted like schema validation errors.
+func resolveContextInheritance(document interface{}) (interface{}, []string) {
+	projectMap, ok := document.(map[string]interface{})
+	if !ok {
+		return document, nil
+	}
+	contexts, ok := projectMap[contextsKey].(map[string]interface{})
+	if !ok {
+		return document, nil
+	}
+	defaults, _ := projectMap[contextDefaultsKey].(map[string]interface{})
+
+	resolver := contextResolver{
+		contexts: contexts,
+		resolved: make(map[string]map[string]interface{}),
+		visiting: make(map[string]bool),
+	}
+	contextNames := make([]string, 0, len(contexts))
+	for contextName := range contexts {
+		contextNames = append(contextNames, contextName)
+	}
+	sort.Strings(contextNames)
+
+	resolvedContexts := make(map[string]interface{})
+	var resolveErrors []string
+	for _, contextName := range contextNames {
+		resolvedContext, err := resolver.resolve(contextName)
+		var cycleErr *cycleError
+		if errors.As(err, &cycleErr) {
+			resolveErrors = append(resolveErrors, fmt.Sprintf("%s.%s.%s: %s", contextsKey, contextName, extendsKey, cycleErr))
+			continue
+		}
+		if err != nil {
+			resolveErrors = append(resolveErrors, err.Error())
+			continue
+		}
+		if resolvedContext == nil {
+			resolvedContexts[contextName] = contexts[contextName]
+			continue
+		}
+		resolvedContexts[contextName] = mergeMaps(defaults, resolvedContext)
+	}
+	if len(resolveErrors) > 0 {
+		return document, resolveErrors
+	}
+
+	resolvedProject := make(map[string]interface{})
+	for key, value := range projectMap {
+		resolvedProject[key] = value
+	}
+	resolvedProject[contextsKey] = resolvedContexts
+	return resolvedProject, nil
+}
+
+type contextResolver struct {
+	contexts map[string]interface{}
+	resolved map[string]map[string]interface{}
+	visiting map[string]bool
+}
+
+// cycleError is returned while unwinding an inheritance cycle. It is closed once the context that started the cycle is
+// reached, so that contexts extending a member of the cycle are not reported as members themselves.
+type cycleError struct {
+	origin string
+	closed bool
+}
+
+func (e *cycleError) Error() string {
+	return "Inheritance cycle detected"
+}
+
+func (r *contextResolver) resolve(contextName string) (map[string]interface{}, error) {
+	if resolvedContext, ok := r.resolved[contextName]; ok {
+		return resolvedContext, nil
+	}
+	context, ok := r.contexts[contextName].(map[string]interface{})
+	if !ok {
+		// Not an object, leave it for the schema validation to report
+		return nil, nil
+	}
+	parentValue, hasParent := context[extendsKey]
+	if !hasParent {
+		r.resolved[contextName] = context
+		return context, nil
+	}
+
+	fieldName := fmt.Sprintf("%s.%s.%s", contextsKey, contextName, extendsKey)
+	parentName, ok := parentValue.(string)
+	if !ok {
+		return nil, fmt.Errorf("%s: Invalid type. Expected: string", fieldName)
+	}
+	if _, ok := r.contexts[parentName]; !ok {
+		return nil, fmt.Errorf("%s: Context '%s' is not defined", fieldName, parentName)
+	}
+	if r.visiting[contextName] {
+		return nil, &cycleError{origin: contextName}
+	}
+
+	r.visiting[contextName] = true
+	parent, err := r.resolve(parentName)
+	delete(r.visiting, contextName)
+	if err != nil {
+		var cycleErr *cycleError
+		if errors.As(err, &cycleErr) && !cycleErr.closed {
+			cycleErr.closed = cycleErr.origin == contextName
+			return nil, cycleErr
+		}
+		return nil, fmt.Errorf("%s: Parent context '%s' is invalid", fieldName, parentName)
+	}
+
+	resolvedContext := mergeMaps(withoutKey(parent, extendsKey), context)
+	r.resolved[contextName] = resolvedContext
+	return resolvedContext, nil
+}
+
+func mergeMaps(base, override map[string]interface{}) map[string]interface{} {
+	merged := make(map[string]interface{})
+	for key, value := range base {
+		merged[key] = value
+	}
+	for key, value := range override {
+		baseMap, baseIsMap := merged[key].(map[string]interface{})
+		overrideMap, overrideIsMap := value.(map[string]interface{})
+		if baseIsMap && overrideIsMap {
+			merged[key] = mergeMaps(baseMap, overrideMap)
+		} else {
+			merged[key] = value
+		}
+	}
+	return merged
+}
+
+func withoutKey(source map[string]interface{}, excludedKey string) map[string]interface{} {
+	result := make(map[string]interface{})
+	for key, value := range source {
+		if key != excludedKey {
+			result[key] = value
+		}
+	}
+	return result
+}
@@ -0,0 +1,164 @@
+package spec
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFromYaml_ContextInheritance(t *testing.T) {
+	projectYaml := `---
+name: Demo
+schemaVersion: 1
+contextDefaults:
+    usePublicSubnets: true
+    environment:
+        NXF_OPTS: -Xms1g
+    engines:
+        - type: nextflow
+          engine: nextflow
+contexts:
+    base:
+        requestSpotInstances: true
+        instanceTypes: [ c5, m5 ]
+        maxVCpus: 100
+    large:
+        extends: base
+        instanceTypes: [ r5 ]
+        environment:
+            LARGE: "true"
+    largeOnDemand:
+        extends: large
+        requestSpotInstances: false
+    wdl:
+        engines:
+            - type: wdl
+              engine: cromwell
+`
+	specPath := filepath.Join(t.TempDir(), "agc-project.yaml")
+	require.NoError(t, os.WriteFile(specPath, []byte(projectYaml), 0644))
+
+	project, err := FromYaml(specPath)
+	require.NoError(t, err)
+
+	nextflow := []Engine{{Type: "nextflow", Engine: "nextflow"}}
+	assert.Equal(t, Context{
+		RequestSpotInstances: true,
+		InstanceTypes:        []string{"c5", "m5"},
+		MaxVCpus:             100,
+		UsePublicSubnets:     true,
+		Environment:          map[string]string{"NXF_OPTS": "-Xms1g"},
+		Engines:              nextflow,
+	}, project.Contexts["base"])
+	assert.Equal(t, Context{
+		Extends:              "base",
+		RequestSpotInstances: true,
+		InstanceTypes:        []string{"r5"},
+		MaxVCpus:             100,
+		UsePublicSubnets:     true,
+		Environment:          map[string]string{"NXF_OPTS": "-Xms1g", "LARGE": "true"},
+		Engines:              nextflow,
+	}, project.Contexts["large"])
+	assert.Equal(t, Context{
+		Extends:          "large",
+		InstanceTypes:    []string{"r5"},
+		MaxVCpus:         100,
+		UsePublicSubnets: true,
+		Environment:      map[string]string{"NXF_OPTS": "-Xms1g", "LARGE": "true"},
+		Engines:          nextflow,
+	}, project.Contexts["largeOnDemand"])
+	assert.Equal(t, Context{
+		MaxVCpus:         DefaultMaxVCpus,
+		UsePublicSubnets: true,
+		Environment:      map[string]string{"NXF_OPTS": "-Xms1g"},
+		Engines:          []Engine{{Type: "wdl", Engine: "cromwell"}},
+	}, project.Contexts["wdl"])
+}
+
+func TestProjectValidation_ContextInheritanceErrors(t *testing.T) {
+	tests := map[string]struct {
+		yaml       string
+		errMessage string
+	}{
+		"unknown parent": {
+			yaml: `---
+name: Demo
+schemaVersion: 1
+contexts:
+    child:
+        extends: missing
+        engines:
+            - type: wdl
+              engine: cromwell
+`,
+			errMessage: "\n\t1. contexts.child.extends: Context 'missing' is not defined\n",
+		},
+		"cycle": {
+			yaml: `---
+name: Demo
+schemaVersion: 1
+contexts:
+    first:
+        extends: second
+    second:
+        extends: first
+    third:
+        extends: second
+        engines:
+            - type: wdl
+              engine: cromwell
+`,
+			errMessage: "\n\t1. contexts.first.extends: Inheritance cycle detected\n\t2. contexts.second.extends: Inheritance cycle detected\n\t3. contexts.third.extends: Parent context 'second' is invalid\n",
+		},
+		"self reference": {
+			yaml: `---
+name: Demo
+schemaVersion: 1
+contexts:
+    self:
+        extends: self
+`,
+			errMessage: "\n\t1. contexts.self.extends: Inheritance cycle detected\n",
+		},
+		"extends in context defaults": {
+			yaml: `---
+name: Demo
+schemaVersion: 1
+contextDefaults:
+    extends: other
+contexts:
+    default:
+        engines:
+            - type: wdl
+              engine: cromwell
+`,
+			errMessage: "\n\t1. contextDefaults: Must not validate the schema (not)\n",
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			err := ValidateProject([]byte(tt.yaml))
+			assert.Equal(t, tt.errMessage, problemDescriptions(t, err))
+		})
+	}
+}
+
+func TestProjectValidation_ContextInheritanceValidatesResolvedContexts(t *testing.T) {
+	err := ValidateProject([]byte(`---
+name: Demo
+schemaVersion: 1
+contexts:
+    parent:
+        maxVCpus: 10
+    child:
+        extends: parent
+`))
+
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "contexts.child: engines is required")
+	assert.Contains(t, err.Error(), "contexts.parent: engines is required")
+}
@@ -1,11 +1,11 @@
 package spec
 
 import (
-	"bytes"
 	_ "embed"
 	"encoding/json"
-	"fmt"
+	"errors"
 	"os"
+	"path/filepath"
 
 	"github.com/xeipuuv/gojsonschema"
 	"gopkg.in/yaml.v3"
@@ -17,8 +17,20 @@ var jsonUnmarshal = json.Unmarshal
 //go:embed project_schema.json
 var projectSchema string
 
+// ToYaml writes a project specification. An existing file is updated in place so that its comments and layout are kept.
 func ToYaml(specFilePath string, projectSpec Project) error {
-	bytes, err := yaml.Marshal(projectSpec)
+	existingBytes, err := os.ReadFile(specFilePath)
+	if err != nil && !errors.Is(err, os.ErrNotExist) {
+		return err
+	}
+	editor, err := NewEditor(existingBytes)
+	if err != nil {
+		return err
+	}
+	if err := editor.Update(projectSpec); err != nil {
+		return err
+	}
+	bytes, err := editor.Bytes()
 	if err != nil {
 		return err
 	}
@@ -30,13 +42,23 @@ func FromYaml(specFilePath string) (Project, error) {
 	if err != nil {
 		return Project{}, err
 	}
+	document, err := preprocess(filepath.Dir(specFilePath), bytes)
+	if err != nil {
+		return Project{}, err
+	}
+
+	resolvedDocument, err := validateAndResolveProject(filepath.Base(specFilePath), document)
+	if err != nil {
+		return Project{}, err
+	}
 
-	if err := ValidateProject(bytes); err != nil {
+	resolvedBytes, err := yaml.Marshal(resolvedDocument)
+	if err != nil {
 		return Project{}, err
 	}
 
 	var projectSpec Project
-	if err := yaml.Unmarshal(bytes, &projectSpec); err != nil {
+	if err := yaml.Unmarshal(resolvedBytes, &projectSpec); err != nil {
 		return Project{}, err
 	}
 	return projectSpec, nil
@@ -56,34 +78,78 @@ func FromJson(manifestFilePath string) (Manifest, error) {
 }
 
 func ValidateProject(yamlBytes []byte) error {
+	return ValidateProjectInDirectory(".", yamlBytes)
+}
 
+// ValidateProjectInDirectory validates a project specification whose included files are relative to a directory
+func ValidateProjectInDirectory(directory string, yamlBytes []byte) error {
+	document, err := preprocess(directory, yamlBytes)
+	if err != nil {
+		return err
+	}
+	_, err = validateAndResolveProject(defaultSpecFileName, document)
+	return err
+}
+
+// validateAndResolveProject upgrades older schema versions, resolves context inheritance and validates the resolved document against the project schema.
+// Problems are returned as a ValidationError located in the file, or in the included file that declares the offending value.
+func validateAndResolveProject(fileName string, document preprocessedDocument) (interface{}, error) {
 	schemaLoader := gojsonschema.NewStringLoader(projectSchema)
 
+	yamlBytes, err := upgradeForRead(fileName, document.bytes)
+	if err != nil {
+		return nil, err
+	}
 	var data interface{}
 	if err := yaml.Unmarshal(yamlBytes, &data); err != nil {
-		return err
+		return nil, err
+	}
+	resolvedDocument, resolveErrors := resolveContextInheritance(convertDocumentNode(data))
+	if len(resolveErrors) > 0 {
+		return nil, document.validationError(fileName, yamlBytes, resolveErrors)
 	}
-	structLoader := gojsonschema.NewGoLoader(convertDocumentNode(data))
+	structLoader := gojsonschema.NewGoLoader(resolvedDocument)
 
 	result, err := gojsonschema.Validate(schemaLoader, structLoader)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
 	if !result.Valid() {
-		return projectSpecValidationError(result.Errors())
+		return nil, document.validationError(fileName, yamlBytes, schemaErrorDescriptions(result.Errors()))
+	}
+	if tagErrors := validateTags(resolvedDocument); len(tagErrors) > 0 {
+		return nil, document.validationError(fileName, yamlBytes, tagErrors)
+	}
+	if dataErrors := validateData(resolvedDocument); len(dataErrors) > 0 {
+		return nil, document.validationError(fileName, yamlBytes, dataErrors)
+	}
+	if computeErrors := validateCompute(resolvedDocument); len(computeErrors) > 0 {
+		return nil, document.validationError(fileName, yamlBytes, computeErrors)
 	}
 
-	return nil
+	return resolvedDocument, nil
 }
 
-func projectSpecValidationError(errors []gojsonschema.ResultError) error {
-	var errBuffer bytes.Buffer
-	errBuffer.WriteString("\n")
-	for idx, desc := range errors {
-		errBuffer.WriteString(fmt.Sprintf("\t%d. %s\n", idx+1, desc))
+// validationError locates problems in the files of the document when it was changed by preprocessing, otherwise in the
+// bytes that were validated
+func (d preprocessedDocument) validationError(fileName string, yamlBytes []byte, descriptions []string) *ValidationError {
+	if d.locator == nil {
+		return newValidationError(fileName, yamlBytes, descriptions)
+	}
+	return locateProblems(fileName, d.locator, d.sources, descriptions)
+}
+
+func schemaErrorDescriptions(errors []gojsonschema.ResultError) []string {
+	var descriptions []string
+	for _, desc := range errors {
+		// allOf failures are always accompanied by the errors of the failing sub-schema
+		if desc.Type() == "number_all_of" {
+			continue
+		}
+		descriptions = append(descriptions, desc.String())
 	}
-	return fmt.Errorf(errBuffer.String())
+	return descriptions
 }
 
 // convertDocumentNode converts yaml derived interfaces into map[string]interface{}
@@ -69,6 +69,90 @@ contexts:
             - type: wdl
               engine: cromwell
 `,
+		},
+		"contextEnvironmentAndSecrets": {
+			yaml: `---
+name: foo
+schemaVersion: 1
+contexts:
+    myContext:
+        environment:
+            NXF_OPTS: -Xms1g
+            LICENSE_PORT: 27000
+        secrets:
+            - name: LICENSE_SERVER
+              valueFrom: /license/server
+            - name: API_TOKEN
+              valueFrom: api-token
+              source: secretsManager
+        engines:
+            - type: nextflow
+              engine: nextflow`,
+		},
+		"computeEnvironmentTuning": {
+			yaml: `---
+name: foo
+schemaVersion: 1
+contexts:
+    myContext:
+        instanceTypes: [ c5, m5, p3 ]
+        excludeGpuInstances: true
+        excludeInstanceFamilies: [ m5 ]
+        requestSpotInstances: true
+        spotBidPercentage: 60
+        allocationStrategy: SPOT_CAPACITY_OPTIMIZED
+        minVCpus: 4
+        maxVCpus: 512
+        rootVolume:
+            size: 50
+            volumeType: gp3
+        scratchVolume:
+            size: 500
+            volumeType: gp3
+        amiId: ami-0123456789abcdef0
+        engines:
+            - type: nextflow
+              engine: nextflow`,
+		},
+		"contextTarget": {
+			yaml: `---
+name: foo
+schemaVersion: 1
+contexts:
+    myContext:
+        region: eu-west-1
+        awsProfile: research
+        roleArn: arn:aws:iam::111122223333:role/AgcDeployer
+        engines:
+            - type: wdl
+              engine: cromwell`,
+		},
+		"tags": {
+			yaml: `---
+name: foo
+schemaVersion: 1
+tags:
+    cost-center: genomics
+contexts:
+    myContext:
+        tags:
+            team: research
+        engines:
+            - type: wdl
+              engine: cromwell`,
+		},
+		"budget": {
+			yaml: `---
+name: foo
+schemaVersion: 1
+contexts:
+    myContext:
+        budget:
+            monthlyLimit: 500
+            warnThreshold: 75.5
+        engines:
+            - type: wdl
+              engine: cromwell`,
 		},
 		"defaultContext": {
 			yaml: `---
@@ -164,6 +248,55 @@ data:
   - readOnly: true`,
 			errMessage: "\n\t1. data.0: location is required\n",
 		},
+		"invalidDataKmsKeyArn": {
+			yaml: `---
+name: foo
+schemaVersion: 1
+contexts:
+    default:
+        engines:
+            - type: wdl
+              engine: cromwell
+data:
+  - location: s3://bucket
+    kmsKeyArn: arn:aws:kms:us-east-1:123456789012:alias/data`,
+			errMessage: "\n\t1. data.0.kmsKeyArn: Does not match pattern '^arn:aws[a-z-]*:kms:[a-z]{2}(-[a-z]+)+-[0-9]:[0-9]{12}:key/[A-Za-z0-9-]+$'\n",
+		},
+		"invalidDataBucketOwner": {
+			yaml: `---
+name: foo
+schemaVersion: 1
+contexts:
+    default:
+        engines:
+            - type: wdl
+              engine: cromwell
+data:
+  - location: s3://bucket
+    bucketOwner: "1234"`,
+			errMessage: "\n\t1. data.0.bucketOwner: Does not match pattern '^[0-9]{12}$'\n",
+		},
+		"inconsistentDataBucket": {
+			yaml: `---
+name: foo
+schemaVersion: 1
+contexts:
+    default:
+        engines:
+            - type: nextflow
+              engine: nextflow
+data:
+  - location: s3://shared-bucket/cohortA
+    bucketOwner: "123456789012"
+    requesterPays: true
+  - location: s3://shared-bucket/cohortB
+    readOnly: true
+    requesterPays: true
+  - location: s3://shared-bucket/cohortC
+    bucketOwner: "123456789012"
+    requesterPays: false`,
+			errMessage: "\n\t1. data.1.bucketOwner: bucket 'shared-bucket' must have the same bucketOwner as in data.0\n\t2. data.2.requesterPays: bucket 'shared-bucket' must have the same requesterPays as in data.0\n",
+		},
 		"badSchemaVersion": {
 			yaml: `---
 name: foo
@@ -322,6 +455,211 @@ workflows:
 `,
 			errMessage: "\n\t1. workflows.some-workflow: Additional property extra is not allowed\n",
 		},
+		"invalidEnvironmentVariableName": {
+			yaml: `---
+name: Demo
+schemaVersion: 1
+contexts:
+    default:
+        environment:
+            1BAD: value
+        engines:
+            - type: wdl
+              engine: cromwell
+`,
+			errMessage: "\n\t1. contexts.default.environment: Additional property 1BAD is not allowed\n",
+		},
+		"invalidSecretSource": {
+			yaml: `---
+name: Demo
+schemaVersion: 1
+contexts:
+    default:
+        secrets:
+            - name: TOKEN
+              valueFrom: token
+              source: vault
+        engines:
+            - type: wdl
+              engine: cromwell
+`,
+			errMessage: "\n\t1. contexts.default.secrets.0.source: contexts.default.secrets.0.source must be one of the following: \"parameterStore\", \"secretsManager\"\n",
+		},
+		"missingSecretValueFrom": {
+			yaml: `---
+name: Demo
+schemaVersion: 1
+contexts:
+    default:
+        secrets:
+            - name: TOKEN
+        engines:
+            - type: wdl
+              engine: cromwell
+`,
+			errMessage: "\n\t1. contexts.default.secrets.0: valueFrom is required\n",
+		},
+		"spotBidPercentageWithoutSpot": {
+			yaml: `---
+name: Demo
+schemaVersion: 1
+contexts:
+    default:
+        spotBidPercentage: 50
+        engines:
+            - type: wdl
+              engine: cromwell
+`,
+			errMessage: "\n\t1. contexts.default: requestSpotInstances is required\n",
+		},
+		"invalidVolumeType": {
+			yaml: `---
+name: Demo
+schemaVersion: 1
+contexts:
+    default:
+        rootVolume:
+            size: 30
+            volumeType: ssd
+        engines:
+            - type: wdl
+              engine: cromwell
+`,
+			errMessage: "\n\t1. contexts.default.rootVolume.volumeType: contexts.default.rootVolume.volumeType must be one of the following: \"gp2\", \"gp3\", \"standard\"\n",
+		},
+		"requesterPaysWithOtherEngines": {
+			yaml: `---
+name: foo
+schemaVersion: 1
+contexts:
+    nextflow:
+        engines:
+            - type: nextflow
+              engine: nextflow
+    wdl:
+        engines:
+            - type: wdl
+              engine: cromwell
+data:
+  - location: s3://open-bucket
+  - location: s3://paid-bucket
+    readOnly: true
+    requesterPays: true`,
+			errMessage: "\n\t1. data.1.requesterPays: the cromwell engine of context 'wdl' cannot read requester pays buckets\n",
+		},
+		"spotCapacityOptimizedWithoutSpot": {
+			yaml: `---
+name: Demo
+schemaVersion: 1
+contexts:
+    default:
+        allocationStrategy: SPOT_CAPACITY_OPTIMIZED
+        minVCpus: 300
+        engines:
+            - type: nextflow
+              engine: nextflow
+`,
+			errMessage: "\n\t1. contexts.default.allocationStrategy: SPOT_CAPACITY_OPTIMIZED requires requestSpotInstances\n\t2. contexts.default.minVCpus: Must be less than or equal to maxVCpus (256)\n",
+		},
+		"scratchVolumeWithEfsEngine": {
+			yaml: `---
+name: Demo
+schemaVersion: 1
+contexts:
+    default:
+        scratchVolume:
+            size: 500
+        engines:
+            - type: wdl
+              engine: miniwdl
+`,
+			errMessage: "\n\t1. contexts.default.scratchVolume: the miniwdl engine uses EFS as scratch space\n",
+		},
+		"invalidAmiId": {
+			yaml: `---
+name: Demo
+schemaVersion: 1
+contexts:
+    default:
+        amiId: my-ami
+        engines:
+            - type: wdl
+              engine: cromwell
+`,
+			errMessage: "\n\t1. contexts.default.amiId: Does not match pattern '^ami-[0-9a-f]{8,17}$'\n",
+		},
+		"invalidRegion": {
+			yaml: `---
+name: Demo
+schemaVersion: 1
+contexts:
+    default:
+        region: Ireland
+        engines:
+            - type: wdl
+              engine: cromwell
+`,
+			errMessage: "\n\t1. contexts.default.region: Does not match pattern '^[a-z]{2}(-[a-z]+)+-[0-9]$'\n",
+		},
+		"invalidRoleArn": {
+			yaml: `---
+name: Demo
+schemaVersion: 1
+contexts:
+    default:
+        roleArn: AgcDeployer
+        engines:
+            - type: wdl
+              engine: cromwell
+`,
+			errMessage: "\n\t1. contexts.default.roleArn: Does not match pattern '^arn:aws[a-z-]*:iam::[0-9]{12}:role/.+$'\n",
+		},
+		"reservedTagKey": {
+			yaml: `---
+name: Demo
+schemaVersion: 1
+tags:
+    aws:createdBy: me
+contexts:
+    default:
+        tags:
+            agc-project: other
+        engines:
+            - type: wdl
+              engine: cromwell
+`,
+			errMessage: "\n\t1. tags: tag keys starting with 'aws:' are reserved by AWS\n\t2. contexts.default.tags: tag 'agc-project' is set by AGC and cannot be overridden\n",
+		},
+		"invalidTagValue": {
+			yaml: `---
+name: Demo
+schemaVersion: 1
+tags:
+    team:
+        - research
+contexts:
+    default:
+        engines:
+            - type: wdl
+              engine: cromwell
+`,
+			errMessage: "\n\t1. tags.team: Invalid type. Expected: string, given: array\n",
+		},
+		"invalidBudget": {
+			yaml: `---
+name: Demo
+schemaVersion: 1
+contexts:
+    default:
+        budget:
+            monthlyLimit: 0
+            warnThreshold: 120
+        engines:
+            - type: wdl
+              engine: cromwell
+`,
+			errMessage: "\n\t1. contexts.default.budget.monthlyLimit: Must be greater than 0\n\t2. contexts.default.budget.warnThreshold: Must be less than or equal to 100\n",
+		},
 		"invalidExtraWorkflowTypeProperty": {
 			yaml: `---
 name: Demo
@@ -349,7 +687,7 @@ workflows:
 	for name, tt := range tests {
 		t.Run(name, func(t *testing.T) {
 			err := ValidateProject([]byte(tt.yaml))
-			assert.EqualError(t, err, tt.errMessage)
+			assert.Equal(t, tt.errMessage, problemDescriptions(t, err))
 		})
 	}
 }
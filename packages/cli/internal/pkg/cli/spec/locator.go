@@ -0,0 +1,80 @@
+package spec
+
+import (
+	"strconv"
+
+	"gopkg.in/yaml.v3"
+)
+
+// Locator finds the line and column of values in a project specification
+type Locator struct {
+	root *yaml.Node
+	// files are the included files that nodes are declared in. Other nodes are declared in the file of their parent.
+	files map[*yaml.Node]string
+}
+
+func NewLocator(yamlBytes []byte) (*Locator, error) {
+	var document yaml.Node
+	if err := yaml.Unmarshal(yamlBytes, &document); err != nil {
+		return nil, err
+	}
+	root := &document
+	if document.Kind == yaml.DocumentNode && len(document.Content) > 0 {
+		root = document.Content[0]
+	}
+	return &Locator{root: root}, nil
+}
+
+// Locate returns the position of the value at a path of mapping keys and sequence indexes. When the path is not in the
+// document, such as for settings a context inherits, the position of its deepest parent in the document is returned.
+// Scalar values are located at the value, other values at their key. A nil locator returns line 0.
+func (l *Locator) Locate(path ...string) (line int, column int) {
+	_, line, column = l.LocateInFile(path...)
+	return line, column
+}
+
+// LocateInFile returns the position of the value at a path like Locate, together with the file that declares it
+// relative to the project. The file is empty for values of the project specification itself.
+func (l *Locator) LocateInFile(path ...string) (file string, line int, column int) {
+	if l == nil {
+		return "", 0, 0
+	}
+	position, positionFile := l.root, ""
+	node, nodeFile := l.root, ""
+	for _, element := range path {
+		key, value := childNode(node, element)
+		if value == nil {
+			break
+		}
+		keyFile := l.fileOf(key, nodeFile)
+		node, nodeFile = value, l.fileOf(value, nodeFile)
+		position, positionFile = value, nodeFile
+		if key != nil && value.Kind != yaml.ScalarNode {
+			position, positionFile = key, keyFile
+		}
+	}
+	return positionFile, position.Line, position.Column
+}
+
+func (l *Locator) fileOf(node *yaml.Node, parentFile string) string {
+	if file, ok := l.files[node]; ok {
+		return file
+	}
+	return parentFile
+}
+
+func childNode(node *yaml.Node, element string) (key *yaml.Node, value *yaml.Node) {
+	switch node.Kind {
+	case yaml.MappingNode:
+		for i := 0; i+1 < len(node.Content); i += 2 {
+			if node.Content[i].Value == element {
+				return node.Content[i], node.Content[i+1]
+			}
+		}
+	case yaml.SequenceNode:
+		if index, err := strconv.Atoi(element); err == nil && index >= 0 && index < len(node.Content) {
+			return nil, node.Content[index]
+		}
+	}
+	return nil, nil
+}
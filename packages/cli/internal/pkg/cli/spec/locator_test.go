@@ -0,0 +1,58 @@
+package spec
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const locatorTestYaml = `name: demo
+schemaVersion: 1
+workflows:
+  hello:
+    type:
+      language: wdl
+      version: 1.0
+    sourceURL: workflows/hello
+data:
+  - location: s3://bucket/path
+contexts:
+  ctx1:
+    engines:
+      - type: wdl
+        engine: cromwell
+`
+
+func TestLocator_Locate(t *testing.T) {
+	locator, err := NewLocator([]byte(locatorTestYaml))
+	require.NoError(t, err)
+
+	testCases := map[string]struct {
+		path           []string
+		expectedLine   int
+		expectedColumn int
+	}{
+		"scalar value":        {path: []string{"workflows", "hello", "sourceURL"}, expectedLine: 8, expectedColumn: 16},
+		"mapping value":       {path: []string{"workflows", "hello"}, expectedLine: 4, expectedColumn: 3},
+		"sequence item":       {path: []string{"data", "0", "location"}, expectedLine: 10, expectedColumn: 15},
+		"missing path":        {path: []string{"contexts", "ctx1", "engines", "0", "filesystem", "fsType"}, expectedLine: 14, expectedColumn: 9},
+		"index out of bounds": {path: []string{"data", "3"}, expectedLine: 9, expectedColumn: 1},
+		"document":            {expectedLine: 1, expectedColumn: 1},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			line, column := locator.Locate(tc.path...)
+			assert.Equal(t, tc.expectedLine, line)
+			assert.Equal(t, tc.expectedColumn, column)
+		})
+	}
+}
+
+func TestLocator_Nil(t *testing.T) {
+	var locator *Locator
+	line, column := locator.Locate("name")
+	assert.Equal(t, 0, line)
+	assert.Equal(t, 0, column)
+}
@@ -0,0 +1,142 @@
+package spec
+
+import (
+	"bytes"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/aws/amazon-genomics-cli/internal/pkg/cli/clierror/actionableerror"
+	"github.com/rs/zerolog/log"
+	"gopkg.in/yaml.v3"
+)
+
+const (
+	firstVersion     = 1
+	schemaVersionKey = "schemaVersion"
+	defaultIndent    = 2
+)
+
+// Migration upgrades a project specification from a schema version to the next one. Upgrades edit the yaml nodes of the
+// document so that comments and the order of keys are kept.
+type Migration struct {
+	FromVersion int
+	Description string
+	Upgrade     func(root *yaml.Node) error
+}
+
+// migrations are the registered upgrades, keyed by the schema version they upgrade from
+var migrations = map[int]Migration{}
+
+// registerMigration adds the upgrade from a schema version to the next one. Migrations are registered by the init
+// function of the file that introduces the breaking change.
+func registerMigration(migration Migration) {
+	if _, ok := migrations[migration.FromVersion]; ok {
+		panic(fmt.Sprintf("a migration from schema version %d is already registered", migration.FromVersion))
+	}
+	migrations[migration.FromVersion] = migration
+}
+
+// Migrate upgrades a project specification to the LatestVersion of the schema. The migrations that were applied are
+// returned with the upgraded document, which is unchanged when none were needed.
+func Migrate(yamlBytes []byte) ([]byte, []Migration, error) {
+	return migrate(yamlBytes, LatestVersion, migrations)
+}
+
+func migrate(yamlBytes []byte, latestVersion int, registered map[int]Migration) ([]byte, []Migration, error) {
+	var document yaml.Node
+	if err := yaml.Unmarshal(yamlBytes, &document); err != nil {
+		return nil, nil, err
+	}
+	versionNode, version := schemaVersion(&document)
+	if versionNode == nil || version < firstVersion || version == latestVersion {
+		return yamlBytes, nil, nil
+	}
+	if version > latestVersion {
+		return nil, nil, newerVersionError(version, latestVersion)
+	}
+
+	var applied []Migration
+	for ; version < latestVersion; version++ {
+		migration, ok := registered[version]
+		if !ok {
+			return nil, nil, fmt.Errorf("no migration is registered to upgrade schema version %d to version %d", version, version+1)
+		}
+		if err := migration.Upgrade(document.Content[0]); err != nil {
+			return nil, nil, fmt.Errorf("unable to upgrade schema version %d to version %d: %w", version, version+1, err)
+		}
+		versionNode.Value = strconv.Itoa(version + 1)
+		applied = append(applied, migration)
+	}
+
+	migratedBytes, err := encodeDocument(&document, detectIndent(yamlBytes))
+	if err != nil {
+		return nil, nil, err
+	}
+	return migratedBytes, applied, nil
+}
+
+// upgradeForRead migrates an older project specification in memory so that it can be validated against the current
+// schema. The file itself is only rewritten by 'agc project migrate'.
+func upgradeForRead(fileName string, yamlBytes []byte) ([]byte, error) {
+	migratedBytes, applied, err := Migrate(yamlBytes)
+	if err != nil {
+		return nil, err
+	}
+	if len(applied) > 0 {
+		log.Warn().Msgf("%s uses schemaVersion %d, which is older than the latest version %d. Run 'agc project migrate' to upgrade it",
+			fileName, applied[0].FromVersion, LatestVersion)
+	}
+	return migratedBytes, nil
+}
+
+func newerVersionError(version, latestVersion int) error {
+	return actionableerror.New(
+		fmt.Errorf("the project specification has schemaVersion %d but this CLI only supports versions up to %d", version, latestVersion),
+		"Please upgrade your CLI to a version that supports the schema of this project",
+	)
+}
+
+// schemaVersion returns the node of the schemaVersion value and the version it holds. A nil node is returned when the
+// document has no integer schemaVersion, which is left for the schema validation to report.
+func schemaVersion(document *yaml.Node) (*yaml.Node, int) {
+	if document.Kind != yaml.DocumentNode || len(document.Content) == 0 {
+		return nil, 0
+	}
+	_, versionNode := childNode(document.Content[0], schemaVersionKey)
+	if versionNode == nil || versionNode.Kind != yaml.ScalarNode {
+		return nil, 0
+	}
+	version, err := strconv.Atoi(versionNode.Value)
+	if err != nil {
+		return nil, 0
+	}
+	return versionNode, version
+}
+
+func encodeDocument(document *yaml.Node, indent int) ([]byte, error) {
+	var buffer bytes.Buffer
+	encoder := yaml.NewEncoder(&buffer)
+	encoder.SetIndent(indent)
+	if err := encoder.Encode(document); err != nil {
+		return nil, err
+	}
+	if err := encoder.Close(); err != nil {
+		return nil, err
+	}
+	return buffer.Bytes(), nil
+}
+
+// detectIndent returns the indentation of the first indented line, which is a child of a top level key, so that
+// migrated files keep their layout
+func detectIndent(yamlBytes []byte) int {
+	for _, line := range strings.Split(string(yamlBytes), "\n") {
+		trimmed := strings.TrimLeft(line, " ")
+		indent := len(line) - len(trimmed)
+		if indent == 0 || trimmed == "" || strings.HasPrefix(trimmed, "#") {
+			continue
+		}
+		return indent
+	}
+	return defaultIndent
+}
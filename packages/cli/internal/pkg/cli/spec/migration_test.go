@@ -0,0 +1,122 @@
+package spec
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/amazon-genomics-cli/internal/pkg/cli/clierror/actionableerror"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gopkg.in/yaml.v3"
+)
+
+const migrationTestYaml = `# Project of the genomics team
+name: demo
+schemaVersion: 1
+data:
+  - location: s3://bucket/reference # shared reference data
+contexts:
+  ctx1:
+    # spot instances are cheaper
+    requestSpotInstances: true
+    engines:
+      - type: wdl
+        engine: cromwell
+`
+
+// renameKey returns a migration that renames a key of the context mappings
+func renameKey(fromVersion int, oldKey, newKey string) Migration {
+	return Migration{
+		FromVersion: fromVersion,
+		Description: fmt.Sprintf("rename %s to %s", oldKey, newKey),
+		Upgrade: func(root *yaml.Node) error {
+			_, contexts := childNode(root, "contexts")
+			if contexts == nil {
+				return nil
+			}
+			for i := 1; i < len(contexts.Content); i += 2 {
+				if key, _ := childNode(contexts.Content[i], oldKey); key != nil {
+					key.Value = newKey
+				}
+			}
+			return nil
+		},
+	}
+}
+
+func TestMigrate_LatestVersion(t *testing.T) {
+	migrated, applied, err := Migrate([]byte(migrationTestYaml))
+	require.NoError(t, err)
+	assert.Empty(t, applied)
+	assert.Equal(t, migrationTestYaml, string(migrated))
+}
+
+func TestMigrate_KeepsComments(t *testing.T) {
+	registered := map[int]Migration{
+		1: renameKey(1, "requestSpotInstances", "useSpot"),
+		2: renameKey(2, "useSpot", "spot"),
+	}
+
+	migrated, applied, err := migrate([]byte(migrationTestYaml), 3, registered)
+	require.NoError(t, err)
+	require.Len(t, applied, 2)
+	assert.Equal(t, "rename requestSpotInstances to useSpot", applied[0].Description)
+	assert.Equal(t, "rename useSpot to spot", applied[1].Description)
+	assert.Equal(t, `# Project of the genomics team
+name: demo
+schemaVersion: 3
+data:
+  - location: s3://bucket/reference # shared reference data
+contexts:
+  ctx1:
+    # spot instances are cheaper
+    spot: true
+    engines:
+      - type: wdl
+        engine: cromwell
+`, string(migrated))
+}
+
+func TestMigrate_KeepsIndent(t *testing.T) {
+	yamlBytes := []byte("name: demo\nschemaVersion: 1\ncontexts:\n    ctx1:\n        requestSpotInstances: true\n")
+
+	migrated, _, err := migrate(yamlBytes, 2, map[int]Migration{1: renameKey(1, "requestSpotInstances", "spot")})
+	require.NoError(t, err)
+	assert.Equal(t, "name: demo\nschemaVersion: 2\ncontexts:\n    ctx1:\n        spot: true\n", string(migrated))
+}
+
+func TestMigrate_NewerVersion(t *testing.T) {
+	_, _, err := Migrate([]byte("name: demo\nschemaVersion: 99\n"))
+
+	var actionableErr *actionableerror.Error
+	require.ErrorAs(t, err, &actionableErr)
+	assert.EqualError(t, actionableErr.Cause, "the project specification has schemaVersion 99 but this CLI only supports versions up to 1")
+	assert.Equal(t, "Please upgrade your CLI to a version that supports the schema of this project", actionableErr.SuggestedAction)
+}
+
+func TestMigrate_MissingMigration(t *testing.T) {
+	_, _, err := migrate([]byte(migrationTestYaml), 3, map[int]Migration{1: renameKey(1, "a", "b")})
+	assert.EqualError(t, err, "no migration is registered to upgrade schema version 2 to version 3")
+}
+
+func TestMigrate_UpgradeError(t *testing.T) {
+	failing := Migration{FromVersion: 1, Upgrade: func(root *yaml.Node) error { return fmt.Errorf("some error") }}
+
+	_, _, err := migrate([]byte(migrationTestYaml), 2, map[int]Migration{1: failing})
+	assert.EqualError(t, err, "unable to upgrade schema version 1 to version 2: some error")
+}
+
+func TestMigrate_InvalidVersionIsLeftToSchema(t *testing.T) {
+	for _, yamlText := range []string{"name: demo\n", "name: demo\nschemaVersion: 0\n", "name: demo\nschemaVersion: one\n"} {
+		migrated, applied, err := migrate([]byte(yamlText), 2, map[int]Migration{})
+		require.NoError(t, err)
+		assert.Empty(t, applied)
+		assert.Equal(t, yamlText, string(migrated))
+	}
+}
+
+func TestFromYaml_NewerVersion(t *testing.T) {
+	err := ValidateProject([]byte("name: demo\nschemaVersion: 2\n"))
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "this CLI only supports versions up to 1")
+}
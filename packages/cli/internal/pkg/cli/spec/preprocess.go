@@ -0,0 +1,281 @@
+package spec
+
+import (
+	"bytes"
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+const (
+	includeTag  = "!include"
+	includesKey = "includes"
+)
+
+// variablePattern matches ${NAME} and ${NAME:-default}. A reference escaped as $${NAME} is kept as ${NAME}.
+var variablePattern = regexp.MustCompile(`\$?\$\{([A-Za-z_][A-Za-z0-9_]*)(:-([^}]*))?\}`)
+
+var lookupEnv = os.LookupEnv
+
+// preprocessor resolves the files included by a project specification and the environment variables it references
+type preprocessor struct {
+	directory string
+	including []string
+	undefined []string
+	changed   bool
+	files     map[*yaml.Node]string
+	sources   map[string][]byte
+}
+
+// preprocessedDocument is a project specification whose includes and environment variables are resolved. When the
+// specification was changed, the locator finds values at their position in the project or included file that declares
+// them rather than in the re-encoded bytes, and the sources hold the contents of the included files.
+type preprocessedDocument struct {
+	bytes   []byte
+	locator *Locator
+	sources map[string][]byte
+}
+
+// Preprocess resolves the '!include' values, the 'includes' list and the ${ENV_VAR:-default} references of a project
+// specification. Included files are relative to the file that includes them, starting with the directory of the
+// project. The bytes are returned unchanged when the specification uses none of these, so that problems can be located
+// in the file.
+func Preprocess(directory string, yamlBytes []byte) ([]byte, error) {
+	document, err := preprocess(directory, yamlBytes)
+	if err != nil {
+		return nil, err
+	}
+	return document.bytes, nil
+}
+
+func preprocess(directory string, yamlBytes []byte) (preprocessedDocument, error) {
+	var document yaml.Node
+	if err := yaml.Unmarshal(yamlBytes, &document); err != nil {
+		return preprocessedDocument{}, err
+	}
+	if document.Kind != yaml.DocumentNode || len(document.Content) == 0 {
+		return preprocessedDocument{bytes: yamlBytes}, nil
+	}
+	p := &preprocessor{directory: directory, files: make(map[*yaml.Node]string), sources: make(map[string][]byte)}
+	if err := p.resolve(directory, document.Content[0], nil); err != nil {
+		return preprocessedDocument{}, err
+	}
+	if len(p.undefined) > 0 {
+		return preprocessedDocument{}, fmt.Errorf("the project specification references undefined environment variables:\n\t%s", strings.Join(p.undefined, "\n\t"))
+	}
+	if !p.changed {
+		return preprocessedDocument{bytes: yamlBytes}, nil
+	}
+	resolvedBytes, err := encodeDocument(&document, detectIndent(yamlBytes))
+	if err != nil {
+		return preprocessedDocument{}, err
+	}
+	p.sources[""] = yamlBytes
+	return preprocessedDocument{
+		bytes:   resolvedBytes,
+		locator: &Locator{root: document.Content[0], files: p.files},
+		sources: p.sources,
+	}, nil
+}
+
+func (p *preprocessor) resolve(directory string, node *yaml.Node, path []string) error {
+	switch node.Kind {
+	case yaml.ScalarNode:
+		p.interpolate(node, path)
+		if node.Tag == includeTag {
+			return p.include(directory, node, path)
+		}
+	case yaml.MappingNode:
+		for i := 0; i+1 < len(node.Content); i += 2 {
+			if len(path) == 0 && node.Content[i].Value == includesKey {
+				continue
+			}
+			if err := p.resolve(directory, node.Content[i+1], append(path, node.Content[i].Value)); err != nil {
+				return err
+			}
+		}
+		// included files are resolved when they are loaded, so they are merged after the keys of this file
+		if len(path) == 0 {
+			return p.mergeIncludes(directory, node)
+		}
+	case yaml.SequenceNode:
+		for i, item := range node.Content {
+			if err := p.resolve(directory, item, append(path, fmt.Sprint(i))); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// interpolate replaces the environment variables referenced by a scalar. A plain scalar that is changed loses its tag
+// so that its type is resolved from the interpolated value, for example for numbers.
+func (p *preprocessor) interpolate(node *yaml.Node, path []string) {
+	if !strings.Contains(node.Value, "${") {
+		return
+	}
+	interpolated := variablePattern.ReplaceAllStringFunc(node.Value, func(reference string) string {
+		if strings.HasPrefix(reference, "$$") {
+			return reference[1:]
+		}
+		match := variablePattern.FindStringSubmatch(reference)
+		if value, ok := lookupEnv(match[1]); ok {
+			return value
+		}
+		if match[2] != "" {
+			return match[3]
+		}
+		p.undefined = append(p.undefined, fmt.Sprintf("%s: environment variable '%s' is not defined and has no default", describePath(path), match[1]))
+		return reference
+	})
+	if interpolated == node.Value {
+		return
+	}
+	node.Value = interpolated
+	if node.Style == 0 && node.Tag != includeTag {
+		node.Tag = ""
+	}
+	p.changed = true
+}
+
+// include replaces a '!include file' value with the document of the file
+func (p *preprocessor) include(directory string, node *yaml.Node, path []string) error {
+	included, filePath, err := p.load(directory, node.Value)
+	if err != nil {
+		return fmt.Errorf("%s: %w", describePath(path), err)
+	}
+	p.including = append(p.including, filePath)
+	defer func() { p.including = p.including[:len(p.including)-1] }()
+	if err := p.resolve(filepath.Dir(filePath), included, path); err != nil {
+		return err
+	}
+	*node = *included
+	p.files[node] = p.relativeName(filePath)
+	p.changed = true
+	return nil
+}
+
+// mergeIncludes merges the top level keys of the files listed by 'includes' into the project. Entries of mappings
+// and sequences are combined, other values and entries defined more than once are errors.
+func (p *preprocessor) mergeIncludes(directory string, root *yaml.Node) error {
+	_, includes := childNode(root, includesKey)
+	if includes == nil {
+		return nil
+	}
+	removeMappingKey(root, includesKey)
+	p.changed = true
+	if includes.Kind != yaml.SequenceNode {
+		return fmt.Errorf("%s: must be a list of files", includesKey)
+	}
+	for _, pattern := range includes.Content {
+		p.interpolate(pattern, []string{includesKey})
+		fileNames, err := filepath.Glob(filepath.Join(directory, pattern.Value))
+		if err != nil {
+			return fmt.Errorf("%s: %w", includesKey, err)
+		}
+		if len(fileNames) == 0 {
+			return fmt.Errorf("%s: no file matches '%s'", includesKey, pattern.Value)
+		}
+		sort.Strings(fileNames)
+		for _, fileName := range fileNames {
+			relativeName, _ := filepath.Rel(directory, fileName)
+			included, filePath, err := p.load(directory, relativeName)
+			if err != nil {
+				return fmt.Errorf("%s: %w", includesKey, err)
+			}
+			if included.Kind != yaml.MappingNode {
+				return fmt.Errorf("%s: '%s' must contain a mapping of project keys", includesKey, relativeName)
+			}
+			p.including = append(p.including, fileName)
+			err = p.resolve(filepath.Dir(filePath), included, nil)
+			p.including = p.including[:len(p.including)-1]
+			if err != nil {
+				return err
+			}
+			p.declare(included.Content, p.relativeName(filePath))
+			if err := mergeIncluded(root, included, relativeName); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// load parses an included file, returning its root node and its path, which the files it includes are relative to
+func (p *preprocessor) load(directory, fileName string) (*yaml.Node, string, error) {
+	filePath := filepath.Clean(filepath.Join(directory, fileName))
+	for _, including := range p.including {
+		if filepath.Clean(including) == filePath {
+			return nil, "", fmt.Errorf("'%s' includes itself", fileName)
+		}
+	}
+	fileBytes, err := os.ReadFile(filePath)
+	if err != nil {
+		return nil, "", fmt.Errorf("unable to include '%s': %w", fileName, err)
+	}
+	var document yaml.Node
+	if err := yaml.NewDecoder(bytes.NewReader(fileBytes)).Decode(&document); err != nil {
+		return nil, "", fmt.Errorf("unable to parse included file '%s': %w", fileName, err)
+	}
+	if len(document.Content) == 0 {
+		return nil, "", fmt.Errorf("included file '%s' is empty", fileName)
+	}
+	p.sources[p.relativeName(filePath)] = fileBytes
+	return document.Content[0], filePath, nil
+}
+
+// declare records the file that the keys and values merged from an included file, and the entries of those values,
+// are declared in. Nodes of files included by the file keep the file they are declared in.
+func (p *preprocessor) declare(nodes []*yaml.Node, fileName string) {
+	for _, node := range nodes {
+		if _, ok := p.files[node]; !ok {
+			p.files[node] = fileName
+		}
+		if node.Kind == yaml.MappingNode || node.Kind == yaml.SequenceNode {
+			p.declare(node.Content, fileName)
+		}
+	}
+}
+
+// relativeName returns the path of an included file relative to the directory of the project
+func (p *preprocessor) relativeName(filePath string) string {
+	if relativeName, err := filepath.Rel(p.directory, filePath); err == nil {
+		return relativeName
+	}
+	return filePath
+}
+
+func mergeIncluded(root, included *yaml.Node, fileName string) error {
+	for i := 0; i+1 < len(included.Content); i += 2 {
+		key, value := included.Content[i], included.Content[i+1]
+		_, existing := childNode(root, key.Value)
+		switch {
+		case existing == nil:
+			root.Content = append(root.Content, key, value)
+		case existing.Kind == yaml.MappingNode && value.Kind == yaml.MappingNode:
+			for j := 0; j+1 < len(value.Content); j += 2 {
+				if entry, _ := childNode(existing, value.Content[j].Value); entry != nil {
+					return fmt.Errorf("%s.%s: defined in the project and again in '%s'", key.Value, value.Content[j].Value, fileName)
+				}
+				existing.Content = append(existing.Content, value.Content[j], value.Content[j+1])
+			}
+		case existing.Kind == yaml.SequenceNode && value.Kind == yaml.SequenceNode:
+			existing.Content = append(existing.Content, value.Content...)
+		default:
+			return fmt.Errorf("%s: defined in the project and again in '%s'", key.Value, fileName)
+		}
+	}
+	return nil
+}
+
+func describePath(path []string) string {
+	if len(path) == 0 {
+		return rootPath
+	}
+	return strings.Join(path, ".")
+}
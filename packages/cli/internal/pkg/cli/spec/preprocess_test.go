@@ -0,0 +1,221 @@
+package spec
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func writePreprocessTestFiles(t *testing.T, files map[string]string) string {
+	directory := t.TempDir()
+	for name, content := range files {
+		path := filepath.Join(directory, name)
+		require.NoError(t, os.MkdirAll(filepath.Dir(path), 0755))
+		require.NoError(t, os.WriteFile(path, []byte(content), 0644))
+	}
+	return directory
+}
+
+func TestPreprocess_Unchanged(t *testing.T) {
+	yamlBytes := []byte("name: demo # the name\nschemaVersion:   1\n")
+
+	resolved, err := Preprocess(t.TempDir(), yamlBytes)
+	require.NoError(t, err)
+	assert.Equal(t, yamlBytes, resolved)
+}
+
+func TestPreprocess_Interpolation(t *testing.T) {
+	t.Setenv("AGC_TEST_BUCKET", "team-bucket")
+	t.Setenv("AGC_TEST_MAX_VCPUS", "512")
+
+	resolved, err := Preprocess(t.TempDir(), []byte(`name: demo
+data:
+  - location: s3://${AGC_TEST_BUCKET}/reference
+  - location: s3://${AGC_TEST_UNSET_BUCKET:-default-bucket}/reads
+contexts:
+  ctx1:
+    maxVCpus: ${AGC_TEST_MAX_VCPUS}
+    environment:
+      LITERAL: "$${AGC_TEST_BUCKET}"
+`))
+	require.NoError(t, err)
+	assert.Equal(t, `name: demo
+data:
+  - location: s3://team-bucket/reference
+  - location: s3://default-bucket/reads
+contexts:
+  ctx1:
+    maxVCpus: 512
+    environment:
+      LITERAL: "${AGC_TEST_BUCKET}"
+`, string(resolved))
+}
+
+func TestPreprocess_UndefinedVariables(t *testing.T) {
+	_, err := Preprocess(t.TempDir(), []byte(`name: ${AGC_TEST_UNSET_NAME}
+data:
+  - location: s3://${AGC_TEST_UNSET_BUCKET}/reference
+`))
+	assert.EqualError(t, err, `the project specification references undefined environment variables:
+	name: environment variable 'AGC_TEST_UNSET_NAME' is not defined and has no default
+	data.0.location: environment variable 'AGC_TEST_UNSET_BUCKET' is not defined and has no default`)
+}
+
+func TestPreprocess_IncludeTag(t *testing.T) {
+	directory := writePreprocessTestFiles(t, map[string]string{
+		"contexts.yaml":    "ctx1:\n  engines: !include engines/wdl.yaml\n",
+		"engines/wdl.yaml": "- type: wdl\n  engine: cromwell\n",
+	})
+
+	resolved, err := Preprocess(directory, []byte("name: demo\ncontexts: !include contexts.yaml\n"))
+	require.NoError(t, err)
+	assert.Equal(t, `name: demo
+contexts:
+  ctx1:
+    engines:
+      - type: wdl
+        engine: cromwell
+`, string(resolved))
+}
+
+func TestPreprocess_IncludesList(t *testing.T) {
+	t.Setenv("AGC_TEST_ENV", "prod")
+	directory := writePreprocessTestFiles(t, map[string]string{
+		"contexts.yaml":        "contexts:\n  ctx1:\n    engines:\n      - type: wdl\n        engine: cromwell\n",
+		"workflows/hello.yaml": "workflows:\n  hello:\n    type: {language: wdl, version: 1.0}\n    sourceURL: workflows/hello\n",
+		"workflows/bye.yaml":   "workflows:\n  bye:\n    type: {language: wdl, version: 1.0}\n    sourceURL: workflows/bye\n",
+		"data/prod.yaml":       "data:\n  - location: s3://prod-bucket/reads\n",
+	})
+
+	resolved, err := Preprocess(directory, []byte(`name: demo
+includes:
+  - contexts.yaml
+  - workflows/*.yaml
+  - data/${AGC_TEST_ENV}.yaml
+data:
+  - location: s3://shared/reference
+`))
+	require.NoError(t, err)
+	assert.Equal(t, `name: demo
+data:
+  - location: s3://shared/reference
+  - location: s3://prod-bucket/reads
+contexts:
+  ctx1:
+    engines:
+      - type: wdl
+        engine: cromwell
+workflows:
+  bye:
+    type: {language: wdl, version: 1.0}
+    sourceURL: workflows/bye
+  hello:
+    type: {language: wdl, version: 1.0}
+    sourceURL: workflows/hello
+`, string(resolved))
+}
+
+func TestPreprocess_IncludeErrors(t *testing.T) {
+	directory := writePreprocessTestFiles(t, map[string]string{
+		"self.yaml":      "contexts: !include self.yaml\n",
+		"duplicate.yaml": "workflows:\n  hello:\n    sourceURL: other\n",
+		"name.yaml":      "name: other\n",
+		"list.yaml":      "- a\n",
+	})
+	testCases := map[string]struct {
+		yaml        string
+		expectedErr string
+	}{
+		"missing file": {
+			yaml:        "contexts: !include missing.yaml\n",
+			expectedErr: "contexts: unable to include 'missing.yaml': open " + filepath.Join(directory, "missing.yaml") + ": no such file or directory",
+		},
+		"no match": {
+			yaml:        "includes: [workflows/*.yaml]\n",
+			expectedErr: "includes: no file matches 'workflows/*.yaml'",
+		},
+		"not a list": {
+			yaml:        "includes: contexts.yaml\n",
+			expectedErr: "includes: must be a list of files",
+		},
+		"cycle": {
+			yaml:        "includes: [self.yaml]\n",
+			expectedErr: "contexts: 'self.yaml' includes itself",
+		},
+		"duplicate entry": {
+			yaml:        "workflows:\n  hello:\n    sourceURL: hello\nincludes: [duplicate.yaml]\n",
+			expectedErr: "workflows.hello: defined in the project and again in 'duplicate.yaml'",
+		},
+		"duplicate scalar": {
+			yaml:        "name: demo\nincludes: [name.yaml]\n",
+			expectedErr: "name: defined in the project and again in 'name.yaml'",
+		},
+		"not a mapping": {
+			yaml:        "includes: [list.yaml]\n",
+			expectedErr: "includes: 'list.yaml' must contain a mapping of project keys",
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			_, err := Preprocess(directory, []byte(tc.yaml))
+			assert.EqualError(t, err, tc.expectedErr)
+		})
+	}
+}
+
+func TestFromYaml_Preprocessed(t *testing.T) {
+	t.Setenv("AGC_TEST_BUCKET", "team-bucket")
+	directory := writePreprocessTestFiles(t, map[string]string{
+		"agc-project.yaml": "name: demo\nschemaVersion: 1\nincludes: [contexts.yaml]\ndata:\n  - location: s3://${AGC_TEST_BUCKET}/reads\n",
+		"contexts.yaml":    "contexts:\n  ctx1:\n    engines:\n      - type: wdl\n        engine: cromwell\n",
+	})
+
+	projectSpec, err := FromYaml(filepath.Join(directory, "agc-project.yaml"))
+	require.NoError(t, err)
+	assert.Equal(t, []Data{{Location: "s3://team-bucket/reads"}}, projectSpec.Data)
+	assert.Equal(t, []Engine{{Type: "wdl", Engine: "cromwell"}}, projectSpec.Contexts["ctx1"].Engines)
+}
+
+func TestFromYaml_PreprocessedProblemsAreLocatedInTheirFile(t *testing.T) {
+	t.Setenv("AGC_TEST_BUCKET", "team-bucket")
+	directory := writePreprocessTestFiles(t, map[string]string{
+		"agc-project.yaml": `# a comment that re-encoding drops
+name: demo
+schemaVersion: 1
+includes: [contexts.yaml]
+data:
+  - location: s3://${AGC_TEST_BUCKET}/reads
+    readOnly: maybe
+workflows:
+  hello: !include workflows/hello.yaml
+`,
+		"contexts.yaml": `contexts:
+  ctx1:
+    engines:
+      - type: wdl
+        engine: cromwell
+    maxVCpus: many
+`,
+		"workflows/hello.yaml": `type:
+  language: wdl
+  version: 1.0
+sourceURL: 42
+`,
+	})
+
+	_, err := FromYaml(filepath.Join(directory, "agc-project.yaml"))
+
+	var validationErr *ValidationError
+	require.True(t, errors.As(err, &validationErr), "expected a validation error but got %v", err)
+	assert.ElementsMatch(t, []Problem{
+		{File: "contexts.yaml", Line: 6, Column: 15, Path: "contexts.ctx1.maxVCpus", Message: "Invalid type. Expected: integer, given: string"},
+		{Line: 7, Column: 15, Path: "data.0.readOnly", Message: "Invalid type. Expected: boolean, given: string"},
+		{File: filepath.Join("workflows", "hello.yaml"), Line: 4, Column: 12, Path: "workflows.hello.sourceURL", Message: "Invalid type. Expected: string, given: integer"},
+	}, validationErr.Problems)
+	assert.Contains(t, err.Error(), "contexts.yaml:6:15: contexts.ctx1.maxVCpus: Invalid type. Expected: integer, given: string\n\t     5 |         engine: cromwell\n\t   > 6 |     maxVCpus: many\n")
+	assert.Contains(t, err.Error(), "agc-project.yaml:7:15: data.0.readOnly")
+}
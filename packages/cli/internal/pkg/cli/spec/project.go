@@ -9,13 +9,17 @@ import (
 const LatestVersion = 1
 
 type Project struct {
-	Name          string              `yaml:"name"`
-	SchemaVersion int                 `yaml:"schemaVersion"`
-	Workflows     map[string]Workflow `yaml:"workflows,omitempty"`
-	Data          []Data              `yaml:"data,omitempty"`
-	Contexts      map[string]Context  `yaml:"contexts,omitempty"`
+	Name            string              `yaml:"name"`
+	SchemaVersion   int                 `yaml:"schemaVersion"`
+	Workflows       map[string]Workflow `yaml:"workflows,omitempty"`
+	Data            []Data              `yaml:"data,omitempty"`
+	ContextDefaults *Context            `yaml:"contextDefaults,omitempty"`
+	Contexts        map[string]Context  `yaml:"contexts,omitempty"`
+	Tags            map[string]string   `yaml:"tags,omitempty"`
 }
 
+// GetContext returns the named context. Contexts of a project read with FromYaml have already been merged with the
+// project contextDefaults and the contexts they extend.
 func (projectSpec *Project) GetContext(contextName string) (Context, error) {
 	contextSpec, ok := projectSpec.Contexts[contextName]
 	if !ok {
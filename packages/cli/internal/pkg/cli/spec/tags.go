@@ -0,0 +1,148 @@
+package spec
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/aws/amazon-genomics-cli/internal/pkg/constants"
+)
+
+const (
+	tagsKey      = "tags"
+	awsTagPrefix = "aws:"
+)
+
+// reservedTagKeys are the tags that AGC sets on the resources of every context
+var reservedTagKeys = []string{
+	constants.AppTagKey,
+	constants.ProjectTagKey,
+	constants.ContextTagKey,
+	constants.UserIdTagKey,
+	"agc-user-email",
+	constants.AgcVersionKey,
+	"agc-engine",
+	"agc-engine-type",
+}
+
+// Tag is a cost allocation tag and the place it is declared
+type Tag struct {
+	Key    string
+	Value  string
+	Source string
+}
+
+// TagSource is a set of tags declared in one place, such as the account, the project or a context
+type TagSource struct {
+	Name string
+	Tags map[string]string
+}
+
+// TagConflict is a tag that is declared with different values in two places
+type TagConflict struct {
+	Key              string
+	Value            string
+	Source           string
+	OverriddenValue  string
+	OverriddenSource string
+}
+
+func (c TagConflict) String() string {
+	return fmt.Sprintf("tag '%s' of the %s overrides the value '%s' of the %s with '%s'",
+		c.Key, c.Source, c.OverriddenValue, c.OverriddenSource, c.Value)
+}
+
+// MergeTags merges sets of tags in increasing order of precedence. The merged tags are sorted by key and every
+// tag that overrides a different value of a lower precedence source is reported as a conflict.
+func MergeTags(sources ...TagSource) ([]Tag, []TagConflict) {
+	merged := make(map[string]Tag)
+	var conflicts []TagConflict
+	for _, source := range sources {
+		keys := make([]string, 0, len(source.Tags))
+		for key := range source.Tags {
+			keys = append(keys, key)
+		}
+		sort.Strings(keys)
+		for _, key := range keys {
+			value := source.Tags[key]
+			if previous, ok := merged[key]; ok && previous.Value != value {
+				conflicts = append(conflicts, TagConflict{
+					Key:              key,
+					Value:            value,
+					Source:           source.Name,
+					OverriddenValue:  previous.Value,
+					OverriddenSource: previous.Source,
+				})
+			}
+			merged[key] = Tag{Key: key, Value: value, Source: source.Name}
+		}
+	}
+
+	var tags []Tag
+	for _, tag := range merged {
+		tags = append(tags, tag)
+	}
+	sort.Slice(tags, func(i, j int) bool {
+		return tags[i].Key < tags[j].Key
+	})
+	return tags, conflicts
+}
+
+// TagMap returns the keys and values of tags
+func TagMap(tags []Tag) map[string]string {
+	tagMap := make(map[string]string, len(tags))
+	for _, tag := range tags {
+		tagMap[tag.Key] = tag.Value
+	}
+	return tagMap
+}
+
+// validateTags checks that the tags of the project and of its contexts do not use keys reserved by AWS or AGC.
+// The returned errors are formatted like schema validation errors.
+func validateTags(document interface{}) []string {
+	projectMap, ok := document.(map[string]interface{})
+	if !ok {
+		return nil
+	}
+	errors := tagKeyErrors(tagsKey, projectMap[tagsKey])
+	if defaults, ok := projectMap[contextDefaultsKey].(map[string]interface{}); ok {
+		errors = append(errors, tagKeyErrors(contextDefaultsKey+"."+tagsKey, defaults[tagsKey])...)
+	}
+	contexts, _ := projectMap[contextsKey].(map[string]interface{})
+	contextNames := make([]string, 0, len(contexts))
+	for contextName := range contexts {
+		contextNames = append(contextNames, contextName)
+	}
+	sort.Strings(contextNames)
+	for _, contextName := range contextNames {
+		if context, ok := contexts[contextName].(map[string]interface{}); ok {
+			errors = append(errors, tagKeyErrors(fmt.Sprintf("%s.%s.%s", contextsKey, contextName, tagsKey), context[tagsKey])...)
+		}
+	}
+	return errors
+}
+
+func tagKeyErrors(path string, tags interface{}) []string {
+	tagMap, ok := tags.(map[string]interface{})
+	if !ok {
+		return nil
+	}
+	keys := make([]string, 0, len(tagMap))
+	for key := range tagMap {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	var errors []string
+	for _, key := range keys {
+		if strings.HasPrefix(strings.ToLower(key), awsTagPrefix) {
+			errors = append(errors, fmt.Sprintf("%s: tag keys starting with '%s' are reserved by AWS", path, awsTagPrefix))
+			continue
+		}
+		for _, reservedKey := range reservedTagKeys {
+			if key == reservedKey {
+				errors = append(errors, fmt.Sprintf("%s: tag '%s' is set by AGC and cannot be overridden", path, key))
+			}
+		}
+	}
+	return errors
+}
@@ -0,0 +1,37 @@
+package spec
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMergeTags(t *testing.T) {
+	tags, conflicts := MergeTags(
+		TagSource{Name: "account", Tags: map[string]string{"team": "platform", "cost-center": "1234"}},
+		TagSource{Name: "project", Tags: map[string]string{"team": "research", "project": "demo"}},
+		TagSource{Name: "context 'ctx'", Tags: map[string]string{"project": "demo"}},
+	)
+
+	assert.Equal(t, []Tag{
+		{Key: "cost-center", Value: "1234", Source: "account"},
+		{Key: "project", Value: "demo", Source: "context 'ctx'"},
+		{Key: "team", Value: "research", Source: "project"},
+	}, tags)
+	assert.Equal(t, []TagConflict{
+		{Key: "team", Value: "research", Source: "project", OverriddenValue: "platform", OverriddenSource: "account"},
+	}, conflicts)
+	assert.Equal(t, "tag 'team' of the project overrides the value 'platform' of the account with 'research'", conflicts[0].String())
+}
+
+func TestMergeTags_Empty(t *testing.T) {
+	tags, conflicts := MergeTags(TagSource{Name: "account"}, TagSource{Name: "project"})
+	assert.Empty(t, tags)
+	assert.Empty(t, conflicts)
+	assert.Empty(t, TagMap(tags))
+}
+
+func TestTagMap(t *testing.T) {
+	tags := []Tag{{Key: "k1", Value: "v1", Source: "account"}, {Key: "k2", Value: "v2", Source: "project"}}
+	assert.Equal(t, map[string]string{"k1": "v1", "k2": "v2"}, TagMap(tags))
+}
@@ -0,0 +1,129 @@
+package spec
+
+import (
+	"bytes"
+	"fmt"
+	"strings"
+)
+
+const (
+	defaultSpecFileName = "agc-project.yaml"
+	rootPath            = "(root)"
+	codeFrameContext    = 1
+)
+
+// Problem is a reason why a project specification is invalid, located at the line and column of the offending value.
+// The line is 0 when the problem cannot be located in the file. The file is empty for the project specification and
+// otherwise the included file that declares the value, relative to the project.
+type Problem struct {
+	File    string
+	Line    int
+	Column  int
+	Path    string
+	Message string
+}
+
+// ValidationError lists the problems that make a project specification invalid
+type ValidationError struct {
+	FileName string
+	Problems []Problem
+	sources  map[string][]byte
+}
+
+// newValidationError locates problems described as "path: message" in the project specification
+func newValidationError(fileName string, yamlBytes []byte, descriptions []string) *ValidationError {
+	locator, _ := NewLocator(yamlBytes)
+	return locateProblems(fileName, locator, map[string][]byte{"": yamlBytes}, descriptions)
+}
+
+// locateProblems locates problems described as "path: message" with a locator. The sources hold the contents of the
+// files that the problems can be located in, keyed by their name relative to the project and "" for the project file.
+func locateProblems(fileName string, locator *Locator, sources map[string][]byte, descriptions []string) *ValidationError {
+	problems := make([]Problem, 0, len(descriptions))
+	for _, description := range descriptions {
+		problem := Problem{Message: description}
+		if separator := strings.Index(description, ": "); separator >= 0 {
+			problem.Path, problem.Message = description[:separator], description[separator+2:]
+		}
+		var path []string
+		if problem.Path != "" && problem.Path != rootPath {
+			path = strings.Split(problem.Path, ".")
+		}
+		problem.File, problem.Line, problem.Column = locator.LocateInFile(path...)
+		problems = append(problems, problem)
+	}
+	return &ValidationError{FileName: fileName, Problems: problems, sources: sources}
+}
+
+func (e *ValidationError) Error() string {
+	var errBuffer bytes.Buffer
+	errBuffer.WriteString("\n")
+	for idx, problem := range e.Problems {
+		errBuffer.WriteString(fmt.Sprintf("\t%d. %s: %s: %s\n", idx+1, e.Position(problem), problem.Path, problem.Message))
+		for _, frameLine := range CodeFrame(e.sources[problem.File], problem.Line, problem.Column) {
+			errBuffer.WriteString(fmt.Sprintf("\t   %s\n", frameLine))
+		}
+	}
+	return errBuffer.String()
+}
+
+// Position renders the location of a problem as file:line:column
+func (e *ValidationError) Position(problem Problem) string {
+	fileName := e.FileName
+	if fileName == "" {
+		fileName = defaultSpecFileName
+	}
+	if problem.File != "" {
+		fileName = problem.File
+	}
+	if problem.Line == 0 {
+		return fileName
+	}
+	return fmt.Sprintf("%s:%d:%d", fileName, problem.Line, problem.Column)
+}
+
+// CodeFrame returns the lines of the source around a line, followed by a marker under the column. No lines are
+// returned when the line is not in the source.
+func CodeFrame(source []byte, line, column int) []string {
+	lines := strings.Split(strings.TrimRight(string(source), "\n"), "\n")
+	if line < 1 || line > len(lines) {
+		return nil
+	}
+	first, last := line-codeFrameContext, line+codeFrameContext
+	if first < 1 {
+		first = 1
+	}
+	if last > len(lines) {
+		last = len(lines)
+	}
+	width := len(fmt.Sprint(last))
+
+	var frame []string
+	for number := first; number <= last; number++ {
+		marker := " "
+		if number == line {
+			marker = ">"
+		}
+		frame = append(frame, strings.TrimRight(fmt.Sprintf("%s %*d | %s", marker, width, number, lines[number-1]), " "))
+		if number == line && column > 0 {
+			frame = append(frame, fmt.Sprintf("  %*s | %s^", width, "", caretIndent(lines[number-1], column)))
+		}
+	}
+	return frame
+}
+
+// caretIndent keeps the tabs of a line so that the caret lines up with the column
+func caretIndent(line string, column int) string {
+	var indent strings.Builder
+	for i, r := range []rune(line) {
+		if i >= column-1 {
+			break
+		}
+		if r == '\t' {
+			indent.WriteRune('\t')
+		} else {
+			indent.WriteRune(' ')
+		}
+	}
+	return indent.String()
+}
@@ -0,0 +1,79 @@
+package spec
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// problemDescriptions renders the problems of a validation error without their location
+func problemDescriptions(t *testing.T, err error) string {
+	var validationErr *ValidationError
+	require.True(t, errors.As(err, &validationErr), "expected a validation error but got %v", err)
+	var descriptions strings.Builder
+	descriptions.WriteString("\n")
+	for idx, problem := range validationErr.Problems {
+		descriptions.WriteString(fmt.Sprintf("\t%d. %s: %s\n", idx+1, problem.Path, problem.Message))
+	}
+	return descriptions.String()
+}
+
+func TestValidationError_Error(t *testing.T) {
+	yaml := `name: Demo
+schemaVersion: 1
+contexts:
+  twoEngines:
+    engines:
+      - type: wdl
+        engine: cromwell
+      - type: nextflow
+        engine: nextflow
+`
+	err := ValidateProject([]byte(yaml))
+
+	var validationErr *ValidationError
+	require.True(t, errors.As(err, &validationErr))
+	assert.Equal(t, []Problem{
+		{Line: 5, Column: 5, Path: "contexts.twoEngines.engines", Message: "Array must have at most 1 items"},
+	}, validationErr.Problems)
+	assert.Equal(t, `
+	1. agc-project.yaml:5:5: contexts.twoEngines.engines: Array must have at most 1 items
+	     4 |   twoEngines:
+	   > 5 |     engines:
+	       |     ^
+	     6 |       - type: wdl
+`, err.Error())
+}
+
+func TestValidationError_RootProblem(t *testing.T) {
+	err := ValidateProject([]byte(`name: foo`))
+
+	var validationErr *ValidationError
+	require.True(t, errors.As(err, &validationErr))
+	assert.Equal(t, "agc-project.yaml:1:1", validationErr.Position(validationErr.Problems[0]))
+	assert.Equal(t, "\n\t1. agc-project.yaml:1:1: (root): contexts is required\n\t   > 1 | name: foo\n\t       | ^\n", err.Error())
+}
+
+func TestValidationError_Position(t *testing.T) {
+	validationErr := &ValidationError{FileName: "project.yaml"}
+	assert.Equal(t, "project.yaml:3:7", validationErr.Position(Problem{Line: 3, Column: 7}))
+	assert.Equal(t, "project.yaml", validationErr.Position(Problem{}))
+}
+
+func TestCodeFrame(t *testing.T) {
+	source := []byte("a: 1\nb:\n\tc: 2\nd: 3\n")
+
+	assert.Equal(t, []string{
+		"  2 | b:",
+		"> 3 | \tc: 2",
+		"    | \t^",
+		"  4 | d: 3",
+	}, CodeFrame(source, 3, 2))
+	assert.Equal(t, []string{"> 1 | a: 1", "    | ^", "  2 | b:"}, CodeFrame(source, 1, 1))
+	assert.Nil(t, CodeFrame(source, 0, 0))
+	assert.Nil(t, CodeFrame(source, 9, 1))
+}
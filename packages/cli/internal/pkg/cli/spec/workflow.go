@@ -1,11 +1,139 @@
 package spec
 
+import (
+	"fmt"
+	"math"
+	"sort"
+)
+
+const (
+	InputTypeString  = "string"
+	InputTypeInteger = "integer"
+	InputTypeNumber  = "number"
+	InputTypeBoolean = "boolean"
+	InputTypeArray   = "array"
+	InputTypeObject  = "object"
+	// InputTypeFile is a string naming a file, either a path relative to the inputs file or a URL
+	InputTypeFile = "file"
+)
+
 type Workflow struct {
-	Type      WorkflowType `yaml:"type"`
-	SourceURL string       `yaml:"sourceURL"`
+	Type          WorkflowType              `yaml:"type"`
+	SourceURL     string                    `yaml:"sourceURL"`
+	Version       string                    `yaml:"version,omitempty"`
+	Description   string                    `yaml:"description,omitempty"`
+	DefaultInputs map[string]interface{}    `yaml:"defaultInputs,omitempty"`
+	InputSchema   map[string]InputParameter `yaml:"inputSchema,omitempty"`
 }
 
 type WorkflowType struct {
 	Language string `yaml:"language"`
 	Version  string `yaml:"version"`
 }
+
+// InputParameter describes an input of a workflow
+type InputParameter struct {
+	Type        string `yaml:"type"`
+	Required    bool   `yaml:"required,omitempty"`
+	Description string `yaml:"description,omitempty"`
+}
+
+// IsFile returns true when the input names a file rather than holding a value
+func (parameter InputParameter) IsFile() bool {
+	return parameter.Type == InputTypeFile
+}
+
+// GetInputs returns the default inputs of the workflow overridden by the given inputs
+func (workflow Workflow) GetInputs(inputs map[string]interface{}) map[string]interface{} {
+	if len(workflow.DefaultInputs) == 0 {
+		return inputs
+	}
+	merged := make(map[string]interface{}, len(workflow.DefaultInputs)+len(inputs))
+	for name, value := range workflow.DefaultInputs {
+		merged[name] = value
+	}
+	for name, value := range inputs {
+		merged[name] = value
+	}
+	return merged
+}
+
+// ValidateInputs checks inputs decoded from JSON, or default inputs decoded from YAML, against the input schema of the
+// workflow. It returns a description of each required input that is missing and each input whose value does not have
+// the type of its parameter.
+func (workflow Workflow) ValidateInputs(inputs map[string]interface{}) []string {
+	var problems []string
+	for _, name := range workflow.InputNames() {
+		parameter := workflow.InputSchema[name]
+		value, ok := inputs[name]
+		if !ok || value == nil {
+			if parameter.Required {
+				problems = append(problems, fmt.Sprintf("%s: required input is missing", name))
+			}
+			continue
+		}
+		if !hasInputType(value, parameter.Type) {
+			problems = append(problems, fmt.Sprintf("%s: expected type '%s' but got %s", name, parameter.Type, describeInputValue(value)))
+		}
+	}
+	return problems
+}
+
+// InputNames returns the names of the inputs in the schema, in alphabetical order
+func (workflow Workflow) InputNames() []string {
+	names := make([]string, 0, len(workflow.InputSchema))
+	for name := range workflow.InputSchema {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+func hasInputType(value interface{}, inputType string) bool {
+	switch inputType {
+	case InputTypeString:
+		_, ok := value.(string)
+		return ok
+	case InputTypeFile:
+		text, ok := value.(string)
+		return ok && text != ""
+	case InputTypeInteger:
+		if _, ok := value.(int); ok {
+			return true
+		}
+		number, ok := value.(float64)
+		return ok && number == math.Trunc(number)
+	case InputTypeNumber:
+		switch value.(type) {
+		case int, float64:
+			return true
+		}
+		return false
+	case InputTypeBoolean:
+		_, ok := value.(bool)
+		return ok
+	case InputTypeArray:
+		_, ok := value.([]interface{})
+		return ok
+	case InputTypeObject:
+		_, ok := value.(map[string]interface{})
+		return ok
+	}
+	return true
+}
+
+func describeInputValue(value interface{}) string {
+	switch typedValue := value.(type) {
+	case string:
+		return fmt.Sprintf("the string '%s'", typedValue)
+	case int, float64:
+		return fmt.Sprintf("the number %v", typedValue)
+	case bool:
+		return fmt.Sprintf("the boolean %t", typedValue)
+	case []interface{}:
+		return "an array"
+	case map[string]interface{}:
+		return "an object"
+	}
+	return fmt.Sprintf("%v", value)
+}
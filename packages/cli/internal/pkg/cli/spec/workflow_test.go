@@ -0,0 +1,95 @@
+package spec
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gopkg.in/yaml.v3"
+)
+
+const inputSchemaTestYaml = `type:
+  language: wdl
+  version: 1.0
+sourceURL: workflows/align
+version: 2.1.0
+description: Aligns reads to a reference
+defaultInputs:
+  align.threads: 4
+  align.reference: s3://bucket/reference.fa
+inputSchema:
+  align.reads:
+    type: file
+    required: true
+    description: The reads to align
+  align.reference:
+    type: file
+    required: true
+  align.threads:
+    type: integer
+  align.sample:
+    type: string
+`
+
+func decodeTestWorkflow(t *testing.T) Workflow {
+	var workflow Workflow
+	require.NoError(t, yaml.Unmarshal([]byte(inputSchemaTestYaml), &workflow))
+	return workflow
+}
+
+func TestWorkflow_GetInputs(t *testing.T) {
+	workflow := decodeTestWorkflow(t)
+
+	inputs := workflow.GetInputs(map[string]interface{}{"align.reads": "reads.fastq", "align.threads": float64(8)})
+	assert.Equal(t, map[string]interface{}{
+		"align.reads":     "reads.fastq",
+		"align.reference": "s3://bucket/reference.fa",
+		"align.threads":   float64(8),
+	}, inputs)
+}
+
+func TestWorkflow_GetInputs_NoDefaults(t *testing.T) {
+	assert.Nil(t, Workflow{}.GetInputs(nil))
+}
+
+func TestWorkflow_ValidateInputs(t *testing.T) {
+	workflow := decodeTestWorkflow(t)
+	testCases := map[string]struct {
+		inputs           string
+		expectedProblems []string
+	}{
+		"valid": {
+			inputs: `{"align.reads": "reads.fastq", "align.threads": 8, "align.sample": "NA12878"}`,
+		},
+		"missing required": {
+			inputs:           `{"align.threads": 8}`,
+			expectedProblems: []string{"align.reads: required input is missing"},
+		},
+		"wrong types": {
+			inputs: `{"align.reads": "", "align.threads": 2.5, "align.sample": 12878}`,
+			expectedProblems: []string{
+				"align.reads: expected type 'file' but got the string ''",
+				"align.sample: expected type 'string' but got the number 12878",
+				"align.threads: expected type 'integer' but got the number 2.5",
+			},
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			var inputs map[string]interface{}
+			require.NoError(t, json.Unmarshal([]byte(tc.inputs), &inputs))
+			assert.Equal(t, tc.expectedProblems, workflow.ValidateInputs(workflow.GetInputs(inputs)))
+		})
+	}
+}
+
+func TestWorkflow_ValidateInputs_YamlDefaults(t *testing.T) {
+	workflow := decodeTestWorkflow(t)
+
+	assert.Empty(t, workflow.ValidateInputs(workflow.GetInputs(map[string]interface{}{"align.reads": "reads.fastq"})))
+	assert.Equal(t, "2.1.0", workflow.Version)
+	assert.Equal(t, "Aligns reads to a reference", workflow.Description)
+	assert.True(t, workflow.InputSchema["align.reads"].IsFile())
+	assert.Equal(t, []string{"align.reads", "align.reference", "align.sample", "align.threads"}, workflow.InputNames())
+}
@@ -0,0 +1,45 @@
+package types
+
+type AccountCheck struct {
+	Check           string
+	Status          string
+	Details         string
+	SuggestedAction string
+}
+
+type Account struct {
+	Region          string
+	StackStatus     string
+	AgcVersion      string
+	CliVersion      string
+	VersionMismatch bool
+	Bucket          string
+	VpcId           string
+	Subnets         string
+	AmiId           string
+	Tags            []AccountTag
+}
+
+type AccountTag struct {
+	Key   string
+	Value string
+}
+
+type AccountUpgradeStep struct {
+	Stack           string
+	Project         string
+	Context         string
+	Owner           string
+	DeployedVersion string
+	TargetVersion   string
+	Action          string
+	Details         string
+	Result          string
+}
+
+type AccountResource struct {
+	Type   string
+	Name   string
+	Action string
+	Reason string
+}
@@ -2,6 +2,7 @@ package types
 
 type Context struct {
 	Name                 string
+	Region               string
 	Status               string
 	StatusReason         string
 	MaxVCpus             int
@@ -9,6 +10,8 @@ type Context struct {
 	InstanceTypes        []InstanceType
 	Output               OutputLocation
 	WesEndpoint          WesEndpoint
+	Tags                 []ContextTag
+	Data                 []ContextData
 }
 
 type ContextInstance struct {
@@ -24,6 +27,16 @@ type ContextInstance struct {
 type ContextSummary struct {
 	Name       string
 	EngineName string
+	Region     string
+}
+
+type ContextDeployment struct {
+	Id         string
+	DeployedAt string
+	DeployedBy string
+	AgcVersion string
+	Images     string
+	RollbackOf string
 }
 
 type OutputLocation struct {
@@ -37,3 +50,19 @@ type WesEndpoint struct {
 type InstanceType struct {
 	Value string
 }
+
+type ContextTag struct {
+	Key    string
+	Value  string
+	Source string
+}
+
+// ContextData is the access of a context to a data location, with the policies a cross-account bucket owner must add
+type ContextData struct {
+	Location      string
+	ReadOnly      bool
+	RequesterPays bool
+	KmsKeyArn     string
+	BucketOwner   string
+	PolicyHint    string
+}
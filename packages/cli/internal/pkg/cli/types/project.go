@@ -4,3 +4,27 @@ type Project struct {
 	Name string
 	Data []Data
 }
+
+// ProjectDiagnostic is a problem found in the project specification, located at the line and column of the offending value
+type ProjectDiagnostic struct {
+	Severity string
+	File     string
+	Line     int
+	Column   int
+	Path     string
+	Message  string
+}
+
+// ProjectTemplate is a built-in template of 'agc project init --template'
+type ProjectTemplate struct {
+	Name        string
+	Description string
+}
+
+// DataCheck is the result of checking an S3 URI referenced by the inputs of the project
+type DataCheck struct {
+	Uri    string
+	Source string
+	Status string
+	Detail string
+}
@@ -5,6 +5,24 @@ type Workflow struct {
 	TypeLanguage string
 	TypeVersion  string
 	Source       string
+	Version      string
+	Description  string
+	Parameters   []WorkflowParameter
+}
+
+type WorkflowParameter struct {
+	Name        string
+	Type        string
+	Required    bool
+	Default     string
+	Description string
+}
+
+type WorkflowInput struct {
+	Name     string
+	Type     string
+	Required bool
+	Default  string
 }
 
 type WorkflowName struct {
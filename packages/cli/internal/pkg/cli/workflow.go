@@ -28,6 +28,7 @@ typed according to which workflow definition language they use (e.g. WDL).
 	cmd.AddCommand(BuildWorkflowListCommand())
 	cmd.AddCommand(BuildWorkflowStatusCommand())
 	cmd.AddCommand(BuildWorkflowDescribeCommand())
+	cmd.AddCommand(BuildWorkflowInputsCommand())
 	cmd.AddCommand(BuildWorkflowStopCommand())
 	cmd.AddCommand(BuildWorkflowOutputCommand())
 
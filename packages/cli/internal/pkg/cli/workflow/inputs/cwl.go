@@ -0,0 +1,143 @@
+package inputs
+
+import (
+	"fmt"
+	"os"
+	"sort"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+const (
+	cwlFileType      = "File"
+	cwlDirectoryType = "Directory"
+	cwlNullType      = "null"
+)
+
+// ParseCWL returns the inputs of a CWL workflow or tool, in YAML or JSON. In a packed document the '#main' process is
+// used.
+func ParseCWL(path string) ([]Parameter, error) {
+	source, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	var document interface{}
+	if err := yaml.Unmarshal(source, &document); err != nil {
+		return nil, fmt.Errorf("unable to parse '%s': %w", path, err)
+	}
+	process, ok := cwlMainProcess(document)
+	if !ok {
+		return nil, fmt.Errorf("'%s' does not define a CWL process", path)
+	}
+
+	var parameters []Parameter
+	switch inputs := process["inputs"].(type) {
+	case map[string]interface{}:
+		names := make([]string, 0, len(inputs))
+		for name := range inputs {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+		for _, name := range names {
+			parameters = append(parameters, cwlParameter(name, inputs[name]))
+		}
+	case []interface{}:
+		for _, input := range inputs {
+			if fields, ok := input.(map[string]interface{}); ok {
+				id, _ := fields["id"].(string)
+				parameters = append(parameters, cwlParameter(id, fields))
+			}
+		}
+	}
+	return parameters, nil
+}
+
+func cwlMainProcess(document interface{}) (map[string]interface{}, bool) {
+	root, ok := document.(map[string]interface{})
+	if !ok {
+		return nil, false
+	}
+	graph, ok := root["$graph"].([]interface{})
+	if !ok {
+		return root, true
+	}
+	var workflow map[string]interface{}
+	for _, item := range graph {
+		process, ok := item.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		if id, _ := process["id"].(string); id == "#main" || id == "main" {
+			return process, true
+		}
+		if workflow == nil && process["class"] == "Workflow" {
+			workflow = process
+		}
+	}
+	return workflow, workflow != nil
+}
+
+// cwlParameter converts an input given either as its type or as a mapping with a type, and optionally a default
+func cwlParameter(id string, input interface{}) Parameter {
+	name := id[strings.LastIndex(id, "/")+1:]
+	name = strings.TrimPrefix(name, "#")
+	inputType := input
+	var defaultValue interface{}
+	hasDefault := false
+	if fields, ok := input.(map[string]interface{}); ok && fields["type"] != nil {
+		inputType = fields["type"]
+		defaultValue = fields["default"]
+		hasDefault = defaultValue != nil
+	}
+	typeName, optional := cwlTypeName(inputType)
+	parameter := Parameter{
+		Name:       name,
+		Type:       typeName,
+		Required:   !optional && !hasDefault,
+		Default:    defaultValue,
+		HasDefault: hasDefault,
+	}
+	if parameter.Required && (typeName == cwlFileType || typeName == cwlDirectoryType) {
+		parameter.Placeholder = map[string]interface{}{"class": typeName, "path": typeName}
+	}
+	return parameter
+}
+
+// cwlTypeName renders a CWL type and returns whether it allows null
+func cwlTypeName(inputType interface{}) (string, bool) {
+	switch typed := inputType.(type) {
+	case string:
+		if strings.HasSuffix(typed, "?") {
+			return typed, true
+		}
+		return typed, typed == cwlNullType
+	case []interface{}:
+		optional := false
+		var names []string
+		for _, item := range typed {
+			name, _ := cwlTypeName(item)
+			if name == cwlNullType {
+				optional = true
+				continue
+			}
+			names = append(names, name)
+		}
+		name := strings.Join(names, " | ")
+		if optional && len(names) == 1 {
+			name += "?"
+		}
+		return name, optional
+	case map[string]interface{}:
+		switch typed["type"] {
+		case "array":
+			items, _ := cwlTypeName(typed["items"])
+			return items + "[]", false
+		case "enum":
+			return "enum", false
+		case "record":
+			return "record", false
+		}
+	}
+	return "", false
+}
@@ -0,0 +1,69 @@
+package inputs
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseCWL_MapInputs(t *testing.T) {
+	directory := writeTestFiles(t, map[string]string{"main.cwl": `cwlVersion: v1.2
+class: Workflow
+inputs:
+  words: File
+  vowels: string[]
+  reference:
+    type: Directory
+  threads:
+    type: int
+    default: 4
+  label: string?
+  mode:
+    type: ["null", string]
+  samples:
+    type: {type: array, items: File}
+outputs: {}
+steps: {}
+`})
+
+	parameters, err := ParseCWL(filepath.Join(directory, "main.cwl"))
+	require.NoError(t, err)
+	assert.Equal(t, []Parameter{
+		{Name: "label", Type: "string?"},
+		{Name: "mode", Type: "string?"},
+		{Name: "reference", Type: "Directory", Required: true, Placeholder: map[string]interface{}{"class": "Directory", "path": "Directory"}},
+		{Name: "samples", Type: "File[]", Required: true},
+		{Name: "threads", Type: "int", Default: 4, HasDefault: true},
+		{Name: "vowels", Type: "string[]", Required: true},
+		{Name: "words", Type: "File", Required: true, Placeholder: map[string]interface{}{"class": "File", "path": "File"}},
+	}, parameters)
+}
+
+func TestParseCWL_PackedListInputs(t *testing.T) {
+	directory := writeTestFiles(t, map[string]string{"packed.cwl": `{
+  "cwlVersion": "v1.2",
+  "$graph": [
+    {"class": "CommandLineTool", "id": "#tool", "inputs": [{"id": "#tool/ignored", "type": "string"}]},
+    {"class": "Workflow", "id": "#main", "inputs": [
+      {"id": "#main/message", "type": "string", "default": "Hello world!"},
+      {"id": "#main/count", "type": "int"}
+    ]}
+  ]
+}`})
+
+	parameters, err := ParseCWL(filepath.Join(directory, "packed.cwl"))
+	require.NoError(t, err)
+	assert.Equal(t, []Parameter{
+		{Name: "message", Type: "string", Default: "Hello world!", HasDefault: true},
+		{Name: "count", Type: "int", Required: true},
+	}, parameters)
+}
+
+func TestParseCWL_NotAProcess(t *testing.T) {
+	directory := writeTestFiles(t, map[string]string{"list.cwl": "- a\n"})
+
+	_, err := ParseCWL(filepath.Join(directory, "list.cwl"))
+	assert.EqualError(t, err, "'"+filepath.Join(directory, "list.cwl")+"' does not define a CWL process")
+}
@@ -0,0 +1,270 @@
+package inputs
+
+import (
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+const nextflowConfigFileName = "nextflow.config"
+
+var (
+	nextflowAssignmentPattern = regexp.MustCompile(`^\s*params\.([A-Za-z_][\w.]*)\s*=\s*(.*)$`)
+	nextflowReferencePattern  = regexp.MustCompile(`\bparams\.([A-Za-z_]\w*)`)
+	nextflowBlockPattern      = regexp.MustCompile(`^\s*([A-Za-z_]\w*)\s*\{\s*$`)
+	nextflowSettingPattern    = regexp.MustCompile(`^\s*([A-Za-z_]\w*)\s*=\s*(.*)$`)
+	nextflowIntegerPattern    = regexp.MustCompile(`^-?\d+$`)
+	nextflowNumberPattern     = regexp.MustCompile(`^-?\d*\.\d+([eE][-+]?\d+)?$`)
+)
+
+// ParseNextflow returns the parameters of a Nextflow script. Defaults are read from the 'params.name = value'
+// assignments of the script and then from the nextflow.config next to it, which takes precedence as it does for
+// Nextflow. Parameters that the script references but never assigns are listed as required.
+func ParseNextflow(path string) ([]Parameter, error) {
+	script, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	params := &nextflowParams{indices: make(map[string]int)}
+	scriptLines := joinNextflowLines(stripGroovyComments(string(script)))
+	for _, line := range scriptLines {
+		if match := nextflowAssignmentPattern.FindStringSubmatch(line); match != nil {
+			params.set(match[1], match[2])
+		}
+	}
+
+	config, err := os.ReadFile(filepath.Join(filepath.Dir(path), nextflowConfigFileName))
+	if err == nil {
+		params.parseConfig(joinNextflowLines(stripGroovyComments(string(config))))
+	} else if !os.IsNotExist(err) {
+		return nil, err
+	}
+
+	var referenced []string
+	for _, line := range scriptLines {
+		for _, match := range nextflowReferencePattern.FindAllStringSubmatch(line, -1) {
+			if _, ok := params.indices[match[1]]; !ok {
+				params.indices[match[1]] = -1
+				referenced = append(referenced, match[1])
+			}
+		}
+	}
+	sort.Strings(referenced)
+	for _, name := range referenced {
+		params.parameters = append(params.parameters, Parameter{Name: name, Required: true})
+	}
+	return params.parameters, nil
+}
+
+type nextflowParams struct {
+	parameters []Parameter
+	indices    map[string]int
+}
+
+// set adds or replaces the parameter assigned a Groovy expression
+func (p *nextflowParams) set(name, expression string) {
+	expression = strings.TrimSuffix(strings.TrimSpace(expression), ";")
+	parameter := Parameter{Name: name}
+	value, ok := groovyLiteral(expression)
+	switch {
+	case !ok:
+		parameter.DefaultExpression = expression
+	case value == nil:
+		parameter.Required = true
+	default:
+		parameter.Default, parameter.HasDefault = value, true
+		parameter.Type = nextflowType(value)
+	}
+	if i, ok := p.indices[name]; ok {
+		p.parameters[i] = parameter
+		return
+	}
+	p.indices[name] = len(p.parameters)
+	p.parameters = append(p.parameters, parameter)
+}
+
+// parseConfig reads the 'params.name = value' settings and the 'params { }' block at the top level of a configuration.
+// Settings of nested blocks in the params block are named with a dotted path.
+func (p *nextflowParams) parseConfig(lines []string) {
+	depth := 0
+	var paramsPath []string
+	for _, line := range lines {
+		if paramsPath != nil {
+			if match := nextflowBlockPattern.FindStringSubmatch(line); match != nil {
+				paramsPath = append(paramsPath, match[1])
+				continue
+			}
+			if strings.TrimSpace(line) == "}" {
+				paramsPath = paramsPath[:len(paramsPath)-1]
+				if len(paramsPath) == 0 {
+					paramsPath = nil
+				}
+				continue
+			}
+			if match := nextflowSettingPattern.FindStringSubmatch(line); match != nil {
+				p.set(strings.Join(append(paramsPath[1:], match[1]), "."), match[2])
+			}
+			continue
+		}
+		if depth == 0 {
+			if match := nextflowBlockPattern.FindStringSubmatch(line); match != nil && match[1] == "params" {
+				paramsPath = []string{"params"}
+				continue
+			}
+			if match := nextflowAssignmentPattern.FindStringSubmatch(line); match != nil {
+				p.set(match[1], match[2])
+			}
+		}
+		depth += groovyBraceDepth(line)
+	}
+}
+
+// groovyLiteral converts a literal Groovy expression, such as a string, a number or a list of literals. A null literal
+// converts to nil.
+func groovyLiteral(expression string) (interface{}, bool) {
+	switch {
+	case expression == "null":
+		return nil, true
+	case expression == "true" || expression == "false":
+		return expression == "true", true
+	case nextflowIntegerPattern.MatchString(expression):
+		value, err := strconv.ParseInt(expression, 10, 64)
+		return value, err == nil
+	case nextflowNumberPattern.MatchString(expression):
+		value, err := strconv.ParseFloat(expression, 64)
+		return value, err == nil
+	case len(expression) >= 2 && (expression[0] == '\'' || expression[0] == '"') && expression[len(expression)-1] == expression[0]:
+		text := expression[1 : len(expression)-1]
+		if strings.ContainsAny(text, `'"\`) || (expression[0] == '"' && strings.Contains(text, "$")) {
+			return nil, false
+		}
+		return text, true
+	case strings.HasPrefix(expression, "[") && strings.HasSuffix(expression, "]"):
+		items := splitGroovyList(expression[1 : len(expression)-1])
+		list := make([]interface{}, 0, len(items))
+		for _, item := range items {
+			value, ok := groovyLiteral(item)
+			if !ok || value == nil {
+				return nil, false
+			}
+			list = append(list, value)
+		}
+		return list, true
+	}
+	return nil, false
+}
+
+func nextflowType(value interface{}) string {
+	switch value.(type) {
+	case string:
+		return "string"
+	case int64:
+		return "integer"
+	case float64:
+		return "number"
+	case bool:
+		return "boolean"
+	case []interface{}:
+		return "list"
+	}
+	return ""
+}
+
+// splitGroovyList splits the items of a list literal at the commas that are outside strings and nested lists
+func splitGroovyList(text string) []string {
+	var items []string
+	depth, start := 0, 0
+	var quote byte
+	for i := 0; i < len(text); i++ {
+		c := text[i]
+		switch {
+		case quote != 0:
+			if c == quote {
+				quote = 0
+			}
+		case c == '\'' || c == '"':
+			quote = c
+		case c == '[':
+			depth++
+		case c == ']':
+			depth--
+		case c == ',' && depth == 0:
+			items = append(items, strings.TrimSpace(text[start:i]))
+			start = i + 1
+		}
+	}
+	if last := strings.TrimSpace(text[start:]); last != "" {
+		items = append(items, last)
+	}
+	return items
+}
+
+// stripGroovyComments removes the line and block comments of Groovy source outside strings
+func stripGroovyComments(source string) string {
+	var stripped strings.Builder
+	var quote byte
+	for i := 0; i < len(source); i++ {
+		c := source[i]
+		switch {
+		case quote != 0:
+			if c == '\\' && i+1 < len(source) {
+				stripped.WriteByte(c)
+				i++
+				c = source[i]
+			} else if c == quote || c == '\n' {
+				quote = 0
+			}
+		case c == '\'' || c == '"':
+			quote = c
+		case strings.HasPrefix(source[i:], "//"):
+			for i < len(source) && source[i] != '\n' {
+				i++
+			}
+			if i < len(source) {
+				stripped.WriteByte('\n')
+			}
+			continue
+		case strings.HasPrefix(source[i:], "/*"):
+			end := strings.Index(source[i+2:], "*/")
+			if end < 0 {
+				return stripped.String()
+			}
+			stripped.WriteString(strings.Repeat("\n", strings.Count(source[i:i+2+end], "\n")))
+			i += end + 3
+			continue
+		}
+		stripped.WriteByte(c)
+	}
+	return stripped.String()
+}
+
+// joinNextflowLines splits source into lines, joining the lines of a list literal that spans several lines
+func joinNextflowLines(source string) []string {
+	var lines []string
+	var pending strings.Builder
+	depth := 0
+	for _, line := range strings.Split(source, "\n") {
+		if pending.Len() > 0 {
+			pending.WriteString(" ")
+		}
+		pending.WriteString(strings.TrimRight(line, " \t\r"))
+		depth += strings.Count(line, "[") - strings.Count(line, "]")
+		if depth > 0 {
+			continue
+		}
+		lines = append(lines, pending.String())
+		pending.Reset()
+		depth = 0
+	}
+	if pending.Len() > 0 {
+		lines = append(lines, pending.String())
+	}
+	return lines
+}
+
+func groovyBraceDepth(line string) int {
+	return strings.Count(line, "{") - strings.Count(line, "}")
+}
@@ -0,0 +1,70 @@
+package inputs
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseNextflow(t *testing.T) {
+	directory := writeTestFiles(t, map[string]string{
+		"main.nf": `nextflow.enable.dsl = 2
+
+params.reads = null
+params.outdir = "results" // where results go
+params.max_cpus = 4
+params.vowels = [
+    'a', 'e',
+    'i'
+]
+/* params.commented = 1 */
+params.genome = WorkflowMain.getGenomeAttribute(params, 'fasta')
+
+workflow {
+    Channel.fromPath(params.reads).view()
+    println "${params.outdir} s3://bucket/${params.sample_sheet}"
+}
+`,
+		"nextflow.config": `params {
+    outdir = 's3://bucket/results'
+    skip_qc = false
+    max_memory = 8.5
+    aligner {
+        name = "bwa"
+    }
+}
+params.max_cpus = 16
+profiles {
+    test {
+        params {
+            reads = 'test.fastq'
+        }
+    }
+}
+`,
+	})
+
+	parameters, err := ParseNextflow(filepath.Join(directory, "main.nf"))
+	require.NoError(t, err)
+	assert.Equal(t, []Parameter{
+		{Name: "reads", Required: true},
+		{Name: "outdir", Type: "string", Default: "s3://bucket/results", HasDefault: true},
+		{Name: "max_cpus", Type: "integer", Default: int64(16), HasDefault: true},
+		{Name: "vowels", Type: "list", Default: []interface{}{"a", "e", "i"}, HasDefault: true},
+		{Name: "genome", DefaultExpression: "WorkflowMain.getGenomeAttribute(params, 'fasta')"},
+		{Name: "skip_qc", Type: "boolean", Default: false, HasDefault: true},
+		{Name: "max_memory", Type: "number", Default: 8.5, HasDefault: true},
+		{Name: "aligner.name", Type: "string", Default: "bwa", HasDefault: true},
+		{Name: "sample_sheet", Required: true},
+	}, parameters)
+}
+
+func TestParseNextflow_NoConfig(t *testing.T) {
+	directory := writeTestFiles(t, map[string]string{"main.nf": "params.greeting = 'hello'\n"})
+
+	parameters, err := ParseNextflow(filepath.Join(directory, "main.nf"))
+	require.NoError(t, err)
+	assert.Equal(t, []Parameter{{Name: "greeting", Type: "string", Default: "hello", HasDefault: true}}, parameters)
+}
@@ -0,0 +1,53 @@
+// Package inputs discovers the inputs of a workflow by statically parsing its source, without a workflow engine.
+package inputs
+
+import "fmt"
+
+// Parameter is an input of a workflow
+type Parameter struct {
+	Name     string
+	Type     string
+	Required bool
+	// Default is the default value of the input, set when HasDefault is true
+	Default    interface{}
+	HasDefault bool
+	// DefaultExpression is the source of a default value that is not a literal, such as a WDL expression
+	DefaultExpression string
+	// Placeholder is the template value of an input without a default, when it is more than a description of its type
+	Placeholder interface{}
+}
+
+// Template returns a skeleton of the inputs file of a workflow. Inputs with a literal default hold it, the others hold a
+// placeholder describing the value to provide.
+func Template(parameters []Parameter) map[string]interface{} {
+	template := make(map[string]interface{}, len(parameters))
+	for _, parameter := range parameters {
+		template[parameter.Name] = parameter.templateValue()
+	}
+	return template
+}
+
+func (parameter Parameter) templateValue() interface{} {
+	if parameter.HasDefault {
+		return parameter.Default
+	}
+	if parameter.Placeholder != nil {
+		return parameter.Placeholder
+	}
+	return parameter.Describe()
+}
+
+// Describe returns the type of the parameter annotated with whether it is optional and its default expression
+func (parameter Parameter) Describe() string {
+	description := parameter.Type
+	if description == "" {
+		description = "value"
+	}
+	switch {
+	case parameter.DefaultExpression != "":
+		return fmt.Sprintf("%s (optional, default = %s)", description, parameter.DefaultExpression)
+	case !parameter.Required:
+		return fmt.Sprintf("%s (optional)", description)
+	}
+	return description
+}
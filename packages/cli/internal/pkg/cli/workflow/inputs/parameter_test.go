@@ -0,0 +1,27 @@
+package inputs
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTemplate(t *testing.T) {
+	template := Template([]Parameter{
+		{Name: "Align.reads", Type: "File", Required: true},
+		{Name: "Align.threads", Type: "Int", Default: float64(4), HasDefault: true},
+		{Name: "Align.read_group", Type: "String?"},
+		{Name: "Align.out_prefix", Type: "String", DefaultExpression: `sample + ".aligned"`},
+		{Name: "words", Type: "File", Required: true, Placeholder: map[string]interface{}{"class": "File", "path": "File"}},
+		{Name: "reads", Required: true},
+	})
+
+	assert.Equal(t, map[string]interface{}{
+		"Align.reads":      "File",
+		"Align.threads":    float64(4),
+		"Align.read_group": "String? (optional)",
+		"Align.out_prefix": `String (optional, default = sample + ".aligned")`,
+		"words":            map[string]interface{}{"class": "File", "path": "File"},
+		"reads":            "value",
+	}, template)
+}
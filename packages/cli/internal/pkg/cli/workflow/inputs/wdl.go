@@ -0,0 +1,530 @@
+package inputs
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/rs/zerolog/log"
+)
+
+type wdlTokenKind int
+
+const (
+	wdlIdentifier wdlTokenKind = iota
+	wdlString
+	wdlNumber
+	wdlSymbol
+	wdlCommand
+)
+
+type wdlToken struct {
+	kind  wdlTokenKind
+	text  string
+	line  int
+	start int
+	end   int
+}
+
+type wdlDeclaration struct {
+	typeName   string
+	name       string
+	expression string
+}
+
+type wdlCall struct {
+	target string
+	alias  string
+	bound  map[string]bool
+}
+
+// wdlCallable is a task or a workflow
+type wdlCallable struct {
+	name   string
+	inputs []wdlDeclaration
+	calls  []wdlCall
+}
+
+type wdlDocument struct {
+	path      string
+	imports   map[string]string
+	tasks     map[string]*wdlCallable
+	workflows map[string]*wdlCallable
+	// workflow is the workflow of the document, or its only task when it has no workflow
+	workflow *wdlCallable
+}
+
+// ParseWDL returns the inputs of the workflow in a WDL 1.x file. These are the inputs of the workflow followed by the
+// inputs of its calls that are not bound by the call, named as the engines expect them in an inputs file. Local
+// imports are followed to find the inputs of the tasks and workflows they define.
+func ParseWDL(path string) ([]Parameter, error) {
+	resolver := &wdlResolver{documents: make(map[string]*wdlDocument)}
+	document, err := resolver.load(path)
+	if err != nil {
+		return nil, err
+	}
+	if document.workflow == nil {
+		return nil, fmt.Errorf("no workflow is defined in '%s'", path)
+	}
+	var parameters []Parameter
+	for _, declaration := range document.workflow.inputs {
+		parameters = append(parameters, declaration.parameter(document.workflow.name))
+	}
+	resolver.collectCalls(document, document.workflow, document.workflow.name, []*wdlCallable{document.workflow}, &parameters)
+	return parameters, nil
+}
+
+type wdlResolver struct {
+	documents map[string]*wdlDocument
+}
+
+func (r *wdlResolver) load(path string) (*wdlDocument, error) {
+	absPath, err := filepath.Abs(path)
+	if err != nil {
+		return nil, err
+	}
+	if document, ok := r.documents[absPath]; ok {
+		return document, nil
+	}
+	source, err := os.ReadFile(absPath)
+	if err != nil {
+		return nil, err
+	}
+	document, err := parseWDLDocument(string(source))
+	if err != nil {
+		return nil, fmt.Errorf("unable to parse '%s': %w", path, err)
+	}
+	document.path = absPath
+	r.documents[absPath] = document
+	return document, nil
+}
+
+// collectCalls adds the unbound inputs of the calls of a callable, following sub-workflows
+func (r *wdlResolver) collectCalls(document *wdlDocument, callable *wdlCallable, prefix string, stack []*wdlCallable, parameters *[]Parameter) {
+	for _, call := range callable.calls {
+		calleeDocument, callee := r.resolveCall(document, call.target)
+		if callee == nil {
+			log.Debug().Msgf("unable to resolve the call to '%s' in '%s', its inputs are not listed", call.target, document.path)
+			continue
+		}
+		callPrefix := prefix + "." + call.alias
+		for _, declaration := range callee.inputs {
+			if !call.bound[declaration.name] {
+				*parameters = append(*parameters, declaration.parameter(callPrefix))
+			}
+		}
+		if containsCallable(stack, callee) {
+			continue
+		}
+		r.collectCalls(calleeDocument, callee, callPrefix, append(stack, callee), parameters)
+	}
+}
+
+func (r *wdlResolver) resolveCall(document *wdlDocument, target string) (*wdlDocument, *wdlCallable) {
+	namespace, name := "", target
+	if i := strings.LastIndex(target, "."); i >= 0 {
+		namespace, name = target[:i], target[i+1:]
+	}
+	if namespace != "" {
+		importPath, ok := document.imports[namespace]
+		if !ok {
+			return nil, nil
+		}
+		if parsedURL, err := url.Parse(importPath); err == nil && parsedURL.Scheme != "" && parsedURL.Scheme != "file" {
+			log.Warn().Msgf("imports from '%s' are not followed, the inputs of its calls are not listed", importPath)
+			return nil, nil
+		}
+		importPath = strings.TrimPrefix(importPath, "file://")
+		if !filepath.IsAbs(importPath) {
+			importPath = filepath.Join(filepath.Dir(document.path), importPath)
+		}
+		imported, err := r.load(importPath)
+		if err != nil {
+			log.Warn().Msgf("unable to follow the import of '%s': %s", importPath, err)
+			return nil, nil
+		}
+		document = imported
+	}
+	if task, ok := document.tasks[name]; ok {
+		return document, task
+	}
+	return document, document.workflows[name]
+}
+
+func containsCallable(callables []*wdlCallable, callable *wdlCallable) bool {
+	for _, c := range callables {
+		if c == callable {
+			return true
+		}
+	}
+	return false
+}
+
+func (declaration wdlDeclaration) parameter(prefix string) Parameter {
+	parameter := Parameter{
+		Name:     prefix + "." + declaration.name,
+		Type:     declaration.typeName,
+		Required: !strings.HasSuffix(declaration.typeName, "?") && declaration.expression == "",
+	}
+	if declaration.expression != "" {
+		if value, ok := wdlLiteral(declaration.expression); ok {
+			parameter.Default, parameter.HasDefault = value, true
+		} else {
+			parameter.DefaultExpression = declaration.expression
+		}
+	}
+	return parameter
+}
+
+// wdlLiteral converts a default expression that is a literal, such as a number, a string or an array of literals
+func wdlLiteral(expression string) (interface{}, bool) {
+	if strings.Contains(expression, "~{") || strings.Contains(expression, "${") {
+		return nil, false
+	}
+	if len(expression) >= 2 && strings.HasPrefix(expression, "'") && strings.HasSuffix(expression, "'") {
+		text := expression[1 : len(expression)-1]
+		if strings.ContainsAny(text, `'"\`) {
+			return nil, false
+		}
+		return text, true
+	}
+	var value interface{}
+	if err := json.Unmarshal([]byte(expression), &value); err != nil || value == nil {
+		return nil, false
+	}
+	return value, true
+}
+
+func parseWDLDocument(source string) (*wdlDocument, error) {
+	tokens, err := lexWDL(source)
+	if err != nil {
+		return nil, err
+	}
+	p := &wdlParser{source: source, tokens: tokens}
+	document := &wdlDocument{
+		imports:   make(map[string]string),
+		tasks:     make(map[string]*wdlCallable),
+		workflows: make(map[string]*wdlCallable),
+	}
+	for !p.done() {
+		token := p.next()
+		switch {
+		case token.is(wdlIdentifier, "import"):
+			p.parseImport(document)
+		case token.is(wdlIdentifier, "task") || token.is(wdlIdentifier, "workflow"):
+			name := p.next()
+			if !p.peek().is(wdlSymbol, "{") {
+				return nil, fmt.Errorf("line %d: expected '{' after %s '%s'", name.line, token.text, name.text)
+			}
+			p.next()
+			callable := &wdlCallable{name: name.text}
+			p.parseBody(callable)
+			if token.text == "task" {
+				document.tasks[callable.name] = callable
+			} else {
+				document.workflows[callable.name] = callable
+				document.workflow = callable
+			}
+		case token.is(wdlSymbol, "{"):
+			p.skipBalanced("{", "}")
+		}
+	}
+	if document.workflow == nil && len(document.tasks) == 1 {
+		for _, task := range document.tasks {
+			document.workflow = task
+		}
+	}
+	return document, nil
+}
+
+type wdlParser struct {
+	source string
+	tokens []wdlToken
+	index  int
+}
+
+func (t wdlToken) is(kind wdlTokenKind, text string) bool {
+	return t.kind == kind && t.text == text
+}
+
+func (p *wdlParser) done() bool {
+	return p.index >= len(p.tokens)
+}
+
+func (p *wdlParser) next() wdlToken {
+	if p.done() {
+		return wdlToken{kind: wdlSymbol}
+	}
+	token := p.tokens[p.index]
+	p.index++
+	return token
+}
+
+func (p *wdlParser) peek() wdlToken {
+	if p.done() {
+		return wdlToken{kind: wdlSymbol}
+	}
+	return p.tokens[p.index]
+}
+
+// skipBalanced skips the tokens up to the close symbol that matches an open symbol that was just consumed
+func (p *wdlParser) skipBalanced(open, close string) {
+	depth := 1
+	for !p.done() && depth > 0 {
+		token := p.next()
+		if token.is(wdlSymbol, open) {
+			depth++
+		} else if token.is(wdlSymbol, close) {
+			depth--
+		}
+	}
+}
+
+func (p *wdlParser) parseImport(document *wdlDocument) {
+	uri := p.next()
+	if uri.kind != wdlString {
+		return
+	}
+	importPath := uri.text[1 : len(uri.text)-1]
+	alias := strings.TrimSuffix(filepath.Base(importPath), ".wdl")
+	if p.peek().is(wdlIdentifier, "as") {
+		p.next()
+		alias = p.next().text
+	}
+	document.imports[alias] = importPath
+}
+
+// parseBody parses the body of a task or workflow up to its closing brace, or a nested scatter or conditional block
+func (p *wdlParser) parseBody(callable *wdlCallable) {
+	for !p.done() {
+		token := p.next()
+		switch {
+		case token.is(wdlSymbol, "}"):
+			return
+		case token.is(wdlIdentifier, "input") && p.peek().is(wdlSymbol, "{"):
+			p.next()
+			callable.inputs = append(callable.inputs, p.parseDeclarations()...)
+		case token.is(wdlIdentifier, "call"):
+			callable.calls = append(callable.calls, p.parseCall())
+		case (token.is(wdlIdentifier, "scatter") || token.is(wdlIdentifier, "if")) && p.peek().is(wdlSymbol, "("):
+			p.next()
+			p.skipBalanced("(", ")")
+			if p.peek().is(wdlSymbol, "{") {
+				p.next()
+				p.parseBody(callable)
+			}
+		case token.is(wdlSymbol, "{"):
+			p.skipBalanced("{", "}")
+		}
+	}
+}
+
+// parseDeclarations parses the declarations of an input section up to its closing brace. A declaration ends at the
+// end of a line that has no open bracket.
+func (p *wdlParser) parseDeclarations() []wdlDeclaration {
+	var declarations []wdlDeclaration
+	var current []wdlToken
+	depth := 0
+	for !p.done() {
+		token := p.next()
+		if depth == 0 && token.is(wdlSymbol, "}") {
+			break
+		}
+		if depth == 0 && len(current) > 0 && token.line > current[len(current)-1].line && !current[len(current)-1].is(wdlSymbol, "=") {
+			declarations = appendDeclaration(declarations, p.declaration(current))
+			current = nil
+		}
+		switch {
+		case token.kind == wdlSymbol && strings.Contains("([{", token.text):
+			depth++
+		case token.kind == wdlSymbol && strings.Contains(")]}", token.text):
+			depth--
+		}
+		current = append(current, token)
+	}
+	return appendDeclaration(declarations, p.declaration(current))
+}
+
+func appendDeclaration(declarations []wdlDeclaration, declaration *wdlDeclaration) []wdlDeclaration {
+	if declaration == nil {
+		return declarations
+	}
+	return append(declarations, *declaration)
+}
+
+// declaration parses the tokens of 'Type name' or 'Type name = expression'
+func (p *wdlParser) declaration(tokens []wdlToken) *wdlDeclaration {
+	var typeName strings.Builder
+	i, depth := 0, 0
+	for ; i < len(tokens); i++ {
+		token := tokens[i]
+		if depth == 0 && token.kind == wdlIdentifier && typeName.Len() > 0 && !strings.HasSuffix(typeName.String(), "[") {
+			break
+		}
+		switch token.text {
+		case "[":
+			depth++
+		case "]":
+			depth--
+		case ",":
+			typeName.WriteString(", ")
+			continue
+		}
+		typeName.WriteString(token.text)
+	}
+	if i >= len(tokens) || typeName.Len() == 0 {
+		return nil
+	}
+	declaration := &wdlDeclaration{typeName: typeName.String(), name: tokens[i].text}
+	if i+2 < len(tokens) && tokens[i+1].is(wdlSymbol, "=") {
+		declaration.expression = strings.TrimSpace(p.source[tokens[i+2].start:tokens[len(tokens)-1].end])
+	}
+	return declaration
+}
+
+// parseCall parses 'call target [as alias] [after other] [{ input: name = expression, ... }]'
+func (p *wdlParser) parseCall() wdlCall {
+	call := wdlCall{target: p.next().text, bound: make(map[string]bool)}
+	call.alias = call.target[strings.LastIndex(call.target, ".")+1:]
+	for p.peek().is(wdlIdentifier, "as") || p.peek().is(wdlIdentifier, "after") {
+		if p.next().text == "as" {
+			call.alias = p.next().text
+		} else {
+			p.next()
+		}
+	}
+	if !p.peek().is(wdlSymbol, "{") {
+		return call
+	}
+	p.next()
+	if p.peek().is(wdlIdentifier, "input") && p.index+1 < len(p.tokens) && p.tokens[p.index+1].is(wdlSymbol, ":") {
+		p.index += 2
+	}
+	expectName, depth := true, 0
+	for !p.done() {
+		token := p.next()
+		if depth == 0 && token.is(wdlSymbol, "}") {
+			break
+		}
+		switch {
+		case depth == 0 && expectName && token.kind == wdlIdentifier:
+			call.bound[token.text] = true
+			expectName = false
+		case depth == 0 && token.is(wdlSymbol, ","):
+			expectName = true
+		case token.kind == wdlSymbol && strings.Contains("([{", token.text):
+			depth++
+		case token.kind == wdlSymbol && strings.Contains(")]}", token.text):
+			depth--
+		}
+	}
+	return call
+}
+
+// lexWDL splits WDL source into tokens, skipping comments. The body of a command section is a single token so that
+// the shell script it holds is not parsed.
+func lexWDL(source string) ([]wdlToken, error) {
+	var tokens []wdlToken
+	line := 1
+	for i := 0; i < len(source); {
+		c := source[i]
+		switch {
+		case c == '\n':
+			line++
+			i++
+		case c == ' ' || c == '\t' || c == '\r':
+			i++
+		case c == '#':
+			for i < len(source) && source[i] != '\n' {
+				i++
+			}
+		case c == '"' || c == '\'':
+			end := i + 1
+			for end < len(source) && source[end] != c {
+				if source[end] == '\\' {
+					end++
+				}
+				end++
+			}
+			if end >= len(source) {
+				return nil, fmt.Errorf("line %d: unterminated string", line)
+			}
+			tokens = append(tokens, wdlToken{kind: wdlString, text: source[i : end+1], line: line, start: i, end: end + 1})
+			line += strings.Count(source[i:end+1], "\n")
+			i = end + 1
+		case isWDLIdentifierStart(c):
+			end := i + 1
+			for end < len(source) && (isWDLIdentifierStart(source[end]) || isDigit(source[end]) || source[end] == '.') {
+				end++
+			}
+			tokens = append(tokens, wdlToken{kind: wdlIdentifier, text: source[i:end], line: line, start: i, end: end})
+			i = end
+			if tokens[len(tokens)-1].text == "command" {
+				command, err := lexWDLCommand(source, i, line)
+				if err != nil {
+					return nil, err
+				}
+				if command != nil {
+					tokens = append(tokens, *command)
+					line += strings.Count(source[i:command.end], "\n")
+					i = command.end
+				}
+			}
+		case isDigit(c):
+			end := i + 1
+			for end < len(source) && (isDigit(source[end]) || strings.IndexByte(".eE", source[end]) >= 0 ||
+				((source[end] == '-' || source[end] == '+') && strings.IndexByte("eE", source[end-1]) >= 0)) {
+				end++
+			}
+			tokens = append(tokens, wdlToken{kind: wdlNumber, text: source[i:end], line: line, start: i, end: end})
+			i = end
+		default:
+			tokens = append(tokens, wdlToken{kind: wdlSymbol, text: string(c), line: line, start: i, end: i + 1})
+			i++
+		}
+	}
+	return tokens, nil
+}
+
+// lexWDLCommand returns the body of a 'command <<< >>>' or 'command { }' section starting at offset, or nil when the
+// keyword is used as a name
+func lexWDLCommand(source string, offset, line int) (*wdlToken, error) {
+	start := offset
+	for start < len(source) && strings.IndexByte(" \t\r\n", source[start]) >= 0 {
+		start++
+	}
+	switch {
+	case strings.HasPrefix(source[start:], "<<<"):
+		end := strings.Index(source[start:], ">>>")
+		if end < 0 {
+			return nil, fmt.Errorf("line %d: unterminated command section", line)
+		}
+		return &wdlToken{kind: wdlCommand, line: line, start: start, end: start + end + 3}, nil
+	case strings.HasPrefix(source[start:], "{"):
+		depth := 0
+		for end := start; end < len(source); end++ {
+			switch source[end] {
+			case '{':
+				depth++
+			case '}':
+				depth--
+				if depth == 0 {
+					return &wdlToken{kind: wdlCommand, line: line, start: start, end: end + 1}, nil
+				}
+			}
+		}
+		return nil, fmt.Errorf("line %d: unterminated command section", line)
+	}
+	return nil, nil
+}
+
+func isWDLIdentifierStart(c byte) bool {
+	return c == '_' || (c >= 'a' && c <= 'z') || (c >= 'A' && c <= 'Z')
+}
+
+func isDigit(c byte) bool {
+	return c >= '0' && c <= '9'
+}
@@ -0,0 +1,160 @@
+package inputs
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func writeTestFiles(t *testing.T, files map[string]string) string {
+	directory := t.TempDir()
+	for name, content := range files {
+		path := filepath.Join(directory, name)
+		require.NoError(t, os.MkdirAll(filepath.Dir(path), 0755))
+		require.NoError(t, os.WriteFile(path, []byte(content), 0644))
+	}
+	return directory
+}
+
+const testMainWdl = `version 1.0
+
+import "tasks/align.wdl" as align_tasks
+import "https://example.com/remote.wdl" as remote
+
+# aligns reads { with braces in a comment
+workflow Align {
+    input {
+        File reads
+        Array[File]+ references
+        Map[String, Int] limits = {"cpu": 4}
+        Int threads = 4
+        String sample = 'NA12878'
+        String? read_group
+        Boolean dedup = true
+        String out_prefix = sample + ".aligned"
+        Array[String] flags = [
+            "-M",
+            "-Y"
+        ]
+    }
+
+    scatter (reference in references) {
+        call align_tasks.bwa as bwa_mem {
+            input:
+                reads = reads,
+                reference = reference,
+                threads
+        }
+    }
+    if (dedup) {
+        call mark_duplicates { input: bams = bwa_mem.bam }
+    }
+    call remote.report
+}
+
+task mark_duplicates {
+    input {
+        Array[File] bams
+        String java_options = "-Xmx4g"
+        Int memory_gb
+    }
+    command <<<
+        java ~{java_options} -jar picard.jar MarkDuplicates I=~{sep=' I=' bams} # not a comment
+    >>>
+    output {
+        File bam = "deduplicated.bam"
+    }
+}
+`
+
+const testAlignWdl = `version 1.0
+
+task bwa {
+    input {
+        File reads
+        File reference
+        Int threads
+        Float? min_score
+    }
+    command {
+        bwa mem -t ${threads} ${reference} ${reads} | awk '{ print $1 }' > out.sam
+    }
+    runtime {
+        docker: "biocontainers/bwa"
+    }
+}
+`
+
+func TestParseWDL(t *testing.T) {
+	directory := writeTestFiles(t, map[string]string{
+		"main.wdl":        testMainWdl,
+		"tasks/align.wdl": testAlignWdl,
+	})
+
+	parameters, err := ParseWDL(filepath.Join(directory, "main.wdl"))
+	require.NoError(t, err)
+	assert.Equal(t, []Parameter{
+		{Name: "Align.reads", Type: "File", Required: true},
+		{Name: "Align.references", Type: "Array[File]+", Required: true},
+		{Name: "Align.limits", Type: "Map[String, Int]", Default: map[string]interface{}{"cpu": float64(4)}, HasDefault: true},
+		{Name: "Align.threads", Type: "Int", Default: float64(4), HasDefault: true},
+		{Name: "Align.sample", Type: "String", Default: "NA12878", HasDefault: true},
+		{Name: "Align.read_group", Type: "String?"},
+		{Name: "Align.dedup", Type: "Boolean", Default: true, HasDefault: true},
+		{Name: "Align.out_prefix", Type: "String", DefaultExpression: `sample + ".aligned"`},
+		{Name: "Align.flags", Type: "Array[String]", Default: []interface{}{"-M", "-Y"}, HasDefault: true},
+		{Name: "Align.bwa_mem.min_score", Type: "Float?"},
+		{Name: "Align.mark_duplicates.java_options", Type: "String", Default: "-Xmx4g", HasDefault: true},
+		{Name: "Align.mark_duplicates.memory_gb", Type: "Int", Required: true},
+	}, parameters)
+}
+
+func TestParseWDL_SubWorkflow(t *testing.T) {
+	directory := writeTestFiles(t, map[string]string{
+		"main.wdl": `version 1.0
+import "sub.wdl"
+workflow Main {
+    call sub.Sub { input: name = "x" }
+}
+`,
+		"sub.wdl": `version 1.0
+workflow Sub {
+    input {
+        String name
+        Int count = 1
+    }
+    call greet
+}
+task greet {
+    input {
+        String greeting
+    }
+    command { echo ~{greeting} }
+}
+`,
+	})
+
+	parameters, err := ParseWDL(filepath.Join(directory, "main.wdl"))
+	require.NoError(t, err)
+	assert.Equal(t, []Parameter{
+		{Name: "Main.Sub.count", Type: "Int", Default: float64(1), HasDefault: true},
+		{Name: "Main.Sub.greet.greeting", Type: "String", Required: true},
+	}, parameters)
+}
+
+func TestParseWDL_Errors(t *testing.T) {
+	directory := writeTestFiles(t, map[string]string{
+		"tasks.wdl":        "version 1.0\ntask a {\n}\ntask b {\n}\n",
+		"unterminated.wdl": "version 1.0\nworkflow w {\n  input {\n    String s = \"open\n",
+	})
+
+	_, err := ParseWDL(filepath.Join(directory, "tasks.wdl"))
+	assert.EqualError(t, err, "no workflow is defined in '"+filepath.Join(directory, "tasks.wdl")+"'")
+	_, err = ParseWDL(filepath.Join(directory, "unterminated.wdl"))
+	assert.EqualError(t, err, "unable to parse '"+filepath.Join(directory, "unterminated.wdl")+"': line 4: unterminated string")
+	_, err = ParseWDL(filepath.Join(directory, "missing.wdl"))
+	assert.Error(t, err)
+}
@@ -2,11 +2,12 @@ package workflow
 
 type Interface interface {
 	ListWorkflows() (map[string]Summary, error)
-	RunWorkflow(contextName, workflowName, argumentsUrl string, optionFileUrl string) (string, error)
+	RunWorkflow(contextName, workflowName, argumentsUrl string, optionFileUrl string, overrideBudget bool) (string, error)
 	StatusWorkflowByInstanceId(instanceId string) ([]InstanceSummary, error)
 	StatusWorkflowByName(workflowName string, numInstances int) ([]InstanceSummary, error)
 	StatusWorkflowByContext(contextName string, numInstances int) ([]InstanceSummary, error)
 	StatusWorkflowAll(numInstances int) ([]InstanceSummary, error)
+	ListActiveRunIdsByContext(contextName string) ([]string, error)
 	StopWorkflowInstance(runId string)
 	GetWorkflowTasks(runId string) ([]Task, error)
 }
@@ -8,16 +8,19 @@ import (
 	"net/url"
 	"os"
 	"path/filepath"
+	"sort"
 	"strings"
 
 	"github.com/aws/amazon-genomics-cli/internal/pkg/aws"
 	"github.com/aws/amazon-genomics-cli/internal/pkg/aws/cfn"
+	"github.com/aws/amazon-genomics-cli/internal/pkg/aws/costexplorer"
 	"github.com/aws/amazon-genomics-cli/internal/pkg/aws/ddb"
 	"github.com/aws/amazon-genomics-cli/internal/pkg/aws/s3"
 	"github.com/aws/amazon-genomics-cli/internal/pkg/aws/ssm"
 	"github.com/aws/amazon-genomics-cli/internal/pkg/cli/awsresources"
 	"github.com/aws/amazon-genomics-cli/internal/pkg/cli/config"
 	"github.com/aws/amazon-genomics-cli/internal/pkg/cli/spec"
+	"github.com/aws/amazon-genomics-cli/internal/pkg/cli/workflow/inputs"
 	"github.com/aws/amazon-genomics-cli/internal/pkg/cli/zipfile"
 	"github.com/aws/amazon-genomics-cli/internal/pkg/constants"
 	"github.com/aws/amazon-genomics-cli/internal/pkg/osutils"
@@ -31,6 +34,7 @@ import (
 var (
 	compressToTmp                 = zipfile.CompressToTmp
 	workflowZip                   = "workflow.zip"
+	defaultInputsFileName         = "inputs.json"
 	removeFile                    = os.Remove
 	removeAll                     = os.RemoveAll
 	osStat                        = os.Stat
@@ -75,6 +79,7 @@ type runProps struct {
 	workflowUrl          string
 	inputsPath           string
 	input                Input
+	defaultInputs        Input
 	optionFileUrl        string
 	options              map[string]string
 	arguments            []string
@@ -112,6 +117,12 @@ type taskProps struct {
 	runLog         wes_client.RunLog
 }
 
+//nolint:structcheck
+type inputsProps struct {
+	mainWorkflowPath string
+	inputParameters  []inputs.Parameter
+}
+
 //nolint:structcheck
 type workflowOutputProps struct {
 	instanceSummary       InstanceSummary
@@ -125,10 +136,14 @@ type Manager struct {
 	Ssm         ssm.Interface
 	Cfn         cfn.Interface
 	Ddb         ddb.Interface
+	Costs       costexplorer.Interface
 	Storage     storage.StorageClient
 	InputClient storage.InputClient
 	WesFactory  func(url string) (wes.Interface, error)
 
+	profile       string
+	targetClients func(profile string) targetClients
+
 	wes wes.Interface
 	baseProps
 	wesProps
@@ -139,6 +154,7 @@ type Manager struct {
 	instanceStopProps
 	taskProps
 	workflowOutputProps
+	inputsProps
 	err error
 }
 
@@ -163,9 +179,13 @@ func NewManager(profile string) *Manager {
 		Cfn:         aws.CfnClient(profile),
 		S3:          s3Client,
 		Ddb:         aws.DdbClient(profile),
+		Costs:       aws.CostExplorerClient(profile),
 		Storage:     storageClient,
 		InputClient: storage.NewInputClient(s3Client),
 		WesFactory:  func(url string) (wes.Interface, error) { return wes.New(url, profile) },
+
+		profile:       profile,
+		targetClients: newTargetClients,
 	}
 }
 
@@ -339,6 +359,73 @@ func (m *Manager) readInput(inputUrl string) {
 	m.input = input
 }
 
+// validateInputs applies the default inputs of the workflow and checks the inputs against its input schema, so that
+// mistakes are reported before anything is uploaded
+func (m *Manager) validateInputs() {
+	if m.err != nil {
+		return
+	}
+	m.defaultInputs = make(Input)
+	for name, value := range m.workflowSpec.DefaultInputs {
+		if _, ok := m.input[name]; !ok {
+			m.defaultInputs[name] = value
+		}
+	}
+	m.input = m.workflowSpec.GetInputs(m.input)
+	if len(m.workflowSpec.InputSchema) == 0 {
+		return
+	}
+	problems := m.workflowSpec.ValidateInputs(m.input)
+	problems = append(problems, m.missingInputFiles()...)
+	if len(problems) > 0 {
+		m.err = fmt.Errorf("the inputs do not match the input schema of the workflow:\n\t%s", strings.Join(problems, "\n\t"))
+	}
+}
+
+// missingInputFiles returns a description of each local file named by a file input that does not exist
+func (m *Manager) missingInputFiles() []string {
+	var problems []string
+	for _, name := range m.workflowSpec.InputNames() {
+		location, ok := m.input[name].(string)
+		if !ok || !m.workflowSpec.InputSchema[name].IsFile() {
+			continue
+		}
+		if parsedUrl, err := url.Parse(location); err == nil && parsedUrl.Scheme != "" && parsedUrl.Scheme != "file" {
+			continue
+		}
+		filePath := osutils.StripFileURLPrefix(location)
+		if !filepath.IsAbs(filePath) {
+			filePath = filepath.Join(m.inputBaseLocation(name), filePath)
+		}
+		if _, err := osStat(filePath); err != nil {
+			problems = append(problems, fmt.Sprintf("%s: file '%s' does not exist", name, filePath))
+		}
+	}
+	return problems
+}
+
+// inputBaseLocation returns the directory that relative paths in the named input are resolved against, which is the
+// project directory for default inputs and the directory of the inputs file for the inputs it holds
+func (m *Manager) inputBaseLocation(name string) string {
+	if _, ok := m.defaultInputs[name]; ok {
+		return m.Project.GetLocation()
+	}
+	return m.inputsBaseLocation()
+}
+
+// inputsBaseLocation returns the directory of the inputs file or, when only default inputs are used, the project
+// directory
+func (m *Manager) inputsBaseLocation() string {
+	if m.inputsPath == "" {
+		return m.Project.GetLocation()
+	}
+	absInputsPath, err := filepath.Abs(m.inputsPath)
+	if err != nil {
+		return filepath.Dir(m.inputsPath)
+	}
+	return filepath.Dir(absInputsPath)
+}
+
 func (m *Manager) parseInputToArguments() {
 	if m.err != nil || m.input == nil {
 		return
@@ -353,19 +440,27 @@ func (m *Manager) uploadInputsToS3() {
 		return
 	}
 	objectKey := awsresources.RenderBucketDataKey(m.projectSpec.Name, m.userId)
-	absInputsPath, err := filepath.Abs(m.inputsPath)
-	if err != nil {
-		m.err = err
-		return
+	inputsByLocation := make(map[string]Input)
+	for name, value := range m.input {
+		baseLocation := m.inputBaseLocation(name)
+		if inputsByLocation[baseLocation] == nil {
+			inputsByLocation[baseLocation] = make(Input)
+		}
+		inputsByLocation[baseLocation][name] = value
 	}
-	baseLocation := filepath.Dir(absInputsPath)
-	log.Debug().Msgf("moving local inputs from '%s' to s3://%s/%s and replacing paths with S3 paths", baseLocation, m.bucketName, objectKey)
-	inputsWithS3Paths, err := m.InputClient.UpdateInputs(baseLocation, m.input, m.bucketName, objectKey)
-	if err != nil {
-		m.err = fmt.Errorf("unable to sync s3://%s/%s: %w", m.bucketName, objectKey, err)
-		return
+	updatedInputs := make(Input, len(m.input))
+	for baseLocation, inputs := range inputsByLocation {
+		log.Debug().Msgf("moving local inputs from '%s' to s3://%s/%s and replacing paths with S3 paths", baseLocation, m.bucketName, objectKey)
+		inputsWithS3Paths, err := m.InputClient.UpdateInputs(baseLocation, inputs, m.bucketName, objectKey)
+		if err != nil {
+			m.err = fmt.Errorf("unable to sync s3://%s/%s: %w", m.bucketName, objectKey, err)
+			return
+		}
+		for name, value := range inputsWithS3Paths {
+			updatedInputs[name] = value
+		}
 	}
-	m.input = inputsWithS3Paths
+	m.input = updatedInputs
 }
 
 func (m *Manager) readOptionFile(optionFileUrl string) {
@@ -396,11 +491,17 @@ func (m *Manager) readConfig() {
 	log.Debug().Msgf("current user id: '%s'", m.userId)
 }
 
+// contextOwnerId returns the id under which the context is deployed. Shared contexts are deployed once per project,
+// while workflow instances are still recorded against the current user.
+func (m *Manager) contextOwnerId(contextName string) string {
+	return awsresources.RenderContextOwnerId(m.userId, m.projectSpec.Contexts[contextName].Shared)
+}
+
 func (m *Manager) isContextDeployed(contextName string) bool {
 	if m.err != nil {
 		return false
 	}
-	engineStackName := awsresources.RenderContextStackName(m.projectSpec.Name, contextName, m.userId)
+	engineStackName := awsresources.RenderContextStackName(m.projectSpec.Name, contextName, m.contextOwnerId(contextName))
 	status, err := m.Cfn.GetStackStatus(engineStackName)
 	if err != nil {
 		if errors.Is(err, cfn.StackDoesNotExistError) {
@@ -426,6 +527,7 @@ func (m *Manager) setContext(contextName string) {
 		return
 	}
 	m.contextSpec = contextSpec
+	m.useContextTarget()
 }
 
 func (m *Manager) setEngineForWorkflowType(contextName string) {
@@ -449,7 +551,7 @@ func (m *Manager) setContextStackInfo(contextName string) {
 	if m.err != nil {
 		return
 	}
-	contextStackName := awsresources.RenderContextStackName(m.projectSpec.Name, contextName, m.userId)
+	contextStackName := awsresources.RenderContextStackName(m.projectSpec.Name, contextName, m.contextOwnerId(contextName))
 	log.Debug().Msgf("using context infrastructure from cloudformation stack '%s'", contextStackName)
 	m.contextStackInfo, m.err = m.Cfn.GetStackInfo(contextStackName)
 }
@@ -473,7 +575,7 @@ func (m *Manager) setWorkflowParameters() {
 		return
 	}
 	m.workflowParams = make(map[string]string)
-	if m.inputsPath == "" {
+	if len(m.attachments) == 0 {
 		return
 	}
 	m.workflowParams["workflowInputs"] = filepath.Base(m.attachments[0])
@@ -514,7 +616,11 @@ func (m *Manager) saveAttachments() {
 		return
 	}
 
-	namePattern := fmt.Sprintf("%s_*", filepath.Base(m.inputsPath))
+	inputsFileName := defaultInputsFileName
+	if m.inputsPath != "" {
+		inputsFileName = filepath.Base(m.inputsPath)
+	}
+	namePattern := fmt.Sprintf("%s_*", inputsFileName)
 	for _, arg := range m.arguments {
 		fileName, err := writeToTmp(namePattern, arg)
 		log.Debug().Msgf("saved attachment for argument '%s' to '%s'", arg, fileName)
@@ -710,10 +816,54 @@ func (m *Manager) renderWorkflowDetails(workflowName string) (Details, error) {
 		TypeLanguage: m.workflowSpec.Type.Language,
 		TypeVersion:  m.workflowSpec.Type.Version,
 		Source:       m.workflowSpec.SourceURL,
+		Version:      m.workflowSpec.Version,
+		Description:  m.workflowSpec.Description,
+		Parameters:   renderParameterDetails(m.workflowSpec),
 	}
 	return details, nil
 }
 
+// renderParameterDetails lists the inputs of the input schema followed by the default inputs that it does not declare
+func renderParameterDetails(workflowSpec spec.Workflow) []ParameterDetails {
+	var parameters []ParameterDetails
+	for _, name := range workflowSpec.InputNames() {
+		parameter := workflowSpec.InputSchema[name]
+		parameters = append(parameters, ParameterDetails{
+			Name:        name,
+			Type:        parameter.Type,
+			Required:    parameter.Required,
+			Default:     renderDefaultInput(workflowSpec.DefaultInputs, name),
+			Description: parameter.Description,
+		})
+	}
+	var undeclared []string
+	for name := range workflowSpec.DefaultInputs {
+		if _, ok := workflowSpec.InputSchema[name]; !ok {
+			undeclared = append(undeclared, name)
+		}
+	}
+	sort.Strings(undeclared)
+	for _, name := range undeclared {
+		parameters = append(parameters, ParameterDetails{Name: name, Default: renderDefaultInput(workflowSpec.DefaultInputs, name)})
+	}
+	return parameters
+}
+
+func renderDefaultInput(defaultInputs map[string]interface{}, name string) string {
+	value, ok := defaultInputs[name]
+	if !ok {
+		return ""
+	}
+	if text, ok := value.(string); ok {
+		return text
+	}
+	jsonBytes, err := json.Marshal(value)
+	if err != nil {
+		return fmt.Sprint(value)
+	}
+	return string(jsonBytes)
+}
+
 func (m *Manager) updateInProject(instance *InstanceSummary) {
 	if m.err != nil || instance == nil {
 		return
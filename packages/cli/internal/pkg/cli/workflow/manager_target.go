@@ -0,0 +1,54 @@
+package workflow
+
+import (
+	"github.com/aws/amazon-genomics-cli/internal/pkg/aws"
+	"github.com/aws/amazon-genomics-cli/internal/pkg/aws/cfn"
+	"github.com/aws/amazon-genomics-cli/internal/pkg/aws/costexplorer"
+	"github.com/aws/amazon-genomics-cli/internal/pkg/aws/s3"
+	"github.com/aws/amazon-genomics-cli/internal/pkg/aws/ssm"
+	"github.com/aws/amazon-genomics-cli/internal/pkg/storage"
+	"github.com/aws/amazon-genomics-cli/internal/pkg/wes"
+)
+
+// targetClients are the clients of the region and account that a context is deployed to. Workflow instances are
+// recorded in the region of the current profile whatever the region of their context, so the instance history of
+// a project can be listed without knowing where each run took place.
+type targetClients struct {
+	s3          s3.Interface
+	ssm         ssm.Interface
+	cfn         cfn.Interface
+	costs       costexplorer.Interface
+	inputClient storage.InputClient
+	wesFactory  func(url string) (wes.Interface, error)
+}
+
+func newTargetClients(profile string) targetClients {
+	s3Client := aws.S3Client(profile)
+	return targetClients{
+		s3:          s3Client,
+		ssm:         aws.SsmClient(profile),
+		cfn:         aws.CfnClient(profile),
+		costs:       aws.CostExplorerClient(profile),
+		inputClient: storage.NewInputClient(s3Client),
+		wesFactory:  func(url string) (wes.Interface, error) { return wes.New(url, profile) },
+	}
+}
+
+// useContextTarget switches the clients of the manager to the region and account of the current context
+func (m *Manager) useContextTarget() {
+	if m.err != nil || m.targetClients == nil {
+		return
+	}
+	clients := m.targetClients(m.contextTargetProfile())
+	m.S3, m.Ssm, m.Cfn, m.InputClient, m.WesFactory = clients.s3, clients.ssm, clients.cfn, clients.inputClient, clients.wesFactory
+	m.Costs = clients.costs
+}
+
+// contextTargetProfile returns the profile key of the clients for the region and account of the current context
+func (m *Manager) contextTargetProfile() string {
+	profile := m.profile
+	if m.contextSpec.AwsProfile != "" {
+		profile = m.contextSpec.AwsProfile
+	}
+	return aws.TargetProfile(profile, m.contextSpec.Region, m.contextSpec.RoleArn)
+}
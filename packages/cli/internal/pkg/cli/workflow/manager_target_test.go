@@ -0,0 +1,48 @@
+package workflow
+
+import (
+	"testing"
+
+	"github.com/aws/amazon-genomics-cli/internal/pkg/aws"
+	"github.com/aws/amazon-genomics-cli/internal/pkg/cli/spec"
+	awsmocks "github.com/aws/amazon-genomics-cli/internal/pkg/mocks/aws"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestManager_SetContext_UsesContextTarget(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	defaultCfn := awsmocks.NewMockCfnClient(ctrl)
+	regionalCfn := awsmocks.NewMockCfnClient(ctrl)
+	defaultDdb := awsmocks.NewMockDdbClient(ctrl)
+	regionalProfile := aws.TargetProfile("other-profile", "eu-west-1", "")
+
+	manager := &Manager{
+		Cfn:     defaultCfn,
+		Ddb:     defaultDdb,
+		profile: "test-profile",
+		targetClients: func(profile string) targetClients {
+			if profile == regionalProfile {
+				return targetClients{cfn: regionalCfn}
+			}
+			return targetClients{cfn: defaultCfn}
+		},
+	}
+	manager.projectSpec = spec.Project{
+		Name: testProjectName,
+		Contexts: map[string]spec.Context{
+			"regional": {Region: "eu-west-1", AwsProfile: "other-profile", Engines: []spec.Engine{{Type: "wdl", Engine: "cromwell"}}},
+			"default":  {Engines: []spec.Engine{{Type: "wdl", Engine: "cromwell"}}},
+		},
+	}
+
+	manager.setContext("regional")
+	assert.NoError(t, manager.err)
+	assert.Equal(t, regionalCfn, manager.Cfn)
+	assert.Equal(t, defaultDdb, manager.Ddb)
+
+	manager.setContext("default")
+	assert.NoError(t, manager.err)
+	assert.Equal(t, defaultCfn, manager.Cfn)
+}
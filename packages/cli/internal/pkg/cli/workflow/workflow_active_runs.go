@@ -0,0 +1,47 @@
+package workflow
+
+import (
+	"context"
+
+	"github.com/rsc/wes_client"
+)
+
+var activeRunStates = map[wes_client.State]bool{
+	wes_client.QUEUED:       true,
+	wes_client.INITIALIZING: true,
+	wes_client.RUNNING:      true,
+	wes_client.PAUSED:       true,
+	wes_client.CANCELING:    true,
+}
+
+// ListActiveRunIdsByContext returns the ids of all runs that are active in the workflow engine of a context,
+// including runs submitted by other users of a shared context.
+func (m *Manager) ListActiveRunIdsByContext(contextName string) ([]string, error) {
+	m.readProjectSpec()
+	m.readConfig()
+	m.setContext(contextName)
+	if !m.isContextDeployed(contextName) {
+		return nil, m.err
+	}
+	m.setContextStackInfo(contextName)
+	m.setWesUrl()
+	m.setWesClient()
+	return m.listActiveRunIds()
+}
+
+func (m *Manager) listActiveRunIds() ([]string, error) {
+	if m.err != nil {
+		return nil, m.err
+	}
+	runs, err := m.wes.ListRuns(context.Background())
+	if err != nil {
+		return nil, err
+	}
+	var runIds []string
+	for _, run := range runs {
+		if activeRunStates[run.State] {
+			runIds = append(runIds, run.RunId)
+		}
+	}
+	return runIds, nil
+}
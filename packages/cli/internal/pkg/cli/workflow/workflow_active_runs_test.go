@@ -0,0 +1,103 @@
+package workflow
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/aws/amazon-genomics-cli/internal/pkg/aws/cfn"
+	"github.com/aws/amazon-genomics-cli/internal/pkg/cli/spec"
+	awsmocks "github.com/aws/amazon-genomics-cli/internal/pkg/mocks/aws"
+	storagemocks "github.com/aws/amazon-genomics-cli/internal/pkg/mocks/storage"
+	wesmocks "github.com/aws/amazon-genomics-cli/internal/pkg/mocks/wes"
+	"github.com/aws/amazon-genomics-cli/internal/pkg/wes"
+	"github.com/aws/aws-sdk-go-v2/service/cloudformation/types"
+	"github.com/golang/mock/gomock"
+	"github.com/rsc/wes_client"
+	"github.com/stretchr/testify/suite"
+)
+
+const testSharedContextStack = "Agc-Context-TestProject1-shared-TestContext1"
+
+type WorkflowActiveRunsTestSuite struct {
+	suite.Suite
+	ctrl              *gomock.Controller
+	mockProjectClient *storagemocks.MockProjectClient
+	mockConfigClient  *storagemocks.MockConfigClient
+	mockCfn           *awsmocks.MockCfnClient
+	mockWes           *wesmocks.MockWesClient
+	testProjSpec      spec.Project
+	manager           *Manager
+}
+
+func (s *WorkflowActiveRunsTestSuite) BeforeTest(_, _ string) {
+	s.ctrl = gomock.NewController(s.T())
+	s.mockProjectClient = storagemocks.NewMockProjectClient(s.ctrl)
+	s.mockConfigClient = storagemocks.NewMockConfigClient(s.ctrl)
+	s.mockWes = wesmocks.NewMockWesClient(s.ctrl)
+	s.mockCfn = awsmocks.NewMockCfnClient(s.ctrl)
+
+	s.manager = &Manager{
+		Project:    s.mockProjectClient,
+		Config:     s.mockConfigClient,
+		Cfn:        s.mockCfn,
+		WesFactory: func(_ string) (wes.Interface, error) { return s.mockWes, nil },
+	}
+
+	s.testProjSpec = spec.Project{
+		Name: testProjectName,
+		Contexts: map[string]spec.Context{
+			testContext1Name: {
+				Shared:  true,
+				Engines: []spec.Engine{{Type: "wdl", Engine: "cromwell"}},
+			},
+		},
+	}
+}
+
+func (s *WorkflowActiveRunsTestSuite) TestListActiveRunIdsByContext_SharedContext() {
+	defer s.ctrl.Finish()
+
+	s.mockConfigClient.EXPECT().GetUserId().Return(testUserId, nil)
+	s.mockProjectClient.EXPECT().Read().Return(s.testProjSpec, nil)
+	s.mockCfn.EXPECT().GetStackStatus(testSharedContextStack).Return(types.StackStatusCreateComplete, nil)
+	s.mockCfn.EXPECT().GetStackInfo(testSharedContextStack).Return(cfn.StackInfo{Outputs: map[string]string{"WesUrl": testWesUrl}}, nil)
+	s.mockWes.EXPECT().ListRuns(context.Background()).Return([]wes_client.RunStatus{
+		{RunId: "run-1", State: wes_client.RUNNING},
+		{RunId: "run-2", State: wes_client.COMPLETE},
+		{RunId: "run-3", State: wes_client.QUEUED},
+	}, nil)
+
+	runIds, err := s.manager.ListActiveRunIdsByContext(testContext1Name)
+	s.Require().NoError(err)
+	s.Assert().Equal([]string{"run-1", "run-3"}, runIds)
+}
+
+func (s *WorkflowActiveRunsTestSuite) TestListActiveRunIdsByContext_NotDeployed() {
+	defer s.ctrl.Finish()
+
+	s.mockConfigClient.EXPECT().GetUserId().Return(testUserId, nil)
+	s.mockProjectClient.EXPECT().Read().Return(s.testProjSpec, nil)
+	s.mockCfn.EXPECT().GetStackStatus(testSharedContextStack).Return(types.StackStatus(""), cfn.StackDoesNotExistError)
+
+	runIds, err := s.manager.ListActiveRunIdsByContext(testContext1Name)
+	s.Require().NoError(err)
+	s.Assert().Empty(runIds)
+}
+
+func (s *WorkflowActiveRunsTestSuite) TestListActiveRunIdsByContext_WesError() {
+	defer s.ctrl.Finish()
+
+	s.mockConfigClient.EXPECT().GetUserId().Return(testUserId, nil)
+	s.mockProjectClient.EXPECT().Read().Return(s.testProjSpec, nil)
+	s.mockCfn.EXPECT().GetStackStatus(testSharedContextStack).Return(types.StackStatusCreateComplete, nil)
+	s.mockCfn.EXPECT().GetStackInfo(testSharedContextStack).Return(cfn.StackInfo{Outputs: map[string]string{"WesUrl": testWesUrl}}, nil)
+	s.mockWes.EXPECT().ListRuns(context.Background()).Return(nil, fmt.Errorf("some wes error"))
+
+	_, err := s.manager.ListActiveRunIdsByContext(testContext1Name)
+	s.Assert().EqualError(err, "some wes error")
+}
+
+func TestWorkflowActiveRunsTestSuite(t *testing.T) {
+	suite.Run(t, new(WorkflowActiveRunsTestSuite))
+}
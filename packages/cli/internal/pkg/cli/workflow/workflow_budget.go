@@ -0,0 +1,54 @@
+package workflow
+
+import (
+	"fmt"
+
+	"github.com/aws/amazon-genomics-cli/internal/pkg/cli/awsresources"
+	"github.com/aws/amazon-genomics-cli/internal/pkg/cli/clierror/actionableerror"
+	"github.com/aws/amazon-genomics-cli/internal/pkg/constants"
+	"github.com/rs/zerolog/log"
+)
+
+// checkBudget compares the month-to-date spend of the context with its budget. Runs are refused once the spend has
+// reached the monthly limit unless the budget is overridden.
+func (m *Manager) checkBudget(contextName string, overrideBudget bool) {
+	if m.err != nil {
+		return
+	}
+	budget := m.contextSpec.Budget
+	if !budget.IsSet() {
+		return
+	}
+
+	log.Debug().Msgf("checking month-to-date spend of context '%s'", contextName)
+	spend, err := m.Costs.GetMonthToDateCost(map[string]string{
+		constants.ProjectTagKey: m.projectSpec.Name,
+		constants.ContextTagKey: contextName,
+		constants.UserIdTagKey:  awsresources.RenderContextOwnerId(m.userId, m.contextSpec.Shared),
+	})
+	if err != nil {
+		if overrideBudget {
+			log.Warn().Err(err).Msgf("Unable to check the budget of context '%s', running the workflow anyway", contextName)
+			return
+		}
+		m.err = actionableerror.New(
+			fmt.Errorf("unable to check the budget of context '%s': %w", contextName, err),
+			"Please check that you are allowed to call 'ce:GetCostAndUsage' or run the workflow with --override-budget",
+		)
+		return
+	}
+
+	switch {
+	case spend >= budget.MonthlyLimit && overrideBudget:
+		log.Warn().Msgf("Context '%s' has spent $%.2f this month, exceeding its monthly budget of $%.2f. Running the workflow as the budget is overridden",
+			contextName, spend, budget.MonthlyLimit)
+	case spend >= budget.MonthlyLimit:
+		m.err = actionableerror.New(
+			fmt.Errorf("context '%s' has spent $%.2f this month, exceeding its monthly budget of $%.2f", contextName, spend, budget.MonthlyLimit),
+			"Please raise the monthly limit of the context's budget or run the workflow with --override-budget",
+		)
+	case spend >= budget.GetWarnAmount():
+		log.Warn().Msgf("Context '%s' has spent $%.2f this month, %.0f%% of its monthly budget of $%.2f",
+			contextName, spend, spend/budget.MonthlyLimit*100, budget.MonthlyLimit)
+	}
+}
@@ -0,0 +1,88 @@
+package workflow
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/aws/amazon-genomics-cli/internal/pkg/cli/spec"
+	"github.com/stretchr/testify/assert"
+)
+
+// stubSpend is a spend source that reports a fixed month-to-date cost
+type stubSpend struct {
+	cost float64
+	err  error
+	tags map[string]string
+}
+
+func (s *stubSpend) GetMonthToDateCost(tags map[string]string) (float64, error) {
+	s.tags = tags
+	return s.cost, s.err
+}
+
+func TestManager_CheckBudget(t *testing.T) {
+	testBudget := spec.Budget{MonthlyLimit: 100, WarnThreshold: 50}
+	testCases := map[string]struct {
+		budget         spec.Budget
+		spend          stubSpend
+		overrideBudget bool
+		expectedErr    string
+	}{
+		"no budget": {
+			spend: stubSpend{cost: 1000},
+		},
+		"under threshold": {
+			budget: testBudget,
+			spend:  stubSpend{cost: 10},
+		},
+		"past threshold": {
+			budget: testBudget,
+			spend:  stubSpend{cost: 60},
+		},
+		"past limit": {
+			budget:      testBudget,
+			spend:       stubSpend{cost: 100},
+			expectedErr: "context 'TestContext1' has spent $100.00 this month, exceeding its monthly budget of $100.00",
+		},
+		"past limit overridden": {
+			budget:         testBudget,
+			spend:          stubSpend{cost: 150},
+			overrideBudget: true,
+		},
+		"spend error": {
+			budget:      testBudget,
+			spend:       stubSpend{err: errors.New("some cost error")},
+			expectedErr: "unable to check the budget of context 'TestContext1': some cost error",
+		},
+		"spend error overridden": {
+			budget:         testBudget,
+			spend:          stubSpend{err: errors.New("some cost error")},
+			overrideBudget: true,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			spend := tc.spend
+			manager := &Manager{Costs: &spend}
+			manager.projectSpec = spec.Project{Name: testProjectName}
+			manager.contextSpec = spec.Context{Budget: tc.budget}
+			manager.userId = testUserId
+
+			manager.checkBudget(testContext1Name, tc.overrideBudget)
+
+			if tc.expectedErr != "" {
+				if assert.Error(t, manager.err) {
+					assert.Contains(t, manager.err.Error(), tc.expectedErr)
+				}
+			} else {
+				assert.NoError(t, manager.err)
+			}
+			if tc.budget.IsSet() {
+				assert.Equal(t, map[string]string{"agc-project": testProjectName, "agc-context": testContext1Name, "agc-user-id": testUserId}, spend.tags)
+			} else {
+				assert.Nil(t, spend.tags)
+			}
+		})
+	}
+}
@@ -71,6 +71,35 @@ func (s *WorkflowDescribeTestSuite) TestDescribeWorkflow_Nominal() {
 	}
 }
 
+func (s *WorkflowDescribeTestSuite) TestDescribeWorkflow_Parameters() {
+	defer s.ctrl.Finish()
+	s.testProjSpec.Workflows[testWorkflow1] = spec.Workflow{
+		Type:          testWorkflowType,
+		SourceURL:     testWorkflowLocalUrl,
+		Version:       "2.1.0",
+		Description:   "Aligns reads to a reference",
+		DefaultInputs: map[string]interface{}{"align.threads": 4, "align.sample": "NA12878", "align.flags": []interface{}{"-M"}},
+		InputSchema: map[string]spec.InputParameter{
+			"align.threads": {Type: spec.InputTypeInteger},
+			"align.reads":   {Type: spec.InputTypeFile, Required: true, Description: "The reads to align"},
+		},
+	}
+	s.mockProjectClient.EXPECT().Read().Return(s.testProjSpec, nil)
+	s.mockConfigClient.EXPECT().GetUserId().Return(testUserId, nil)
+
+	actualDetails, err := s.manager.DescribeWorkflow(testWorkflow1)
+	if s.Assert().NoError(err) {
+		s.Assert().Equal("2.1.0", actualDetails.Version)
+		s.Assert().Equal("Aligns reads to a reference", actualDetails.Description)
+		s.Assert().Equal([]ParameterDetails{
+			{Name: "align.reads", Type: spec.InputTypeFile, Required: true, Description: "The reads to align"},
+			{Name: "align.threads", Type: spec.InputTypeInteger, Default: "4"},
+			{Name: "align.flags", Default: `["-M"]`},
+			{Name: "align.sample", Default: "NA12878"},
+		}, actualDetails.Parameters)
+	}
+}
+
 func (s *WorkflowDescribeTestSuite) TestDescribeWorkflow_ReadProjectSpecFailure() {
 	defer s.ctrl.Finish()
 	errorMessage := "failed to read project specification"
@@ -7,6 +7,18 @@ type Details struct {
 	TypeLanguage string
 	TypeVersion  string
 	Source       string
+	Version      string
+	Description  string
+	Parameters   []ParameterDetails
+}
+
+// ParameterDetails describes an input of a workflow declared by its input schema or default inputs
+type ParameterDetails struct {
+	Name        string
+	Type        string
+	Required    bool
+	Default     string
+	Description string
 }
 type InstanceSummary struct {
 	Id           string
@@ -0,0 +1,118 @@
+package workflow
+
+import (
+	"fmt"
+	"path/filepath"
+	"strings"
+
+	"github.com/aws/amazon-genomics-cli/internal/pkg/cli/clierror/actionableerror"
+	"github.com/aws/amazon-genomics-cli/internal/pkg/cli/spec"
+	"github.com/aws/amazon-genomics-cli/internal/pkg/cli/workflow/inputs"
+	"github.com/aws/amazon-genomics-cli/internal/pkg/storage"
+	"github.com/rs/zerolog/log"
+)
+
+type InputsManager interface {
+	DiscoverInputs(workflowName string) ([]inputs.Parameter, error)
+}
+
+// mainFileExtensions are the extensions of the main file of a workflow directory without a MANIFEST.json
+var mainFileExtensions = map[string]string{
+	"wdl":      ".wdl",
+	"nextflow": ".nf",
+	"cwl":      ".cwl",
+}
+
+// DiscoverInputs returns the inputs of a workflow by parsing its local source
+func (m *Manager) DiscoverInputs(workflowName string) ([]inputs.Parameter, error) {
+	m.readProjectSpec()
+	m.setWorkflowSpec(workflowName)
+	m.parseWorkflowLocation()
+	m.validateLocalSource(workflowName)
+	m.setWorkflowPath()
+	m.setMainWorkflowPath(workflowName)
+	m.parseInputParameters()
+	return m.inputParameters, m.err
+}
+
+func (m *Manager) validateLocalSource(workflowName string) {
+	if m.err != nil {
+		return
+	}
+	if scheme := strings.ToLower(m.parsedSourceURL.Scheme); scheme != "" && scheme != "file" {
+		m.err = actionableerror.New(
+			fmt.Errorf("the source of workflow '%s' is '%s', only local workflow sources can be parsed", workflowName, m.workflowSpec.SourceURL),
+			"Please copy the workflow into the project and set its sourceURL to the local path")
+	}
+}
+
+// setMainWorkflowPath sets the path of the main file of the workflow. For a directory this is the mainWorkflowURL of
+// its MANIFEST.json or, without one, the file named 'main' or the only file with the extension of the workflow language.
+func (m *Manager) setMainWorkflowPath(workflowName string) {
+	if m.err != nil {
+		return
+	}
+	fileInfo, err := osStat(m.path)
+	if err != nil {
+		m.err = err
+		return
+	}
+	if !fileInfo.IsDir() {
+		m.mainWorkflowPath = m.path
+		return
+	}
+	if storage.DoesManifestExistInDirectory(m.path) {
+		manifest, err := storage.ReadManifestInDirectory(m.path)
+		if err != nil {
+			m.err = err
+			return
+		}
+		if manifest.MainWorkflowUrl != "" {
+			m.mainWorkflowPath = filepath.Join(m.path, manifest.MainWorkflowUrl)
+			return
+		}
+	}
+	extension := mainFileExtensions[strings.ToLower(m.workflowSpec.Type.Language)]
+	if extension == "" {
+		m.err = unsupportedInputsLanguageError(m.workflowSpec)
+		return
+	}
+	mainPath := filepath.Join(m.path, "main"+extension)
+	if _, err := osStat(mainPath); err == nil {
+		m.mainWorkflowPath = mainPath
+		return
+	}
+	candidates, err := filepath.Glob(filepath.Join(m.path, "*"+extension))
+	if err != nil {
+		m.err = err
+		return
+	}
+	if len(candidates) != 1 {
+		m.err = actionableerror.New(
+			fmt.Errorf("unable to find the main file of workflow '%s' in '%s'", workflowName, m.path),
+			fmt.Sprintf("Please add a %s with a mainWorkflowURL to the workflow directory", storage.ManifestFileName))
+		return
+	}
+	m.mainWorkflowPath = candidates[0]
+}
+
+func (m *Manager) parseInputParameters() {
+	if m.err != nil {
+		return
+	}
+	log.Debug().Msgf("parsing the inputs of '%s'", m.mainWorkflowPath)
+	switch strings.ToLower(m.workflowSpec.Type.Language) {
+	case "wdl":
+		m.inputParameters, m.err = inputs.ParseWDL(m.mainWorkflowPath)
+	case "nextflow":
+		m.inputParameters, m.err = inputs.ParseNextflow(m.mainWorkflowPath)
+	case "cwl":
+		m.inputParameters, m.err = inputs.ParseCWL(m.mainWorkflowPath)
+	default:
+		m.err = unsupportedInputsLanguageError(m.workflowSpec)
+	}
+}
+
+func unsupportedInputsLanguageError(workflowSpec spec.Workflow) error {
+	return fmt.Errorf("the inputs of %s workflows cannot be discovered, only WDL, Nextflow and CWL workflows can be parsed", workflowSpec.Type.Language)
+}
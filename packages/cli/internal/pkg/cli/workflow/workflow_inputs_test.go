@@ -0,0 +1,119 @@
+package workflow
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/aws/amazon-genomics-cli/internal/pkg/cli/clierror/actionableerror"
+	"github.com/aws/amazon-genomics-cli/internal/pkg/cli/spec"
+	"github.com/aws/amazon-genomics-cli/internal/pkg/cli/workflow/inputs"
+	storagemocks "github.com/aws/amazon-genomics-cli/internal/pkg/mocks/storage"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+)
+
+type WorkflowInputsTestSuite struct {
+	suite.Suite
+	ctrl              *gomock.Controller
+	mockProjectClient *storagemocks.MockProjectClient
+	origOsStat        func(name string) (os.FileInfo, error)
+	projectDirectory  string
+
+	manager *Manager
+}
+
+func (s *WorkflowInputsTestSuite) BeforeTest(_, _ string) {
+	s.ctrl = gomock.NewController(s.T())
+	s.mockProjectClient = storagemocks.NewMockProjectClient(s.ctrl)
+	s.origOsStat, osStat = osStat, os.Stat
+	s.projectDirectory = s.T().TempDir()
+	s.manager = &Manager{Project: s.mockProjectClient}
+}
+
+func (s *WorkflowInputsTestSuite) AfterTest(_, _ string) {
+	osStat = s.origOsStat
+	s.ctrl.Finish()
+}
+
+func (s *WorkflowInputsTestSuite) writeFile(name, content string) {
+	path := filepath.Join(s.projectDirectory, name)
+	require.NoError(s.T(), os.MkdirAll(filepath.Dir(path), 0755))
+	require.NoError(s.T(), os.WriteFile(path, []byte(content), 0644))
+}
+
+func (s *WorkflowInputsTestSuite) expectProject(language, sourceURL string) {
+	s.mockProjectClient.EXPECT().Read().Return(spec.Project{
+		Name: testProjectName,
+		Workflows: map[string]spec.Workflow{
+			testLocalWorkflowName: {Type: spec.WorkflowType{Language: language, Version: "1.0"}, SourceURL: sourceURL},
+		},
+	}, nil)
+	s.mockProjectClient.EXPECT().GetLocation().AnyTimes().Return(s.projectDirectory)
+}
+
+func (s *WorkflowInputsTestSuite) TestDiscoverInputs_File() {
+	s.writeFile("workflows/hello.wdl", "version 1.0\nworkflow Hello {\n  input {\n    String name\n  }\n}\n")
+	s.expectProject("wdl", "workflows/hello.wdl")
+
+	parameters, err := s.manager.DiscoverInputs(testLocalWorkflowName)
+	s.Require().NoError(err)
+	s.Assert().Equal([]inputs.Parameter{{Name: "Hello.name", Type: "String", Required: true}}, parameters)
+}
+
+func (s *WorkflowInputsTestSuite) TestDiscoverInputs_Manifest() {
+	s.writeFile("workflows/words/MANIFEST.json", `{"mainWorkflowURL": "words.cwl"}`)
+	s.writeFile("workflows/words/words.cwl", "class: Workflow\ninputs:\n  vowels: string[]\n")
+	s.writeFile("workflows/words/other.cwl", "class: Workflow\ninputs:\n  other: string\n")
+	s.expectProject("cwl", "workflows/words")
+
+	parameters, err := s.manager.DiscoverInputs(testLocalWorkflowName)
+	s.Require().NoError(err)
+	s.Assert().Equal([]inputs.Parameter{{Name: "vowels", Type: "string[]", Required: true}}, parameters)
+}
+
+func (s *WorkflowInputsTestSuite) TestDiscoverInputs_MainByConvention() {
+	s.writeFile("workflows/words/main.nf", "params.vowels = ['a']\n")
+	s.writeFile("workflows/words/module.nf", "params.other = 1\n")
+	s.expectProject("nextflow", "workflows/words")
+
+	parameters, err := s.manager.DiscoverInputs(testLocalWorkflowName)
+	s.Require().NoError(err)
+	s.Assert().Equal([]inputs.Parameter{{Name: "vowels", Type: "list", Default: []interface{}{"a"}, HasDefault: true}}, parameters)
+}
+
+func (s *WorkflowInputsTestSuite) TestDiscoverInputs_NoMainFile() {
+	s.writeFile("workflows/words/a.wdl", "")
+	s.writeFile("workflows/words/b.wdl", "")
+	s.expectProject("wdl", "workflows/words")
+
+	_, err := s.manager.DiscoverInputs(testLocalWorkflowName)
+	var actionableError *actionableerror.Error
+	if s.Assert().True(errors.As(err, &actionableError)) {
+		s.Assert().EqualError(actionableError.Cause, "unable to find the main file of workflow '"+testLocalWorkflowName+"' in '"+filepath.Join(s.projectDirectory, "workflows/words")+"'")
+	}
+}
+
+func (s *WorkflowInputsTestSuite) TestDiscoverInputs_RemoteSource() {
+	s.expectProject("wdl", testWorkflowS3Url)
+
+	_, err := s.manager.DiscoverInputs(testLocalWorkflowName)
+	var actionableError *actionableerror.Error
+	if s.Assert().True(errors.As(err, &actionableError)) {
+		s.Assert().EqualError(actionableError.Cause, "the source of workflow '"+testLocalWorkflowName+"' is '"+testWorkflowS3Url+"', only local workflow sources can be parsed")
+	}
+}
+
+func (s *WorkflowInputsTestSuite) TestDiscoverInputs_UnsupportedLanguage() {
+	s.writeFile("workflows/Snakefile", "")
+	s.expectProject("snakemake", "workflows/Snakefile")
+
+	_, err := s.manager.DiscoverInputs(testLocalWorkflowName)
+	s.Assert().EqualError(err, "the inputs of snakemake workflows cannot be discovered, only WDL, Nextflow and CWL workflows can be parsed")
+}
+
+func TestWorkflowInputsTestSuite(t *testing.T) {
+	suite.Run(t, new(WorkflowInputsTestSuite))
+}
@@ -2,15 +2,18 @@ package workflow
 
 import "fmt"
 
-func (m *Manager) RunWorkflow(contextName, workflowName, inputsFileUrl string, optionFileUrl string) (string, error) {
+func (m *Manager) RunWorkflow(contextName, workflowName, inputsFileUrl string, optionFileUrl string, overrideBudget bool) (string, error) {
 	m.readProjectSpec()
 	m.setWorkflowSpec(workflowName)
 	m.readConfig()
 	m.setContext(contextName)
 	m.setEngineForWorkflowType(contextName)
 	m.validateContextIsDeployed(contextName)
+	m.checkBudget(contextName, overrideBudget)
 	m.setOutputBucket()
 	m.parseWorkflowLocation()
+	m.readInput(inputsFileUrl)
+	m.validateInputs()
 	if m.isUploadRequired() {
 		m.setBaseObjectKey(contextName, workflowName)
 		m.setWorkflowPath()
@@ -19,7 +22,6 @@ func (m *Manager) RunWorkflow(contextName, workflowName, inputsFileUrl string, o
 		m.cleanUpWorkflow()
 	}
 	m.calculateFinalLocation()
-	m.readInput(inputsFileUrl)
 	m.uploadInputsToS3()
 	m.parseInputToArguments()
 	m.readOptionFile(optionFileUrl)
@@ -208,7 +208,7 @@ func (s *WorkflowRunTestSuite) TestRunWorkflow_LocalFile_WithS3Args() {
 	s.mockOs.EXPECT().Remove(testCompressedTmpPath).After(uploadCall).Return(nil)
 	s.mockOs.EXPECT().Remove(testTmpAttachmentPath).Return(nil)
 
-	actualId, err := s.manager.RunWorkflow(testContext1Name, testLocalWorkflowName, testArgumentsPath, "")
+	actualId, err := s.manager.RunWorkflow(testContext1Name, testLocalWorkflowName, testArgumentsPath, "", false)
 	if s.Assert().NoError(err) {
 		s.Assert().Equal(testRun1Id, actualId)
 	}
@@ -240,7 +240,7 @@ func (s *WorkflowRunTestSuite) TestRunWorkflow_LocalFile_WithLocalArgs() {
 	s.mockOs.EXPECT().Remove(testCompressedTmpPath).After(uploadCall).Return(nil)
 	s.mockOs.EXPECT().Remove(testTmpAttachmentPath).Return(nil)
 
-	actualId, err := s.manager.RunWorkflow(testContext1Name, testLocalWorkflowName, testArgumentsPath, "")
+	actualId, err := s.manager.RunWorkflow(testContext1Name, testLocalWorkflowName, testArgumentsPath, "", false)
 	if s.Assert().NoError(err) {
 		s.Assert().Equal(testRun1Id, actualId)
 	}
@@ -264,7 +264,7 @@ func (s *WorkflowRunTestSuite) TestRunWorkflow_LocalFile_NoArgs() {
 	s.mockDdb.EXPECT().WriteWorkflowInstance(context.Background(), s.wfInstance).Return(nil)
 	s.mockOs.EXPECT().Remove(testCompressedTmpPath).After(uploadCall).Return(nil)
 
-	actualId, err := s.manager.RunWorkflow(testContext1Name, testLocalWorkflowName, "", "")
+	actualId, err := s.manager.RunWorkflow(testContext1Name, testLocalWorkflowName, "", "", false)
 	if s.Assert().NoError(err) {
 		s.Assert().Equal(testRun1Id, actualId)
 	}
@@ -280,7 +280,7 @@ func (s *WorkflowRunTestSuite) TestRunWorkflow_LocalFile_OptionsFile() {
 	s.mockWes.EXPECT().RunWorkflow(context.Background(), gomock.Any()).Return(testRun1Id, nil)
 	s.wfInstance.WorkflowName = testS3WorkflowName
 	s.mockDdb.EXPECT().WriteWorkflowInstance(context.Background(), s.wfInstance).Return(nil)
-	actualId, err := s.manager.RunWorkflow(testContext1Name, testS3WorkflowName, "", testOptionFilePath)
+	actualId, err := s.manager.RunWorkflow(testContext1Name, testS3WorkflowName, "", testOptionFilePath, false)
 	if s.Assert().NoError(err) {
 		s.Assert().Equal(testRun1Id, actualId)
 	}
@@ -306,7 +306,7 @@ func (s *WorkflowRunTestSuite) TestRunWorkflow_S3Object_WithLocalArgs() {
 	s.mockDdb.EXPECT().WriteWorkflowInstance(context.Background(), s.wfInstance).Return(nil)
 	s.mockOs.EXPECT().Remove(testTmpAttachmentPath).Return(nil)
 
-	actualId, err := s.manager.RunWorkflow(testContext1Name, testS3WorkflowName, testArgumentsPath, "")
+	actualId, err := s.manager.RunWorkflow(testContext1Name, testS3WorkflowName, testArgumentsPath, "", false)
 	if s.Assert().NoError(err) {
 		s.Assert().Equal(testRun1Id, actualId)
 	}
@@ -321,17 +321,104 @@ func (s *WorkflowRunTestSuite) TestRunWorkflow_S3Object_NoArgs() {
 	s.mockWes.EXPECT().RunWorkflow(context.Background(), gomock.Any()).Return(testRun1Id, nil)
 	s.wfInstance.WorkflowName = testS3WorkflowName
 	s.mockDdb.EXPECT().WriteWorkflowInstance(context.Background(), s.wfInstance).Return(nil)
-	actualId, err := s.manager.RunWorkflow(testContext1Name, testS3WorkflowName, "", "")
+	actualId, err := s.manager.RunWorkflow(testContext1Name, testS3WorkflowName, "", "", false)
 	if s.Assert().NoError(err) {
 		s.Assert().Equal(testRun1Id, actualId)
 	}
 }
 
+func (s *WorkflowRunTestSuite) TestRunWorkflow_DefaultInputs() {
+	workflowSpec := s.testProjSpec.Workflows[testS3WorkflowName]
+	workflowSpec.DefaultInputs = map[string]interface{}{testInputKey: testDataFileS3Url}
+	workflowSpec.InputSchema = map[string]spec.InputParameter{testInputKey: {Type: spec.InputTypeFile, Required: true}}
+	s.testProjSpec.Workflows[testS3WorkflowName] = workflowSpec
+	s.mockConfigClient.EXPECT().GetUserId().Return(testUserId, nil)
+	s.mockCfn.EXPECT().GetStackStatus(testContext1Stack).Return(types.StackStatusCreateComplete, nil)
+	s.mockProjectClient.EXPECT().Read().Return(s.testProjSpec, nil)
+	s.mockProjectClient.EXPECT().GetLocation().AnyTimes().Return(testProjectFileDir)
+	s.mockSsmClient.EXPECT().GetOutputBucket().Return(testOutputBucket, nil)
+	testInputS3Map := make(map[string]interface{})
+	_ = json.Unmarshal([]byte(testInputS3), &testInputS3Map)
+	s.mockInputClient.EXPECT().UpdateInputs(testProjectFileDir, testInputS3Map, testOutputBucket, testFilePathKey).Return(testInputS3Map, nil)
+	s.mockTmp.EXPECT().Write("inputs.json_*", testInputS3).Return(testTmpAttachmentPath, nil)
+	s.mockCfn.EXPECT().GetStackInfo(testContext1Stack).Return(s.testStackInfo, nil)
+	s.mockWes.EXPECT().RunWorkflow(context.Background(), gomock.Any()).Return(testRun1Id, nil)
+	s.wfInstance.WorkflowName = testS3WorkflowName
+	s.mockDdb.EXPECT().WriteWorkflowInstance(context.Background(), s.wfInstance).Return(nil)
+	s.mockOs.EXPECT().Remove(testTmpAttachmentPath).Return(nil)
+
+	actualId, err := s.manager.RunWorkflow(testContext1Name, testS3WorkflowName, "", "", false)
+	if s.Assert().NoError(err) {
+		s.Assert().Equal(testRun1Id, actualId)
+	}
+}
+
+func (s *WorkflowRunTestSuite) TestRunWorkflow_DefaultInputsWithInputsFile() {
+	referenceKey, referenceFile := "Workflow.reference", "reference.fa"
+	referenceS3Url := "s3://" + testOutputBucket + "/" + testFilePathKey + "/" + referenceFile
+	dataS3Url := "s3://" + testOutputBucket + "/" + testFilePathKey + "/" + testDataFileLocalUrl
+	workflowSpec := s.testProjSpec.Workflows[testS3WorkflowName]
+	workflowSpec.DefaultInputs = map[string]interface{}{referenceKey: referenceFile, testInputKey: testDataFileS3Url}
+	workflowSpec.InputSchema = map[string]spec.InputParameter{
+		referenceKey: {Type: spec.InputTypeFile, Required: true},
+		testInputKey: {Type: spec.InputTypeFile, Required: true},
+	}
+	s.testProjSpec.Workflows[testS3WorkflowName] = workflowSpec
+	s.mockConfigClient.EXPECT().GetUserId().Return(testUserId, nil)
+	s.mockCfn.EXPECT().GetStackStatus(testContext1Stack).Return(types.StackStatusCreateComplete, nil)
+	s.mockProjectClient.EXPECT().Read().Return(s.testProjSpec, nil)
+	s.mockProjectClient.EXPECT().GetLocation().AnyTimes().Return(testProjectFileDir)
+	s.mockSsmClient.EXPECT().GetOutputBucket().Return(testOutputBucket, nil)
+	s.mockStorageClient.EXPECT().ReadAsBytes(testArgumentsPath).Return([]byte(testInputLocal), nil)
+	s.mockOs.EXPECT().Stat(filepath.Join(testProjectFileDir, referenceFile)).Return(s.mockFileInfo, nil)
+	s.mockOs.EXPECT().Stat(filepath.Join(s.inputsAbsDir, testDataFileLocalUrl)).Return(s.mockFileInfo, nil)
+	s.mockInputClient.EXPECT().UpdateInputs(testProjectFileDir, map[string]interface{}{referenceKey: referenceFile}, testOutputBucket, testFilePathKey).
+		Return(map[string]interface{}{referenceKey: referenceS3Url}, nil)
+	s.mockInputClient.EXPECT().UpdateInputs(s.inputsAbsDir, map[string]interface{}{testInputKey: testDataFileLocalUrl}, testOutputBucket, testFilePathKey).
+		Return(map[string]interface{}{testInputKey: dataS3Url}, nil)
+	s.mockTmp.EXPECT().Write(testArgsFileName+"_*", `{"`+referenceKey+`":"`+referenceS3Url+`","`+testInputKey+`":"`+dataS3Url+`"}`).Return(testTmpAttachmentPath, nil)
+	s.mockCfn.EXPECT().GetStackInfo(testContext1Stack).Return(s.testStackInfo, nil)
+	s.mockWes.EXPECT().RunWorkflow(context.Background(), gomock.Any()).Return(testRun1Id, nil)
+	s.wfInstance.WorkflowName = testS3WorkflowName
+	s.mockDdb.EXPECT().WriteWorkflowInstance(context.Background(), s.wfInstance).Return(nil)
+	s.mockOs.EXPECT().Remove(testTmpAttachmentPath).Return(nil)
+
+	actualId, err := s.manager.RunWorkflow(testContext1Name, testS3WorkflowName, testArgumentsPath, "", false)
+	if s.Assert().NoError(err) {
+		s.Assert().Equal(testRun1Id, actualId)
+	}
+}
+
+func (s *WorkflowRunTestSuite) TestRunWorkflow_InputSchemaMismatch() {
+	workflowSpec := s.testProjSpec.Workflows[testLocalWorkflowName]
+	workflowSpec.InputSchema = map[string]spec.InputParameter{
+		testInputKey:      {Type: spec.InputTypeFile, Required: true},
+		"Workflow.count":  {Type: spec.InputTypeInteger, Required: true},
+		"Workflow.sample": {Type: spec.InputTypeString},
+	}
+	s.testProjSpec.Workflows[testLocalWorkflowName] = workflowSpec
+	s.mockConfigClient.EXPECT().GetUserId().Return(testUserId, nil)
+	s.mockCfn.EXPECT().GetStackStatus(testContext1Stack).Return(types.StackStatusCreateComplete, nil)
+	s.mockProjectClient.EXPECT().Read().Return(s.testProjSpec, nil)
+	s.mockSsmClient.EXPECT().GetOutputBucket().Return(testOutputBucket, nil)
+	s.mockStorageClient.EXPECT().ReadAsBytes(testArgumentsPath).Return([]byte(`{"`+testInputKey+`":"`+testDataFileLocalUrl+`","Workflow.sample":1}`), nil)
+	s.mockOs.EXPECT().Stat(filepath.Join(s.inputsAbsDir, testDataFileLocalUrl)).Return(nil, os.ErrNotExist)
+
+	actualId, err := s.manager.RunWorkflow(testContext1Name, testLocalWorkflowName, testArgumentsPath, "", false)
+	if s.Assert().Error(err) {
+		s.Assert().EqualError(err, testErrorPrefix+`the inputs do not match the input schema of the workflow:
+	Workflow.count: required input is missing
+	Workflow.sample: expected type 'string' but got the number 1
+	`+testInputKey+`: file '`+filepath.Join(s.inputsAbsDir, testDataFileLocalUrl)+`' does not exist`)
+		s.Assert().Empty(actualId)
+	}
+}
+
 func (s *WorkflowRunTestSuite) TestRunWorkflow_ReadProjectSpecFailure() {
 	errorMessage := "failed to read project specification"
 	s.mockProjectClient.EXPECT().Read().Return(spec.Project{}, errors.New(errorMessage))
 
-	actualId, err := s.manager.RunWorkflow(testContext1Name, "", "", "")
+	actualId, err := s.manager.RunWorkflow(testContext1Name, "", "", "", false)
 	if s.Assert().Error(err) {
 		s.Assert().EqualError(err, testErrorPrefix+errorMessage)
 		s.Assert().Empty(actualId)
@@ -341,7 +428,7 @@ func (s *WorkflowRunTestSuite) TestRunWorkflow_ReadProjectSpecFailure() {
 func (s *WorkflowRunTestSuite) TestRunWorkflow_MissingWorkflowSpec() {
 	s.mockProjectClient.EXPECT().Read().Return(s.testProjSpec, nil)
 
-	actualId, err := s.manager.RunWorkflow(testContext1Name, "dummy", "", "")
+	actualId, err := s.manager.RunWorkflow(testContext1Name, "dummy", "", "", false)
 	if s.Assert().Error(err) {
 		s.Assert().EqualError(err, testErrorPrefix+"workflow 'dummy' is not defined in Project 'TestProject1' specification")
 		s.Assert().Empty(actualId)
@@ -354,7 +441,7 @@ func (s *WorkflowRunTestSuite) TestRunWorkflow_InvalidWorkflowDefinitionUrl() {
 	s.mockProjectClient.EXPECT().Read().Return(s.testProjSpec, nil)
 	s.mockSsmClient.EXPECT().GetOutputBucket().Return(testOutputBucket, nil)
 
-	actualId, err := s.manager.RunWorkflow(testContext1Name, testInvalidWorkflowName, "", "")
+	actualId, err := s.manager.RunWorkflow(testContext1Name, testInvalidWorkflowName, "", "", false)
 	if s.Assert().Error(err) {
 		s.Assert().EqualError(err, testErrorPrefix+`parse ":NotURL:": missing protocol scheme`)
 		s.Assert().Empty(actualId)
@@ -372,7 +459,7 @@ func (s *WorkflowRunTestSuite) TestRunWorkflow_CompressionFailed() {
 	s.mockFileInfo.EXPECT().IsDir().Return(false)
 	s.mockZip.EXPECT().CompressToTmp(testFullWorkflowLocalUrl).Return("", errors.New(errorMessage))
 
-	actualId, err := s.manager.RunWorkflow(testContext1Name, testLocalWorkflowName, "", "")
+	actualId, err := s.manager.RunWorkflow(testContext1Name, testLocalWorkflowName, "", "", false)
 	if s.Assert().Error(err) {
 		s.Assert().EqualError(err, testErrorPrefix+errorMessage)
 		s.Assert().Empty(actualId)
@@ -386,7 +473,7 @@ func (s *WorkflowRunTestSuite) TestRunWorkflow_SSMClientFailed() {
 	s.mockProjectClient.EXPECT().Read().Return(s.testProjSpec, nil)
 	s.mockSsmClient.EXPECT().GetOutputBucket().Return("", errors.New(errorMessage))
 
-	actualId, err := s.manager.RunWorkflow(testContext1Name, testLocalWorkflowName, "", "")
+	actualId, err := s.manager.RunWorkflow(testContext1Name, testLocalWorkflowName, "", "", false)
 	if s.Assert().Error(err) {
 		s.Assert().EqualError(err, testErrorPrefix+errorMessage)
 		s.Assert().Empty(actualId)
@@ -410,7 +497,7 @@ func (s *WorkflowRunTestSuite) TestRunWorkflow_UploadToS3Failed() {
 	s.mockS3Client.EXPECT().UploadFile(testOutputBucket, testWorkflowZipKey, testCompressedTmpPath).Return(errors.New(errorMessage))
 	s.mockOs.EXPECT().Remove(testCompressedTmpPath).Return(nil)
 
-	actualId, err := s.manager.RunWorkflow(testContext1Name, testLocalWorkflowName, "", "")
+	actualId, err := s.manager.RunWorkflow(testContext1Name, testLocalWorkflowName, "", "", false)
 	if s.Assert().Error(err) {
 		s.Assert().EqualError(err, testErrorPrefix+expectedInfix+errorMessage)
 		s.Assert().Empty(actualId)
@@ -422,19 +509,10 @@ func (s *WorkflowRunTestSuite) TestRunWorkflow_ReadArgsFailed() {
 	s.mockCfn.EXPECT().GetStackStatus(testContext1Stack).Return(types.StackStatusCreateComplete, nil)
 	errorMessage := "cannot read input"
 	s.mockProjectClient.EXPECT().Read().Return(s.testProjSpec, nil)
-	s.mockProjectClient.EXPECT().GetLocation().Return(testProjectFileDir)
 	s.mockSsmClient.EXPECT().GetOutputBucket().Return(testOutputBucket, nil)
-	s.mockInputClient.EXPECT().UpdateInputReferencesAndUploadToS3(testFullWorkflowLocalUrl, testTempDir, testOutputBucket, testWorkflowKey).Return(nil)
-	s.mockTmp.EXPECT().TempDir("", "workflow_*").Return(testTempDir, nil)
-	s.mockOs.EXPECT().RemoveAll(testTempDir).Return(nil)
-	s.mockOs.EXPECT().Stat(testFullWorkflowLocalUrl).Return(s.mockFileInfo, nil)
-	s.mockFileInfo.EXPECT().IsDir().Return(true)
-	s.mockZip.EXPECT().CompressToTmp(testTempDir).Return(testCompressedTmpPath, nil)
-	s.mockS3Client.EXPECT().UploadFile(testOutputBucket, testWorkflowZipKey, testCompressedTmpPath).Return(nil)
 	s.mockStorageClient.EXPECT().ReadAsBytes(testArgumentsPath).Return([]byte{}, errors.New(errorMessage))
-	s.mockOs.EXPECT().Remove(testCompressedTmpPath).Return(nil)
 
-	actualId, err := s.manager.RunWorkflow(testContext1Name, testLocalWorkflowName, testArgumentsPath, "")
+	actualId, err := s.manager.RunWorkflow(testContext1Name, testLocalWorkflowName, testArgumentsPath, "", false)
 	if s.Assert().Error(err) {
 		s.Assert().EqualError(err, testErrorPrefix+errorMessage)
 		s.Assert().Empty(actualId)
@@ -462,7 +540,7 @@ func (s *WorkflowRunTestSuite) TestRunWorkflow_UploadInputFailed() {
 	_ = json.Unmarshal([]byte(testInputLocal), &testInputS3Map)
 	s.mockInputClient.EXPECT().UpdateInputs(s.inputsAbsDir, testInputS3Map, testOutputBucket, testFilePathKey).Return(nil, errors.New(errorMessage))
 
-	actualId, err := s.manager.RunWorkflow(testContext1Name, testLocalWorkflowName, testArgumentsPath, "")
+	actualId, err := s.manager.RunWorkflow(testContext1Name, testLocalWorkflowName, testArgumentsPath, "", false)
 	if s.Assert().Error(err) {
 		s.Assert().EqualError(err, testErrorPrefix+expectedInfix+errorMessage)
 		s.Assert().Empty(actualId)
@@ -483,7 +561,7 @@ func (s *WorkflowRunTestSuite) TestRunWorkflow_CfnFailed() {
 	s.mockCfn.EXPECT().GetStackInfo(testContext1Stack).Return(cfn.StackInfo{}, errors.New(errorMessage))
 	s.mockOs.EXPECT().Remove(testCompressedTmpPath).Return(nil)
 
-	actualId, err := s.manager.RunWorkflow(testContext1Name, testLocalWorkflowName, "", "")
+	actualId, err := s.manager.RunWorkflow(testContext1Name, testLocalWorkflowName, "", "", false)
 	if s.Assert().Error(err) {
 		s.Assert().EqualError(err, testErrorPrefix+errorMessage)
 		s.Assert().Empty(actualId)
@@ -508,7 +586,7 @@ func (s *WorkflowRunTestSuite) TestRunWorkflow_CfnMissingWesUrlFailed() {
 	s.mockCfn.EXPECT().GetStackInfo(testContext1Stack).Return(stackInfo, nil)
 	s.mockOs.EXPECT().Remove(testCompressedTmpPath).Return(nil)
 
-	actualId, err := s.manager.RunWorkflow(testContext1Name, testLocalWorkflowName, "", "")
+	actualId, err := s.manager.RunWorkflow(testContext1Name, testLocalWorkflowName, "", "", false)
 	if s.Assert().Error(err) {
 		s.Assert().EqualError(err, testErrorPrefix+errorMessage)
 		s.Assert().Empty(actualId)
@@ -533,7 +611,7 @@ func (s *WorkflowRunTestSuite) TestRunWorkflow_WesFailed() {
 	s.mockWes.EXPECT().RunWorkflow(context.Background(), gomock.Any()).Return("", errors.New(errorMessage))
 	s.mockOs.EXPECT().Remove(testCompressedTmpPath).Return(nil)
 
-	actualId, err := s.manager.RunWorkflow(testContext1Name, testLocalWorkflowName, "", "")
+	actualId, err := s.manager.RunWorkflow(testContext1Name, testLocalWorkflowName, "", "", false)
 	if s.Assert().Error(err) {
 		s.Assert().EqualError(err, testErrorPrefix+errorMessage)
 		s.Assert().Empty(actualId)
@@ -546,7 +624,7 @@ func (s *WorkflowRunTestSuite) TestRunWorkflow_DeployValidationFailed() {
 	s.mockProjectClient.EXPECT().Read().Return(s.testProjSpec, nil)
 	s.mockCfn.EXPECT().GetStackStatus(testContext1Stack).Return(types.StackStatusCreateComplete, cfn.StackDoesNotExistError)
 
-	actualId, err := s.manager.RunWorkflow(testContext1Name, testLocalWorkflowName, "", "")
+	actualId, err := s.manager.RunWorkflow(testContext1Name, testLocalWorkflowName, "", "", false)
 	if s.Assert().Error(err) {
 		s.Assert().EqualError(err, testErrorPrefix+errorMessage)
 		s.Assert().Empty(actualId)
@@ -559,7 +637,7 @@ func (s *WorkflowRunTestSuite) TestRunWorkflow_DeployValidationCfnFailed() {
 	s.mockProjectClient.EXPECT().Read().Return(s.testProjSpec, nil)
 	s.mockCfn.EXPECT().GetStackStatus(testContext1Stack).Return(types.StackStatusCreateComplete, errors.New(errorMessage))
 
-	actualId, err := s.manager.RunWorkflow(testContext1Name, testLocalWorkflowName, "", "")
+	actualId, err := s.manager.RunWorkflow(testContext1Name, testLocalWorkflowName, "", "", false)
 	if s.Assert().Error(err) {
 		s.Assert().EqualError(err, testErrorPrefix+errorMessage)
 		s.Assert().Empty(actualId)
@@ -577,7 +655,7 @@ func (s *WorkflowRunTestSuite) TestRunWorkflow_CreateTempDir() {
 	s.mockFileInfo.EXPECT().IsDir().Return(true)
 	s.mockTmp.EXPECT().TempDir("", "workflow_*").Return("", errors.New(errorMessage))
 
-	actualId, err := s.manager.RunWorkflow(testContext1Name, testLocalWorkflowName, "", "")
+	actualId, err := s.manager.RunWorkflow(testContext1Name, testLocalWorkflowName, "", "", false)
 	if s.Assert().Error(err) {
 		s.Assert().EqualError(err, testErrorPrefix+errorMessage)
 		s.Assert().Empty(actualId)
@@ -597,7 +675,7 @@ func (s *WorkflowRunTestSuite) TestRunWorkflow_CopyError() {
 	s.mockInputClient.EXPECT().UpdateInputReferencesAndUploadToS3(testFullWorkflowLocalUrl, testTempDir, testOutputBucket, testWorkflowKey).Return(errors.New(errorMessage))
 	s.mockOs.EXPECT().RemoveAll(testTempDir).Return(nil)
 
-	actualId, err := s.manager.RunWorkflow(testContext1Name, testLocalWorkflowName, "", "")
+	actualId, err := s.manager.RunWorkflow(testContext1Name, testLocalWorkflowName, "", "", false)
 	if s.Assert().Error(err) {
 		s.Assert().EqualError(err, testErrorPrefix+errorMessage)
 		s.Assert().Empty(actualId)
@@ -628,7 +706,7 @@ func (s *WorkflowRunTestSuite) TestRunWorkflow_RemoveErrorStillWorks() {
 	s.mockOs.EXPECT().Remove(testCompressedTmpPath).After(uploadCall).Return(nil)
 	s.mockOs.EXPECT().Remove(testTmpAttachmentPath).Return(nil)
 
-	actualId, err := s.manager.RunWorkflow(testContext1Name, testLocalWorkflowName, testArgumentsPath, "")
+	actualId, err := s.manager.RunWorkflow(testContext1Name, testLocalWorkflowName, testArgumentsPath, "", false)
 	if s.Assert().NoError(err) {
 		s.Assert().Equal(testRun1Id, actualId)
 	}
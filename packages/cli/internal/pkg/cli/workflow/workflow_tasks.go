@@ -20,11 +20,12 @@ type Task struct {
 }
 
 type RunLog struct {
-	RunId  string
-	State  string
-	Stdout string
-	Stderr string
-	Tasks  []Task
+	RunId         string
+	State         string
+	Stdout        string
+	Stderr        string
+	Tasks         []Task
+	TargetProfile string
 }
 
 func (m *Manager) GetWorkflowTasks(runId string) ([]Task, error) {
@@ -53,11 +54,12 @@ func (m *Manager) GetRunLog(runId string) (RunLog, error) {
 	}
 
 	return RunLog{
-		RunId:  m.taskProps.runLog.RunId,
-		State:  string(m.taskProps.runLog.State),
-		Stdout: m.taskProps.runLog.RunLog.Stdout,
-		Stderr: m.taskProps.runLog.RunLog.Stderr,
-		Tasks:  tasks,
+		RunId:         m.taskProps.runLog.RunId,
+		State:         string(m.taskProps.runLog.State),
+		Stdout:        m.taskProps.runLog.RunLog.Stdout,
+		Stderr:        m.taskProps.runLog.RunLog.Stderr,
+		Tasks:         tasks,
+		TargetProfile: m.contextTargetProfile(),
 	}, nil
 }
 
@@ -43,6 +43,17 @@ func (o *describeWorkflowOpts) Execute() (types.Workflow, error) {
 		TypeLanguage: details.TypeLanguage,
 		TypeVersion:  details.TypeVersion,
 		Source:       details.Source,
+		Version:      details.Version,
+		Description:  details.Description,
+	}
+	for _, parameter := range details.Parameters {
+		workflow.Parameters = append(workflow.Parameters, types.WorkflowParameter{
+			Name:        parameter.Name,
+			Type:        parameter.Type,
+			Required:    parameter.Required,
+			Default:     parameter.Default,
+			Description: parameter.Description,
+		})
 	}
 
 	return workflow, nil
@@ -57,6 +68,7 @@ func BuildWorkflowDescribeCommand() *cobra.Command {
 		Long: `describe is for showing details on the specified workflow.
 It includes workflow specification and list of recent instances of that workflow.
 An instance is created every time we run a workflow.
+The inputs declared by the inputSchema and defaultInputs of the workflow are listed as parameters.
 
 ` + DescribeOutput(types.Workflow{}),
 		Args: cobra.ExactArgs(1),
@@ -0,0 +1,127 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/aws/amazon-genomics-cli/internal/pkg/cli/clierror"
+	"github.com/aws/amazon-genomics-cli/internal/pkg/cli/format"
+	"github.com/aws/amazon-genomics-cli/internal/pkg/cli/types"
+	"github.com/aws/amazon-genomics-cli/internal/pkg/cli/workflow"
+	"github.com/aws/amazon-genomics-cli/internal/pkg/cli/workflow/inputs"
+	"github.com/rs/zerolog/log"
+	"github.com/spf13/cobra"
+)
+
+const (
+	templateFlag            = "template"
+	templateFlagDescription = "Print a skeleton inputs file in JSON, ready to be completed and used with 'workflow run --inputsFile'."
+)
+
+type workflowInputsVars struct {
+	workflowName string
+	template     bool
+}
+
+type workflowInputsOpts struct {
+	workflowInputsVars
+	wfManager workflow.InputsManager
+}
+
+func newWorkflowInputsOpts(vars workflowInputsVars) (*workflowInputsOpts, error) {
+	return &workflowInputsOpts{
+		workflowInputsVars: vars,
+		wfManager:          workflow.NewManager(profile),
+	}, nil
+}
+
+func (o *workflowInputsOpts) Validate() error {
+	return nil
+}
+
+// Execute returns the inputs of the workflow, parsed from its source
+func (o *workflowInputsOpts) Execute() ([]inputs.Parameter, error) {
+	return o.wfManager.DiscoverInputs(o.workflowName)
+}
+
+func renderInputsTemplate(parameters []inputs.Parameter) (string, error) {
+	templateBytes, err := json.MarshalIndent(inputs.Template(parameters), "", "  ")
+	if err != nil {
+		return "", err
+	}
+	return string(templateBytes), nil
+}
+
+func toWorkflowInputs(parameters []inputs.Parameter) []types.WorkflowInput {
+	workflowInputs := make([]types.WorkflowInput, 0, len(parameters))
+	for _, parameter := range parameters {
+		workflowInput := types.WorkflowInput{
+			Name:     parameter.Name,
+			Type:     parameter.Type,
+			Required: parameter.Required,
+			Default:  parameter.DefaultExpression,
+		}
+		if parameter.HasDefault {
+			defaultBytes, err := json.Marshal(parameter.Default)
+			if err != nil {
+				workflowInput.Default = fmt.Sprint(parameter.Default)
+			} else {
+				workflowInput.Default = string(defaultBytes)
+			}
+		}
+		workflowInputs = append(workflowInputs, workflowInput)
+	}
+	return workflowInputs
+}
+
+// BuildWorkflowInputsCommand builds the command to list the inputs of a workflow in the current project.
+func BuildWorkflowInputsCommand() *cobra.Command {
+	vars := workflowInputsVars{}
+	cmd := &cobra.Command{
+		Use:   "inputs workflow_name [--template]",
+		Short: "Show the inputs of a workflow in the current project",
+		Long: `inputs lists the inputs of a workflow by parsing its source, without running a workflow engine.
+The source must be local to the project. WDL input sections are read along with the unbound inputs of the
+tasks and workflows called, following local imports. Nextflow params are read from the assignments in the main
+script and from the nextflow.config next to it. CWL inputs are read from the main process.
+
+With --template a skeleton inputs file is printed instead. Inputs with a literal default hold it, the others
+hold their type, which should be replaced with a value. Optional inputs may be removed.
+
+` + DescribeOutput([]types.WorkflowInput{}),
+		Example: `
+/code agc workflow inputs hello --template > inputs.json`,
+		Args: cobra.ExactArgs(1),
+		RunE: runCmdE(func(cmd *cobra.Command, args []string) error {
+			vars.workflowName = args[0]
+			opts, err := newWorkflowInputsOpts(vars)
+			if err != nil {
+				return err
+			}
+			if err := opts.Validate(); err != nil {
+				return err
+			}
+			log.Info().Msgf("Parsing the inputs of workflow '%s'", vars.workflowName)
+			parameters, err := opts.Execute()
+			if err != nil {
+				return clierror.New("workflow inputs", vars, err)
+			}
+			if !vars.template {
+				format.Default.Write(toWorkflowInputs(parameters))
+				return nil
+			}
+			template, err := renderInputsTemplate(parameters)
+			if err != nil {
+				return clierror.New("workflow inputs", vars, err)
+			}
+			printLn(template)
+			return nil
+		}),
+		ValidArgsFunction: NewWorkflowAutoComplete().GetWorkflowAutoComplete(),
+	}
+	cmd.Flags().BoolVar(&vars.template, templateFlag, false, templateFlagDescription)
+	return cmd
+}
@@ -0,0 +1,63 @@
+package cli
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/aws/amazon-genomics-cli/internal/pkg/cli/types"
+	"github.com/aws/amazon-genomics-cli/internal/pkg/cli/workflow/inputs"
+	managermocks "github.com/aws/amazon-genomics-cli/internal/pkg/mocks/manager"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var testWorkflowInputParameters = []inputs.Parameter{
+	{Name: "Hello.name", Type: "String", Required: true},
+	{Name: "Hello.vowels", Type: "Array[String]", Default: []interface{}{"a", "e"}, HasDefault: true},
+	{Name: "Hello.prefix", Type: "String", DefaultExpression: `name + "_"`},
+}
+
+func TestWorkflowInputsOpts_Execute(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	wfManager := managermocks.NewMockWorkflowManager(ctrl)
+	opts := &workflowInputsOpts{workflowInputsVars: workflowInputsVars{workflowName: "hello"}, wfManager: wfManager}
+	wfManager.EXPECT().DiscoverInputs("hello").Return(testWorkflowInputParameters, nil)
+
+	parameters, err := opts.Execute()
+	require.NoError(t, err)
+	assert.Equal(t, testWorkflowInputParameters, parameters)
+}
+
+func TestWorkflowInputsOpts_Execute_Error(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	wfManager := managermocks.NewMockWorkflowManager(ctrl)
+	opts := &workflowInputsOpts{workflowInputsVars: workflowInputsVars{workflowName: "hello"}, wfManager: wfManager}
+	wfManager.EXPECT().DiscoverInputs("hello").Return(nil, errors.New("cannot parse"))
+
+	_, err := opts.Execute()
+	assert.EqualError(t, err, "cannot parse")
+}
+
+func TestToWorkflowInputs(t *testing.T) {
+	assert.Equal(t, []types.WorkflowInput{
+		{Name: "Hello.name", Type: "String", Required: true},
+		{Name: "Hello.vowels", Type: "Array[String]", Default: `["a","e"]`},
+		{Name: "Hello.prefix", Type: "String", Default: `name + "_"`},
+	}, toWorkflowInputs(testWorkflowInputParameters))
+}
+
+func TestRenderInputsTemplate(t *testing.T) {
+	template, err := renderInputsTemplate(testWorkflowInputParameters)
+	require.NoError(t, err)
+	assert.Equal(t, `{
+  "Hello.name": "String",
+  "Hello.prefix": "String (optional, default = name + \"_\")",
+  "Hello.vowels": [
+    "a",
+    "e"
+  ]
+}`, template)
+}
@@ -15,7 +15,7 @@ import (
 const (
 	contextFlag            = "context"
 	contextFlagShort       = "c"
-	contextFlagDescription = "Name of context"
+	contextFlagDescription = "Name of context. Defaults to the default context of the configuration profile"
 )
 
 const (
@@ -24,11 +24,17 @@ const (
 	inputsFileFlagDescription = "Inputs File Path"
 )
 
+const (
+	overrideBudgetFlag            = "override-budget"
+	overrideBudgetFlagDescription = "Run the workflow even if the context has exceeded its monthly budget"
+)
+
 type runWorkflowVars struct {
-	WorkflowName string
-	InputsFile   string
-	OptionFile   string
-	ContextName  string
+	WorkflowName   string
+	InputsFile     string
+	OptionFile     string
+	ContextName    string
+	OverrideBudget bool
 }
 
 type runWorkflowOpts struct {
@@ -48,7 +54,7 @@ func (o *runWorkflowOpts) Validate() error {
 }
 
 func (o *runWorkflowOpts) Execute() (string, error) {
-	return o.wfManager.RunWorkflow(o.ContextName, o.WorkflowName, o.InputsFile, o.OptionFile)
+	return o.wfManager.RunWorkflow(o.ContextName, o.WorkflowName, o.InputsFile, o.OptionFile, o.OverrideBudget)
 }
 
 func BuildWorkflowRunCommand() *cobra.Command {
@@ -58,6 +64,8 @@ func BuildWorkflowRunCommand() *cobra.Command {
 		Short: "Run a workflow",
 		Long: `run is for running the specified workflow in the specified context.
 This command prints a run Id for the created workflow instance.
+If the context has a budget, the run is refused once the month-to-date spend of the
+context has reached the monthly limit, unless --override-budget is given.
 `,
 		Example: `
 Run the workflow named "myworkflow", against the "prod" context,
@@ -66,6 +74,9 @@ using input parameters contained in file "/home/ec2-user/myproj/workflows/mywork
 		Args: cobra.ExactArgs(1),
 		RunE: runCmdE(func(cmd *cobra.Command, args []string) error {
 			vars.WorkflowName = args[0]
+			if err := useDefaultContext(&vars.ContextName); err != nil {
+				return err
+			}
 			opts, err := newRunWorkflowOpts(vars)
 			if err != nil {
 				return clierror.New("workflow run", vars, err)
@@ -87,6 +98,7 @@ using input parameters contained in file "/home/ec2-user/myproj/workflows/mywork
 	cmd.Flags().StringVarP(&vars.InputsFile, inputsFileFlag, inputsFileFlagShort, "", inputsFileFlagDescription)
 	cmd.Flags().StringVarP(&vars.OptionFile, optionFileFlag, optionFileFlagShort, "", optionFileFlagDescription)
 	cmd.Flags().StringVarP(&vars.ContextName, contextFlag, contextFlagShort, "", contextFlagDescription)
+	cmd.Flags().BoolVar(&vars.OverrideBudget, overrideBudgetFlag, false, overrideBudgetFlagDescription)
 	aliasFn := func(f *pflag.FlagSet, name string) pflag.NormalizedName {
 		switch name {
 		case argsFlag:
@@ -95,7 +107,6 @@ using input parameters contained in file "/home/ec2-user/myproj/workflows/mywork
 		return pflag.NormalizedName(name)
 	}
 	cmd.Flags().SetNormalizeFunc(aliasFn)
-	_ = cmd.MarkFlagRequired(contextFlag)
 	_ = cmd.RegisterFlagCompletionFunc(contextFlag, NewContextAutoComplete().GetContextAutoComplete())
 	return cmd
 }
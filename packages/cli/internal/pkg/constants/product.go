@@ -5,6 +5,9 @@ const (
 	AppTagKey     = "application-name"
 	AppTagValue   = "agc"
 	AgcVersionKey = "agc-version"
+	ProjectTagKey = "agc-project"
+	ContextTagKey = "agc-context"
+	UserIdTagKey  = "agc-user-id"
 
 	CustomTagEnvKey     = "CUSTOM_TAGS"
 	AgcBucketNameEnvKey = "AGC_BUCKET_NAME"
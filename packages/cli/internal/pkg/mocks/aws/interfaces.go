@@ -4,12 +4,18 @@ import (
 	"github.com/aws/amazon-genomics-cli/internal/pkg/aws/batch"
 	"github.com/aws/amazon-genomics-cli/internal/pkg/aws/cdk"
 	"github.com/aws/amazon-genomics-cli/internal/pkg/aws/cfn"
+	"github.com/aws/amazon-genomics-cli/internal/pkg/aws/costexplorer"
 	"github.com/aws/amazon-genomics-cli/internal/pkg/aws/cwl"
 	"github.com/aws/amazon-genomics-cli/internal/pkg/aws/ddb"
+	"github.com/aws/amazon-genomics-cli/internal/pkg/aws/ec2"
 	"github.com/aws/amazon-genomics-cli/internal/pkg/aws/ecr"
+	"github.com/aws/amazon-genomics-cli/internal/pkg/aws/efs"
 	"github.com/aws/amazon-genomics-cli/internal/pkg/aws/s3"
+	"github.com/aws/amazon-genomics-cli/internal/pkg/aws/secretsmanager"
+	"github.com/aws/amazon-genomics-cli/internal/pkg/aws/servicequotas"
 	"github.com/aws/amazon-genomics-cli/internal/pkg/aws/ssm"
 	"github.com/aws/amazon-genomics-cli/internal/pkg/aws/sts"
+	"github.com/aws/amazon-genomics-cli/internal/pkg/aws/tagging"
 )
 
 type CdkClient interface {
@@ -54,3 +60,27 @@ type DdbClient interface {
 type EcrClient interface {
 	ecr.Interface
 }
+
+type SecretsManagerClient interface {
+	secretsmanager.Interface
+}
+
+type Ec2Client interface {
+	ec2.Interface
+}
+
+type ServiceQuotasClient interface {
+	servicequotas.Interface
+}
+
+type EfsClient interface {
+	efs.Interface
+}
+
+type CostExplorerClient interface {
+	costexplorer.Interface
+}
+
+type TaggingClient interface {
+	tagging.Interface
+}
@@ -14,7 +14,9 @@ import (
 	cfn "github.com/aws/amazon-genomics-cli/internal/pkg/aws/cfn"
 	cwl "github.com/aws/amazon-genomics-cli/internal/pkg/aws/cwl"
 	ddb "github.com/aws/amazon-genomics-cli/internal/pkg/aws/ddb"
+	ec2 "github.com/aws/amazon-genomics-cli/internal/pkg/aws/ec2"
 	ecr "github.com/aws/amazon-genomics-cli/internal/pkg/aws/ecr"
+	tagging "github.com/aws/amazon-genomics-cli/internal/pkg/aws/tagging"
 	types "github.com/aws/aws-sdk-go-v2/service/cloudformation/types"
 	gomock "github.com/golang/mock/gomock"
 )
@@ -223,6 +225,21 @@ func (mr *MockS3ClientMockRecorder) DeleteObjectVersion(bucketName, key, version
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteObjectVersion", reflect.TypeOf((*MockS3Client)(nil).DeleteObjectVersion), bucketName, key, versionId)
 }
 
+// DownloadPrefix mocks base method.
+func (m *MockS3Client) DownloadPrefix(bucketName, prefix, directory string) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DownloadPrefix", bucketName, prefix, directory)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DownloadPrefix indicates an expected call of DownloadPrefix.
+func (mr *MockS3ClientMockRecorder) DownloadPrefix(bucketName, prefix, directory interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DownloadPrefix", reflect.TypeOf((*MockS3Client)(nil).DownloadPrefix), bucketName, prefix, directory)
+}
+
 // EmptyBucket mocks base method.
 func (m *MockS3Client) EmptyBucket(bucketName string) error {
 	m.ctrl.T.Helper()
@@ -237,6 +254,51 @@ func (mr *MockS3ClientMockRecorder) EmptyBucket(bucketName interface{}) *gomock.
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EmptyBucket", reflect.TypeOf((*MockS3Client)(nil).EmptyBucket), bucketName)
 }
 
+// GetBucketRegion mocks base method.
+func (m *MockS3Client) GetBucketRegion(bucketName string) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetBucketRegion", bucketName)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetBucketRegion indicates an expected call of GetBucketRegion.
+func (mr *MockS3ClientMockRecorder) GetBucketRegion(bucketName interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBucketRegion", reflect.TypeOf((*MockS3Client)(nil).GetBucketRegion), bucketName)
+}
+
+// IsBucketOwner mocks base method.
+func (m *MockS3Client) IsBucketOwner(bucketName, accountId string) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "IsBucketOwner", bucketName, accountId)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// IsBucketOwner indicates an expected call of IsBucketOwner.
+func (mr *MockS3ClientMockRecorder) IsBucketOwner(bucketName, accountId interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IsBucketOwner", reflect.TypeOf((*MockS3Client)(nil).IsBucketOwner), bucketName, accountId)
+}
+
+// ObjectExists mocks base method.
+func (m *MockS3Client) ObjectExists(bucketName, key string, requesterPays bool) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ObjectExists", bucketName, key, requesterPays)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ObjectExists indicates an expected call of ObjectExists.
+func (mr *MockS3ClientMockRecorder) ObjectExists(bucketName, key, requesterPays interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ObjectExists", reflect.TypeOf((*MockS3Client)(nil).ObjectExists), bucketName, key, requesterPays)
+}
+
 // SyncFile mocks base method.
 func (m *MockS3Client) SyncFile(bucketName, key, filePath string) error {
 	m.ctrl.T.Helper()
@@ -370,6 +432,21 @@ func (mr *MockSsmClientMockRecorder) GetOutputBucket() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOutputBucket", reflect.TypeOf((*MockSsmClient)(nil).GetOutputBucket))
 }
 
+// ParameterExists mocks base method.
+func (m *MockSsmClient) ParameterExists(parameterName string) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ParameterExists", parameterName)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ParameterExists indicates an expected call of ParameterExists.
+func (mr *MockSsmClientMockRecorder) ParameterExists(parameterName interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ParameterExists", reflect.TypeOf((*MockSsmClient)(nil).ParameterExists), parameterName)
+}
+
 // MockCfnClient is a mock of CfnClient interface.
 type MockCfnClient struct {
 	ctrl     *gomock.Controller
@@ -544,6 +621,20 @@ func (m *MockCwlClient) EXPECT() *MockCwlClientMockRecorder {
 	return m.recorder
 }
 
+// DeleteLogGroup mocks base method.
+func (m *MockCwlClient) DeleteLogGroup(logGroupName string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteLogGroup", logGroupName)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteLogGroup indicates an expected call of DeleteLogGroup.
+func (mr *MockCwlClientMockRecorder) DeleteLogGroup(logGroupName interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteLogGroup", reflect.TypeOf((*MockCwlClient)(nil).DeleteLogGroup), logGroupName)
+}
+
 // GetLogsPaginated mocks base method.
 func (m *MockCwlClient) GetLogsPaginated(input cwl.GetLogsInput) cwl.LogPaginator {
 	m.ctrl.T.Helper()
@@ -558,6 +649,21 @@ func (mr *MockCwlClientMockRecorder) GetLogsPaginated(input interface{}) *gomock
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetLogsPaginated", reflect.TypeOf((*MockCwlClient)(nil).GetLogsPaginated), input)
 }
 
+// LogGroupExists mocks base method.
+func (m *MockCwlClient) LogGroupExists(logGroupName string) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "LogGroupExists", logGroupName)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// LogGroupExists indicates an expected call of LogGroupExists.
+func (mr *MockCwlClientMockRecorder) LogGroupExists(logGroupName interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LogGroupExists", reflect.TypeOf((*MockCwlClient)(nil).LogGroupExists), logGroupName)
+}
+
 // StreamLogs mocks base method.
 func (m *MockCwlClient) StreamLogs(ctx context.Context, logGroupName string, streams ...string) <-chan cwl.StreamEvent {
 	m.ctrl.T.Helper()
@@ -652,6 +758,21 @@ func (m *MockDdbClient) EXPECT() *MockDdbClientMockRecorder {
 	return m.recorder
 }
 
+// GetContextDeployment mocks base method.
+func (m *MockDdbClient) GetContextDeployment(ctx context.Context, project, owner, contextName, deploymentId string) (ddb.ContextDeployment, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetContextDeployment", ctx, project, owner, contextName, deploymentId)
+	ret0, _ := ret[0].(ddb.ContextDeployment)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetContextDeployment indicates an expected call of GetContextDeployment.
+func (mr *MockDdbClientMockRecorder) GetContextDeployment(ctx, project, owner, contextName, deploymentId interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetContextDeployment", reflect.TypeOf((*MockDdbClient)(nil).GetContextDeployment), ctx, project, owner, contextName, deploymentId)
+}
+
 // GetWorkflowInstanceById mocks base method.
 func (m *MockDdbClient) GetWorkflowInstanceById(ctx context.Context, project, user, runId string) (ddb.WorkflowInstance, error) {
 	m.ctrl.T.Helper()
@@ -667,6 +788,21 @@ func (mr *MockDdbClientMockRecorder) GetWorkflowInstanceById(ctx, project, user,
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetWorkflowInstanceById", reflect.TypeOf((*MockDdbClient)(nil).GetWorkflowInstanceById), ctx, project, user, runId)
 }
 
+// ListContextDeployments mocks base method.
+func (m *MockDdbClient) ListContextDeployments(ctx context.Context, project, owner, contextName string) ([]ddb.ContextDeployment, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListContextDeployments", ctx, project, owner, contextName)
+	ret0, _ := ret[0].([]ddb.ContextDeployment)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListContextDeployments indicates an expected call of ListContextDeployments.
+func (mr *MockDdbClientMockRecorder) ListContextDeployments(ctx, project, owner, contextName interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListContextDeployments", reflect.TypeOf((*MockDdbClient)(nil).ListContextDeployments), ctx, project, owner, contextName)
+}
+
 // ListWorkflowInstances mocks base method.
 func (m *MockDdbClient) ListWorkflowInstances(ctx context.Context, project, user string, limit int) ([]ddb.WorkflowInstance, error) {
 	m.ctrl.T.Helper()
@@ -727,6 +863,20 @@ func (mr *MockDdbClientMockRecorder) ListWorkflows(ctx, project, user interface{
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListWorkflows", reflect.TypeOf((*MockDdbClient)(nil).ListWorkflows), ctx, project, user)
 }
 
+// WriteContextDeployment mocks base method.
+func (m *MockDdbClient) WriteContextDeployment(ctx context.Context, deployment ddb.ContextDeployment) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "WriteContextDeployment", ctx, deployment)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// WriteContextDeployment indicates an expected call of WriteContextDeployment.
+func (mr *MockDdbClientMockRecorder) WriteContextDeployment(ctx, deployment interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WriteContextDeployment", reflect.TypeOf((*MockDdbClient)(nil).WriteContextDeployment), ctx, deployment)
+}
+
 // WriteWorkflowInstance mocks base method.
 func (m *MockDdbClient) WriteWorkflowInstance(ctx context.Context, instance ddb.WorkflowInstance) error {
 	m.ctrl.T.Helper()
@@ -764,6 +914,20 @@ func (m *MockEcrClient) EXPECT() *MockEcrClientMockRecorder {
 	return m.recorder
 }
 
+// DeleteRepository mocks base method.
+func (m *MockEcrClient) DeleteRepository(repositoryName string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteRepository", repositoryName)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteRepository indicates an expected call of DeleteRepository.
+func (mr *MockEcrClientMockRecorder) DeleteRepository(repositoryName interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteRepository", reflect.TypeOf((*MockEcrClient)(nil).DeleteRepository), repositoryName)
+}
+
 // ImageListable mocks base method.
 func (m *MockEcrClient) ImageListable(arg0, arg1, arg2, arg3 string) (bool, error) {
 	m.ctrl.T.Helper()
@@ -779,6 +943,21 @@ func (mr *MockEcrClientMockRecorder) ImageListable(arg0, arg1, arg2, arg3 interf
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ImageListable", reflect.TypeOf((*MockEcrClient)(nil).ImageListable), arg0, arg1, arg2, arg3)
 }
 
+// RepositoryExists mocks base method.
+func (m *MockEcrClient) RepositoryExists(repositoryName string) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RepositoryExists", repositoryName)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RepositoryExists indicates an expected call of RepositoryExists.
+func (mr *MockEcrClientMockRecorder) RepositoryExists(repositoryName interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RepositoryExists", reflect.TypeOf((*MockEcrClient)(nil).RepositoryExists), repositoryName)
+}
+
 // VerifyImageExists mocks base method.
 func (m *MockEcrClient) VerifyImageExists(reference ecr.ImageReference) error {
 	m.ctrl.T.Helper()
@@ -792,3 +971,320 @@ func (mr *MockEcrClientMockRecorder) VerifyImageExists(reference interface{}) *g
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "VerifyImageExists", reflect.TypeOf((*MockEcrClient)(nil).VerifyImageExists), reference)
 }
+
+// MockSecretsManagerClient is a mock of SecretsManagerClient interface.
+type MockSecretsManagerClient struct {
+	ctrl     *gomock.Controller
+	recorder *MockSecretsManagerClientMockRecorder
+}
+
+// MockSecretsManagerClientMockRecorder is the mock recorder for MockSecretsManagerClient.
+type MockSecretsManagerClientMockRecorder struct {
+	mock *MockSecretsManagerClient
+}
+
+// NewMockSecretsManagerClient creates a new mock instance.
+func NewMockSecretsManagerClient(ctrl *gomock.Controller) *MockSecretsManagerClient {
+	mock := &MockSecretsManagerClient{ctrl: ctrl}
+	mock.recorder = &MockSecretsManagerClientMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockSecretsManagerClient) EXPECT() *MockSecretsManagerClientMockRecorder {
+	return m.recorder
+}
+
+// SecretExists mocks base method.
+func (m *MockSecretsManagerClient) SecretExists(secretId string) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SecretExists", secretId)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SecretExists indicates an expected call of SecretExists.
+func (mr *MockSecretsManagerClientMockRecorder) SecretExists(secretId interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SecretExists", reflect.TypeOf((*MockSecretsManagerClient)(nil).SecretExists), secretId)
+}
+
+// MockEc2Client is a mock of Ec2Client interface.
+type MockEc2Client struct {
+	ctrl     *gomock.Controller
+	recorder *MockEc2ClientMockRecorder
+}
+
+// MockEc2ClientMockRecorder is the mock recorder for MockEc2Client.
+type MockEc2ClientMockRecorder struct {
+	mock *MockEc2Client
+}
+
+// NewMockEc2Client creates a new mock instance.
+func NewMockEc2Client(ctrl *gomock.Controller) *MockEc2Client {
+	mock := &MockEc2Client{ctrl: ctrl}
+	mock.recorder = &MockEc2ClientMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockEc2Client) EXPECT() *MockEc2ClientMockRecorder {
+	return m.recorder
+}
+
+// CountElasticIps mocks base method.
+func (m *MockEc2Client) CountElasticIps() (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CountElasticIps")
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CountElasticIps indicates an expected call of CountElasticIps.
+func (mr *MockEc2ClientMockRecorder) CountElasticIps() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CountElasticIps", reflect.TypeOf((*MockEc2Client)(nil).CountElasticIps))
+}
+
+// CountVpcs mocks base method.
+func (m *MockEc2Client) CountVpcs() (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CountVpcs")
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CountVpcs indicates an expected call of CountVpcs.
+func (mr *MockEc2ClientMockRecorder) CountVpcs() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CountVpcs", reflect.TypeOf((*MockEc2Client)(nil).CountVpcs))
+}
+
+// GetImageOwnerId mocks base method.
+func (m *MockEc2Client) GetImageOwnerId(imageId string) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetImageOwnerId", imageId)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetImageOwnerId indicates an expected call of GetImageOwnerId.
+func (mr *MockEc2ClientMockRecorder) GetImageOwnerId(imageId interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetImageOwnerId", reflect.TypeOf((*MockEc2Client)(nil).GetImageOwnerId), imageId)
+}
+
+// GetVpcEndpointServiceNames mocks base method.
+func (m *MockEc2Client) GetVpcEndpointServiceNames(vpcId string) ([]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetVpcEndpointServiceNames", vpcId)
+	ret0, _ := ret[0].([]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetVpcEndpointServiceNames indicates an expected call of GetVpcEndpointServiceNames.
+func (mr *MockEc2ClientMockRecorder) GetVpcEndpointServiceNames(vpcId interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetVpcEndpointServiceNames", reflect.TypeOf((*MockEc2Client)(nil).GetVpcEndpointServiceNames), vpcId)
+}
+
+// GetVpcSubnets mocks base method.
+func (m *MockEc2Client) GetVpcSubnets(vpcId string) ([]ec2.Subnet, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetVpcSubnets", vpcId)
+	ret0, _ := ret[0].([]ec2.Subnet)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetVpcSubnets indicates an expected call of GetVpcSubnets.
+func (mr *MockEc2ClientMockRecorder) GetVpcSubnets(vpcId interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetVpcSubnets", reflect.TypeOf((*MockEc2Client)(nil).GetVpcSubnets), vpcId)
+}
+
+// GetVpcTags mocks base method.
+func (m *MockEc2Client) GetVpcTags(vpcId string) (map[string]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetVpcTags", vpcId)
+	ret0, _ := ret[0].(map[string]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetVpcTags indicates an expected call of GetVpcTags.
+func (mr *MockEc2ClientMockRecorder) GetVpcTags(vpcId interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetVpcTags", reflect.TypeOf((*MockEc2Client)(nil).GetVpcTags), vpcId)
+}
+
+// MockServiceQuotasClient is a mock of ServiceQuotasClient interface.
+type MockServiceQuotasClient struct {
+	ctrl     *gomock.Controller
+	recorder *MockServiceQuotasClientMockRecorder
+}
+
+// MockServiceQuotasClientMockRecorder is the mock recorder for MockServiceQuotasClient.
+type MockServiceQuotasClientMockRecorder struct {
+	mock *MockServiceQuotasClient
+}
+
+// NewMockServiceQuotasClient creates a new mock instance.
+func NewMockServiceQuotasClient(ctrl *gomock.Controller) *MockServiceQuotasClient {
+	mock := &MockServiceQuotasClient{ctrl: ctrl}
+	mock.recorder = &MockServiceQuotasClientMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockServiceQuotasClient) EXPECT() *MockServiceQuotasClientMockRecorder {
+	return m.recorder
+}
+
+// GetQuotaValue mocks base method.
+func (m *MockServiceQuotasClient) GetQuotaValue(serviceCode, quotaCode string) (float64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetQuotaValue", serviceCode, quotaCode)
+	ret0, _ := ret[0].(float64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetQuotaValue indicates an expected call of GetQuotaValue.
+func (mr *MockServiceQuotasClientMockRecorder) GetQuotaValue(serviceCode, quotaCode interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetQuotaValue", reflect.TypeOf((*MockServiceQuotasClient)(nil).GetQuotaValue), serviceCode, quotaCode)
+}
+
+// MockEfsClient is a mock of EfsClient interface.
+type MockEfsClient struct {
+	ctrl     *gomock.Controller
+	recorder *MockEfsClientMockRecorder
+}
+
+// MockEfsClientMockRecorder is the mock recorder for MockEfsClient.
+type MockEfsClientMockRecorder struct {
+	mock *MockEfsClient
+}
+
+// NewMockEfsClient creates a new mock instance.
+func NewMockEfsClient(ctrl *gomock.Controller) *MockEfsClient {
+	mock := &MockEfsClient{ctrl: ctrl}
+	mock.recorder = &MockEfsClientMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockEfsClient) EXPECT() *MockEfsClientMockRecorder {
+	return m.recorder
+}
+
+// DeleteFileSystem mocks base method.
+func (m *MockEfsClient) DeleteFileSystem(fileSystemId string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteFileSystem", fileSystemId)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteFileSystem indicates an expected call of DeleteFileSystem.
+func (mr *MockEfsClientMockRecorder) DeleteFileSystem(fileSystemId interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteFileSystem", reflect.TypeOf((*MockEfsClient)(nil).DeleteFileSystem), fileSystemId)
+}
+
+// FileSystemExists mocks base method.
+func (m *MockEfsClient) FileSystemExists(fileSystemId string) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FileSystemExists", fileSystemId)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FileSystemExists indicates an expected call of FileSystemExists.
+func (mr *MockEfsClientMockRecorder) FileSystemExists(fileSystemId interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FileSystemExists", reflect.TypeOf((*MockEfsClient)(nil).FileSystemExists), fileSystemId)
+}
+
+// MockCostExplorerClient is a mock of CostExplorerClient interface.
+type MockCostExplorerClient struct {
+	ctrl     *gomock.Controller
+	recorder *MockCostExplorerClientMockRecorder
+}
+
+// MockCostExplorerClientMockRecorder is the mock recorder for MockCostExplorerClient.
+type MockCostExplorerClientMockRecorder struct {
+	mock *MockCostExplorerClient
+}
+
+// NewMockCostExplorerClient creates a new mock instance.
+func NewMockCostExplorerClient(ctrl *gomock.Controller) *MockCostExplorerClient {
+	mock := &MockCostExplorerClient{ctrl: ctrl}
+	mock.recorder = &MockCostExplorerClientMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockCostExplorerClient) EXPECT() *MockCostExplorerClientMockRecorder {
+	return m.recorder
+}
+
+// GetMonthToDateCost mocks base method.
+func (m *MockCostExplorerClient) GetMonthToDateCost(tags map[string]string) (float64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetMonthToDateCost", tags)
+	ret0, _ := ret[0].(float64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetMonthToDateCost indicates an expected call of GetMonthToDateCost.
+func (mr *MockCostExplorerClientMockRecorder) GetMonthToDateCost(tags interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetMonthToDateCost", reflect.TypeOf((*MockCostExplorerClient)(nil).GetMonthToDateCost), tags)
+}
+
+// MockTaggingClient is a mock of TaggingClient interface.
+type MockTaggingClient struct {
+	ctrl     *gomock.Controller
+	recorder *MockTaggingClientMockRecorder
+}
+
+// MockTaggingClientMockRecorder is the mock recorder for MockTaggingClient.
+type MockTaggingClientMockRecorder struct {
+	mock *MockTaggingClient
+}
+
+// NewMockTaggingClient creates a new mock instance.
+func NewMockTaggingClient(ctrl *gomock.Controller) *MockTaggingClient {
+	mock := &MockTaggingClient{ctrl: ctrl}
+	mock.recorder = &MockTaggingClientMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockTaggingClient) EXPECT() *MockTaggingClientMockRecorder {
+	return m.recorder
+}
+
+// GetTaggedResources mocks base method.
+func (m *MockTaggingClient) GetTaggedResources(tagKey, tagValue string) ([]tagging.Resource, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTaggedResources", tagKey, tagValue)
+	ret0, _ := ret[0].([]tagging.Resource)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTaggedResources indicates an expected call of GetTaggedResources.
+func (mr *MockTaggingClientMockRecorder) GetTaggedResources(tagKey, tagValue interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTaggedResources", reflect.TypeOf((*MockTaggingClient)(nil).GetTaggedResources), tagKey, tagValue)
+}
@@ -62,6 +62,21 @@ func (mr *MockContextManagerMockRecorder) Destroy(contexts interface{}) *gomock.
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Destroy", reflect.TypeOf((*MockContextManager)(nil).Destroy), contexts)
 }
 
+// History mocks base method.
+func (m *MockContextManager) History(contextName string) ([]context.Deployment, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "History", contextName)
+	ret0, _ := ret[0].([]context.Deployment)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// History indicates an expected call of History.
+func (mr *MockContextManagerMockRecorder) History(contextName interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "History", reflect.TypeOf((*MockContextManager)(nil).History), contextName)
+}
+
 // Info mocks base method.
 func (m *MockContextManager) Info(contextName string) (context.Detail, error) {
 	m.ctrl.T.Helper()
@@ -92,6 +107,20 @@ func (mr *MockContextManagerMockRecorder) List() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockContextManager)(nil).List))
 }
 
+// Rollback mocks base method.
+func (m *MockContextManager) Rollback(contextName, deploymentId string) []context.ProgressResult {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Rollback", contextName, deploymentId)
+	ret0, _ := ret[0].([]context.ProgressResult)
+	return ret0
+}
+
+// Rollback indicates an expected call of Rollback.
+func (mr *MockContextManagerMockRecorder) Rollback(contextName, deploymentId interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Rollback", reflect.TypeOf((*MockContextManager)(nil).Rollback), contextName, deploymentId)
+}
+
 // StatusList mocks base method.
 func (m *MockContextManager) StatusList() ([]context.Instance, error) {
 	m.ctrl.T.Helper()
@@ -106,3 +135,17 @@ func (mr *MockContextManagerMockRecorder) StatusList() *gomock.Call {
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StatusList", reflect.TypeOf((*MockContextManager)(nil).StatusList))
 }
+
+// Upgrade mocks base method.
+func (m *MockContextManager) Upgrade(projectName, ownerId string, contexts []string) []context.ProgressResult {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Upgrade", projectName, ownerId, contexts)
+	ret0, _ := ret[0].([]context.ProgressResult)
+	return ret0
+}
+
+// Upgrade indicates an expected call of Upgrade.
+func (mr *MockContextManagerMockRecorder) Upgrade(projectName, ownerId, contexts interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Upgrade", reflect.TypeOf((*MockContextManager)(nil).Upgrade), projectName, ownerId, contexts)
+}
@@ -8,4 +8,5 @@ type WorkflowManager interface {
 	workflow.TasksManager
 	workflow.StatusManager
 	workflow.OutputManager
+	workflow.InputsManager
 }
@@ -9,6 +9,7 @@ import (
 	reflect "reflect"
 
 	workflow "github.com/aws/amazon-genomics-cli/internal/pkg/cli/workflow"
+	inputs "github.com/aws/amazon-genomics-cli/internal/pkg/cli/workflow/inputs"
 	gomock "github.com/golang/mock/gomock"
 )
 
@@ -35,6 +36,21 @@ func (m *MockWorkflowManager) EXPECT() *MockWorkflowManagerMockRecorder {
 	return m.recorder
 }
 
+// DiscoverInputs mocks base method.
+func (m *MockWorkflowManager) DiscoverInputs(workflowName string) ([]inputs.Parameter, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DiscoverInputs", workflowName)
+	ret0, _ := ret[0].([]inputs.Parameter)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DiscoverInputs indicates an expected call of DiscoverInputs.
+func (mr *MockWorkflowManagerMockRecorder) DiscoverInputs(workflowName interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DiscoverInputs", reflect.TypeOf((*MockWorkflowManager)(nil).DiscoverInputs), workflowName)
+}
+
 // GetRunLog mocks base method.
 func (m *MockWorkflowManager) GetRunLog(runId string) (workflow.RunLog, error) {
 	m.ctrl.T.Helper()
@@ -35,6 +35,20 @@ func (m *MockProjectClient) EXPECT() *MockProjectClientMockRecorder {
 	return m.recorder
 }
 
+// Edit mocks base method.
+func (m *MockProjectClient) Edit(edit func(*spec.Editor) error) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Edit", edit)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Edit indicates an expected call of Edit.
+func (mr *MockProjectClientMockRecorder) Edit(edit interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Edit", reflect.TypeOf((*MockProjectClient)(nil).Edit), edit)
+}
+
 // GetLocation mocks base method.
 func (m *MockProjectClient) GetLocation() string {
 	m.ctrl.T.Helper()
@@ -131,6 +145,21 @@ func (m *MockConfigClient) EXPECT() *MockConfigClientMockRecorder {
 	return m.recorder
 }
 
+// GetCredentials mocks base method.
+func (m *MockConfigClient) GetCredentials() (config.Credentials, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetCredentials")
+	ret0, _ := ret[0].(config.Credentials)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetCredentials indicates an expected call of GetCredentials.
+func (mr *MockConfigClientMockRecorder) GetCredentials() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCredentials", reflect.TypeOf((*MockConfigClient)(nil).GetCredentials))
+}
+
 // GetFormat mocks base method.
 func (m *MockConfigClient) GetFormat() (string, error) {
 	m.ctrl.T.Helper()
@@ -146,6 +175,21 @@ func (mr *MockConfigClientMockRecorder) GetFormat() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetFormat", reflect.TypeOf((*MockConfigClient)(nil).GetFormat))
 }
 
+// GetNetwork mocks base method.
+func (m *MockConfigClient) GetNetwork() (config.Network, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetNetwork")
+	ret0, _ := ret[0].(config.Network)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetNetwork indicates an expected call of GetNetwork.
+func (mr *MockConfigClientMockRecorder) GetNetwork() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetNetwork", reflect.TypeOf((*MockConfigClient)(nil).GetNetwork))
+}
+
 // GetUserEmailAddress mocks base method.
 func (m *MockConfigClient) GetUserEmailAddress() (string, error) {
 	m.ctrl.T.Helper()
@@ -176,6 +220,21 @@ func (mr *MockConfigClientMockRecorder) GetUserId() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUserId", reflect.TypeOf((*MockConfigClient)(nil).GetUserId))
 }
 
+// ListProfiles mocks base method.
+func (m *MockConfigClient) ListProfiles() ([]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListProfiles")
+	ret0, _ := ret[0].([]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListProfiles indicates an expected call of ListProfiles.
+func (mr *MockConfigClientMockRecorder) ListProfiles() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListProfiles", reflect.TypeOf((*MockConfigClient)(nil).ListProfiles))
+}
+
 // Read mocks base method.
 func (m *MockConfigClient) Read() (config.Config, error) {
 	m.ctrl.T.Helper()
@@ -191,6 +250,20 @@ func (mr *MockConfigClientMockRecorder) Read() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Read", reflect.TypeOf((*MockConfigClient)(nil).Read))
 }
 
+// SetCredentials mocks base method.
+func (m *MockConfigClient) SetCredentials(credentials config.Credentials) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetCredentials", credentials)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetCredentials indicates an expected call of SetCredentials.
+func (mr *MockConfigClientMockRecorder) SetCredentials(credentials interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetCredentials", reflect.TypeOf((*MockConfigClient)(nil).SetCredentials), credentials)
+}
+
 // SetFormat mocks base method.
 func (m *MockConfigClient) SetFormat(format string) error {
 	m.ctrl.T.Helper()
@@ -205,6 +278,20 @@ func (mr *MockConfigClientMockRecorder) SetFormat(format interface{}) *gomock.Ca
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetFormat", reflect.TypeOf((*MockConfigClient)(nil).SetFormat), format)
 }
 
+// SetNetwork mocks base method.
+func (m *MockConfigClient) SetNetwork(network config.Network) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetNetwork", network)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetNetwork indicates an expected call of SetNetwork.
+func (mr *MockConfigClientMockRecorder) SetNetwork(network interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetNetwork", reflect.TypeOf((*MockConfigClient)(nil).SetNetwork), network)
+}
+
 // SetUserEmailAddress mocks base method.
 func (m *MockConfigClient) SetUserEmailAddress(userId string) error {
 	m.ctrl.T.Helper()
@@ -219,6 +306,34 @@ func (mr *MockConfigClientMockRecorder) SetUserEmailAddress(userId interface{})
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetUserEmailAddress", reflect.TypeOf((*MockConfigClient)(nil).SetUserEmailAddress), userId)
 }
 
+// UseProfile mocks base method.
+func (m *MockConfigClient) UseProfile(profileName string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UseProfile", profileName)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UseProfile indicates an expected call of UseProfile.
+func (mr *MockConfigClientMockRecorder) UseProfile(profileName interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UseProfile", reflect.TypeOf((*MockConfigClient)(nil).UseProfile), profileName)
+}
+
+// Write mocks base method.
+func (m *MockConfigClient) Write(configData config.Config) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Write", configData)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Write indicates an expected call of Write.
+func (mr *MockConfigClientMockRecorder) Write(configData interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Write", reflect.TypeOf((*MockConfigClient)(nil).Write), configData)
+}
+
 // MockStorageClient is a mock of StorageClient interface.
 type MockStorageClient struct {
 	ctrl     *gomock.Controller
@@ -82,6 +82,21 @@ func (mr *MockWesClientMockRecorder) GetRunStatus(ctx, runId interface{}) *gomoc
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRunStatus", reflect.TypeOf((*MockWesClient)(nil).GetRunStatus), ctx, runId)
 }
 
+// ListRuns mocks base method.
+func (m *MockWesClient) ListRuns(ctx context.Context) ([]wes_client.RunStatus, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListRuns", ctx)
+	ret0, _ := ret[0].([]wes_client.RunStatus)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListRuns indicates an expected call of ListRuns.
+func (mr *MockWesClientMockRecorder) ListRuns(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListRuns", reflect.TypeOf((*MockWesClient)(nil).ListRuns), ctx)
+}
+
 // RunWorkflow mocks base method.
 func (m *MockWesClient) RunWorkflow(ctx context.Context, options ...option.Func) (string, error) {
 	m.ctrl.T.Helper()
@@ -1,7 +1,7 @@
 // Code generated by MockGen. DO NOT EDIT.
-// Source: ./interfaces.go
+// Source: ./internal/pkg/mocks/workflow/interfaces.go
 
-// Package mock_workflow is a generated GoMock package.
+// Package workflowmocks is a generated GoMock package.
 package workflowmocks
 
 import (
@@ -49,6 +49,21 @@ func (mr *MockWorkflowManagerMockRecorder) GetWorkflowTasks(runId interface{}) *
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetWorkflowTasks", reflect.TypeOf((*MockWorkflowManager)(nil).GetWorkflowTasks), runId)
 }
 
+// ListActiveRunIdsByContext mocks base method.
+func (m *MockWorkflowManager) ListActiveRunIdsByContext(contextName string) ([]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListActiveRunIdsByContext", contextName)
+	ret0, _ := ret[0].([]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListActiveRunIdsByContext indicates an expected call of ListActiveRunIdsByContext.
+func (mr *MockWorkflowManagerMockRecorder) ListActiveRunIdsByContext(contextName interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListActiveRunIdsByContext", reflect.TypeOf((*MockWorkflowManager)(nil).ListActiveRunIdsByContext), contextName)
+}
+
 // ListWorkflows mocks base method.
 func (m *MockWorkflowManager) ListWorkflows() (map[string]workflow.Summary, error) {
 	m.ctrl.T.Helper()
@@ -65,18 +80,18 @@ func (mr *MockWorkflowManagerMockRecorder) ListWorkflows() *gomock.Call {
 }
 
 // RunWorkflow mocks base method.
-func (m *MockWorkflowManager) RunWorkflow(contextName, workflowName, argumentsUrl string, optionFileUrl string) (string, error) {
+func (m *MockWorkflowManager) RunWorkflow(contextName, workflowName, argumentsUrl, optionFileUrl string, overrideBudget bool) (string, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "RunWorkflow", contextName, workflowName, argumentsUrl, optionFileUrl)
+	ret := m.ctrl.Call(m, "RunWorkflow", contextName, workflowName, argumentsUrl, optionFileUrl, overrideBudget)
 	ret0, _ := ret[0].(string)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // RunWorkflow indicates an expected call of RunWorkflow.
-func (mr *MockWorkflowManagerMockRecorder) RunWorkflow(contextName, workflowName, argumentsUrl interface{}, optionFileUrl interface{}) *gomock.Call {
+func (mr *MockWorkflowManagerMockRecorder) RunWorkflow(contextName, workflowName, argumentsUrl, optionFileUrl, overrideBudget interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RunWorkflow", reflect.TypeOf((*MockWorkflowManager)(nil).RunWorkflow), contextName, workflowName, argumentsUrl, optionFileUrl)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RunWorkflow", reflect.TypeOf((*MockWorkflowManager)(nil).RunWorkflow), contextName, workflowName, argumentsUrl, optionFileUrl, overrideBudget)
 }
 
 // StatusWorkflowAll mocks base method.
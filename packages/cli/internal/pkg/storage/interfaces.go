@@ -20,6 +20,7 @@ type StorageClient interface {
 type ProjectClient interface {
 	Read() (spec.Project, error)
 	Write(projectSpec spec.Project) error
+	Edit(edit func(editor *spec.Editor) error) error
 	IsInitialized() (bool, error)
 	GetProjectName() (string, error)
 	GetLocation() string
@@ -27,11 +28,18 @@ type ProjectClient interface {
 
 type ConfigClient interface {
 	Read() (config.Config, error)
+	Write(configData config.Config) error
+	ListProfiles() ([]string, error)
+	UseProfile(profileName string) error
 	GetUserEmailAddress() (string, error)
 	SetUserEmailAddress(userId string) error
 	GetUserId() (string, error)
 	GetFormat() (string, error)
 	SetFormat(format string) error
+	GetCredentials() (config.Credentials, error)
+	SetCredentials(credentials config.Credentials) error
+	GetNetwork() (config.Network, error)
+	SetNetwork(network config.Network) error
 }
 
 type InputClient interface {
@@ -93,6 +93,35 @@ func (c FSProjectClient) Write(projectSpec spec.Project) error {
 	return spec.ToYaml(dirToSpecPath(c.RootPath), projectSpec)
 }
 
+// Edit changes the project specification in place with an editor that keeps its comments and layout. The edited
+// specification is validated before it is written.
+func (c FSProjectClient) Edit(edit func(editor *spec.Editor) error) error {
+	specFilePath := dirToSpecPath(c.RootPath)
+	fileInfo, err := os.Stat(specFilePath)
+	if err != nil {
+		return err
+	}
+	yamlBytes, err := os.ReadFile(specFilePath)
+	if err != nil {
+		return err
+	}
+	editor, err := spec.NewEditor(yamlBytes)
+	if err != nil {
+		return err
+	}
+	if err := edit(editor); err != nil {
+		return err
+	}
+	editedBytes, err := editor.Bytes()
+	if err != nil {
+		return err
+	}
+	if err := spec.ValidateProjectInDirectory(c.RootPath, editedBytes); err != nil {
+		return err
+	}
+	return os.WriteFile(specFilePath, editedBytes, fileInfo.Mode().Perm())
+}
+
 func (c FSProjectClient) IsInitialized() (bool, error) {
 	fileName := dirToSpecPath(c.RootPath)
 	specStat, err := os.Stat(fileName)
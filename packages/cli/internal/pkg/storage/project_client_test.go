@@ -5,6 +5,7 @@ import (
 	"path/filepath"
 	"testing"
 
+	"github.com/aws/amazon-genomics-cli/internal/pkg/cli/spec"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
@@ -90,3 +91,52 @@ func TestFSProjectClient_NewClient_CanFindProjectFileGoingUp(t *testing.T) {
 		})
 	}
 }
+
+const editTestSpec = `name: demo
+schemaVersion: 1
+contexts:
+  ctx1:
+    engines:
+      - type: wdl
+        engine: cromwell
+# shared reference data
+data:
+  - location: s3://bucket/reference
+`
+
+func TestFSProjectClient_Edit(t *testing.T) {
+	tempDir := t.TempDir()
+	specFilePath := filepath.Join(tempDir, ProjectSpecFileName)
+	require.NoError(t, os.WriteFile(specFilePath, []byte(editTestSpec), 0600))
+	client, err := NewProjectClientWithLocation(tempDir)
+	require.NoError(t, err)
+
+	err = client.Edit(func(editor *spec.Editor) error {
+		return editor.AddData(spec.Data{Location: "s3://bucket/reads", ReadOnly: true})
+	})
+	require.NoError(t, err)
+
+	actual, err := os.ReadFile(specFilePath)
+	require.NoError(t, err)
+	assert.Equal(t, editTestSpec+"  - location: s3://bucket/reads\n    readOnly: true\n", string(actual))
+	fileInfo, err := os.Stat(specFilePath)
+	require.NoError(t, err)
+	assert.Equal(t, os.FileMode(0600), fileInfo.Mode().Perm())
+}
+
+func TestFSProjectClient_Edit_InvalidResult(t *testing.T) {
+	tempDir := t.TempDir()
+	specFilePath := filepath.Join(tempDir, ProjectSpecFileName)
+	require.NoError(t, os.WriteFile(specFilePath, []byte(editTestSpec), 0644))
+	client, err := NewProjectClientWithLocation(tempDir)
+	require.NoError(t, err)
+
+	err = client.Edit(func(editor *spec.Editor) error {
+		return editor.AddContext("ctx2", spec.Context{Extends: "missing"})
+	})
+	assert.Error(t, err)
+
+	actual, err := os.ReadFile(specFilePath)
+	require.NoError(t, err)
+	assert.Equal(t, editTestSpec, string(actual))
+}
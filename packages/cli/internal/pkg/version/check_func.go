@@ -5,6 +5,7 @@ import (
 	"time"
 
 	"github.com/aws/amazon-genomics-cli/internal/pkg/environment"
+	"github.com/aws/amazon-genomics-cli/internal/pkg/network"
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/s3"
 	"github.com/rs/zerolog/log"
@@ -55,6 +56,7 @@ func Check() (Result, error) {
 	cfg := aws.Config{
 		Region:      "us-east-1",
 		Credentials: aws.AnonymousCredentials{},
+		HTTPClient:  network.HttpClient(),
 	}
 	s3Client := newS3ClientFromConfig(cfg)
 	currentTime := getCurrentTime()
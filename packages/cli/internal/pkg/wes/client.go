@@ -4,6 +4,7 @@ import (
 	"context"
 	"io"
 
+	"github.com/antihax/optional"
 	"github.com/aws/amazon-genomics-cli/internal/pkg/wes/option"
 	"github.com/rs/zerolog/log"
 	wes "github.com/rsc/wes_client"
@@ -53,6 +54,23 @@ func (c *Client) GetRunLog(ctx context.Context, runId string) (wes.RunLog, error
 	return runLog, err
 }
 
+// ListRuns returns the status of all runs known to the workflow engine, following pagination
+func (c *Client) ListRuns(ctx context.Context) ([]wes.RunStatus, error) {
+	var runs []wes.RunStatus
+	opts := &wes.ListRunsOpts{}
+	for {
+		response, _, err := c.wes.ListRuns(ctx, opts)
+		if err != nil {
+			return nil, err
+		}
+		runs = append(runs, response.Runs...)
+		if response.NextPageToken == "" {
+			return runs, nil
+		}
+		opts.PageToken = optional.NewString(response.NextPageToken)
+	}
+}
+
 func (c *Client) GetRunLogData(ctx context.Context, runId string, dataUrl string) (*io.ReadCloser, error) {
 	runLogDataStream, _, err := c.wes.GetRunLogData(ctx, runId, dataUrl)
 	return runLogDataStream, err
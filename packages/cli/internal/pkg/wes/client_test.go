@@ -15,6 +15,7 @@ import (
 type testApi struct {
 	t                       *testing.T
 	expectedWorkflowRunOpts *wes.RunWorkflowOpts
+	runPages                map[string]wes.RunListResponse
 }
 
 func (api testApi) CancelRun(ctx context.Context, runId string) (wes.RunId, *http.Response, error) {
@@ -30,7 +31,7 @@ func (api testApi) GetServiceInfo(ctx context.Context) (wes.ServiceInfo, *http.R
 	return wes.ServiceInfo{}, nil, nil
 }
 func (api testApi) ListRuns(ctx context.Context, localVarOptionals *wes.ListRunsOpts) (wes.RunListResponse, *http.Response, error) {
-	return wes.RunListResponse{}, nil, nil
+	return api.runPages[localVarOptionals.PageToken.Value()], nil, nil
 }
 func (api testApi) RunWorkflow(ctx context.Context, localVarOptionals *wes.RunWorkflowOpts) (wes.RunId, *http.Response, error) {
 	assert.Equal(api.t, api.expectedWorkflowRunOpts, localVarOptionals)
@@ -96,3 +97,24 @@ func TestClient_RunWorkflow(t *testing.T) {
 		})
 	}
 }
+
+func TestClient_ListRuns(t *testing.T) {
+	mockApi := testApi{
+		t: t,
+		runPages: map[string]wes.RunListResponse{
+			"": {
+				Runs:          []wes.RunStatus{{RunId: "run-1", State: wes.RUNNING}},
+				NextPageToken: "page-2",
+			},
+			"page-2": {
+				Runs: []wes.RunStatus{{RunId: "run-2", State: wes.COMPLETE}},
+			},
+		},
+	}
+	client := &Client{wes: mockApi}
+
+	runs, err := client.ListRuns(context.Background())
+
+	assert.NoError(t, err)
+	assert.Equal(t, []wes.RunStatus{{RunId: "run-1", State: wes.RUNNING}, {RunId: "run-2", State: wes.COMPLETE}}, runs)
+}
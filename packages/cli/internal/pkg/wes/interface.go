@@ -14,4 +14,5 @@ type Interface interface {
 	StopWorkflow(ctx context.Context, runId string) error
 	GetRunLog(ctx context.Context, runId string) (wes.RunLog, error)
 	GetRunLogData(ctx context.Context, runId string, dataUrl string) (*io.ReadCloser, error)
+	ListRuns(ctx context.Context) ([]wes.RunStatus, error)
 }
@@ -9,7 +9,8 @@
 set -e  # fail on any error
 
 echo "=== ENVIRONMENT ==="
-printenv
+# the values of the secrets of the context are not printed
+printenv | grep -v -E "^(${AGC_TASK_SECRET_NAMES//,/|})=" || true
 
 echo "=== RUN COMMAND ==="
 echo "$@"
@@ -104,6 +105,11 @@ if [[ "$MINIWDL_PROJECT" =~ ^s3://.* ]]; then
     fi
 fi
 
+# forward the environment variables and secrets of the context to the workflow tasks
+for NAME in ${AGC_TASK_ENVIRONMENT_NAMES//,/ }; do
+    MINIWDL_PARAMS="${MINIWDL_PARAMS} --env ${NAME}"
+done
+
 echo "== Running Workflow =="
 MINIWDL_PARAMS="${MINIWDL_PARAMS}
   --dir ${WORK_DIR}/.
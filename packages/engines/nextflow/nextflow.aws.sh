@@ -13,7 +13,8 @@ DEFAULT_AWS_CLI_PATH=/opt/aws-cli/bin/aws
 AWS_CLI_PATH=${JOB_AWS_CLI_PATH:-$DEFAULT_AWS_CLI_PATH}
 
 echo "=== ENVIRONMENT ==="
-printenv
+# the values of the secrets of the context are not printed
+printenv | grep -v -E "^(${AGC_TASK_SECRET_NAMES//,/|})=" || true
 
 echo "=== RUN COMMAND ==="
 echo "$@"
@@ -48,11 +49,42 @@ process.queue = "$NF_JOB_QUEUE"
 aws.batch.cliPath = "$AWS_CLI_PATH"
 EOF
 
+# forward the environment variables of the context to the workflow tasks
+for NAME in ${AGC_TASK_ENVIRONMENT_NAMES//,/ }; do
+    if [[ ",${AGC_TASK_SECRET_NAMES}," != *",${NAME},"* ]]; then
+        echo "env.$NAME = System.getenv('$NAME')" >> $NF_CONFIG
+    fi
+done
+
+# the tasks read the secrets of the context from their store when they start, so that their values are never
+# written to the task scripts in the work directory. NF_TASK_SECRETS lists them as NAME=<source>:<name or ARN>
+AWS_REGION=$(echo ${NF_JOB_QUEUE} | cut -f4 -d':')
+BEFORE_SCRIPT=""
+for SECRET in ${NF_TASK_SECRETS//,/ }; do
+    NAME=${SECRET%%=*}
+    REFERENCE=${SECRET#*=}
+    if [[ "${REFERENCE%%:*}" == "secretsManager" ]]; then
+        READ_SECRET="$AWS_CLI_PATH secretsmanager get-secret-value --region $AWS_REGION --secret-id ${REFERENCE#*:} --query SecretString --output text"
+    else
+        READ_SECRET="$AWS_CLI_PATH ssm get-parameter --region $AWS_REGION --with-decryption --name ${REFERENCE#*:} --query Parameter.Value --output text"
+    fi
+    BEFORE_SCRIPT="${BEFORE_SCRIPT}export $NAME=\$($READ_SECRET); "
+done
+if [[ -n "$BEFORE_SCRIPT" ]]; then
+    echo "process.beforeScript = '$BEFORE_SCRIPT'" >> $NF_CONFIG
+fi
+
 if [[ "$EFS_MOUNT" != "" ]]
 then
     echo aws.batch.volumes = [\"/mnt/efs\"] >> $NF_CONFIG
 fi
 
+# send the request payer header for the requester pays data buckets of the project
+if [[ "$NF_REQUESTER_PAYS" == "true" ]]
+then
+    echo aws.client.requesterPays = true >> $NF_CONFIG
+fi
+
 echo "=== CONFIGURATION ==="
 cat ./nextflow.config
 
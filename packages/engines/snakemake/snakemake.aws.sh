@@ -10,7 +10,8 @@ AWS_CLI_PATH=${JOB_AWS_CLI_PATH:-$DEFAULT_AWS_CLI_PATH}
 
 
 echo "=== ENVIRONMENT ==="
-printenv
+# the values of the secrets of the context are not printed
+printenv | grep -v -E "^(${AGC_TASK_SECRET_NAMES//,/|})=" || true
 
 # View paramaters to the script 
 echo "=== RUN COMMAND ==="
@@ -139,6 +140,10 @@ if [[ "$ENGINE_PROJECT" =~ ^s3://.* ]]; then
       ENGINE_PARAMS="${ENGINE_PARAMS} --aws-batch-tags AWS_BATCH_PARENT_JOB_ID=${AWS_BATCH_JOB_ID}  --aws-batch-efs-project-path=snakemake/$GUID --latency-wait 60"
     fi
 fi
+# forward the environment variables and secrets of the context to the workflow tasks
+if [[ -n "$AGC_TASK_ENVIRONMENT_NAMES" ]]; then
+    ENGINE_PARAMS="${ENGINE_PARAMS} --envvars ${AGC_TASK_ENVIRONMENT_NAMES//,/ }"
+fi
 echo "== Finding the project in  =="
 echo "cd ${ENGINE_PROJECT}"
 cd ${ENGINE_PROJECT}
@@ -260,6 +260,29 @@ def test_run_workflow(aws_batch: BatchClient, adapter: StubBatchAdapter):
     assert batch_job_id.run_id == job_id
 
 
+def test_run_workflow_with_job_tags(aws_batch: BatchClient):
+    job_tags = {"cost-center": "1234", "agc-project": "demo"}
+    adapter = StubBatchAdapter(
+        job_queue=job_queue,
+        job_definition=job_definition,
+        aws_batch=aws_batch,
+        job_tags=job_tags,
+    )
+    workflow_url = "s3://my_workflow/"
+    aws_batch.submit_job.return_value = {"jobId": job_id}
+    adapter.run_workflow(workflow_url=workflow_url)
+    aws_batch.submit_job.assert_called_with(
+        jobName=job_name,
+        jobQueue=job_queue,
+        jobDefinition=job_definition,
+        containerOverrides={
+            "command": [workflow_url],
+        },
+        tags=job_tags,
+        propagateTags=True,
+    )
+
+
 def test_get_service_info(adapter: StubBatchAdapter):
     service_info = adapter.get_service_info()
     assert service_info.supported_wes_versions == ["1.0.0"]
@@ -1,4 +1,5 @@
 import traceback
+import json
 import os
 import typing
 import time
@@ -24,6 +25,7 @@ from rest_api.models import (
 )
 
 USER_CANCELLATION_REASON = "User Canceled"
+JOB_TAGS = os.getenv("JOB_TAGS")
 
 
 class BatchAdapter(AbstractWESAdapter):
@@ -37,10 +39,14 @@ class BatchAdapter(AbstractWESAdapter):
         job_definition: str,
         aws_batch: BatchClient = None,
         logger=None,
+        job_tags: typing.Optional[typing.Dict[str, str]] = None,
     ):
         super().__init__(logger)
         self.job_queue = job_queue
         self.job_definition = job_definition
+        self.job_tags = (
+            job_tags if job_tags is not None else json.loads(JOB_TAGS or "{}")
+        )
         self.aws_batch: BatchClient = (
             aws_batch
             if aws_batch
@@ -163,6 +169,10 @@ class BatchAdapter(AbstractWESAdapter):
             workflow_attachment=workflow_attachment,
         )
 
+        # The workflow job and the ECS task it runs in carry the tags of the context
+        tag_args = (
+            {"tags": self.job_tags, "propagateTags": True} if self.job_tags else {}
+        )
         submit_job_response = self.aws_batch.submit_job(
             jobName="agc-run-workflow",
             jobQueue=self.job_queue,
@@ -170,6 +180,7 @@ class BatchAdapter(AbstractWESAdapter):
             containerOverrides={
                 "command": command,
             },
+            **tag_args,
         )
         return RunId(submit_job_response["jobId"])
 
@@ -15,16 +15,31 @@ import (
 )
 
 // NewSigningHttpClient returns an *http.Client that will sign all requests with AWS V4 Signing.
+// The credentials of the config, such as those of an assumed role, are cached and refreshed when they expire.
+// Requests are sent with the transport of the config's HTTP client when it exposes one, so that the proxy and
+// certificate authorities of the SDK clients also apply to the signed requests.
 func NewSigningHttpClient(config aws.Config) (*http.Client, error) {
 	if config.Region == "" {
 		return nil, fmt.Errorf("aws region is not set")
 	}
+	if config.Credentials == nil {
+		return nil, fmt.Errorf("aws credentials are not set")
+	}
+	if _, ok := config.Credentials.(*aws.CredentialsCache); !ok {
+		config.Credentials = aws.NewCredentialsCache(config.Credentials)
+	}
+
+	var wrapped http.RoundTripper = http.DefaultTransport
+	if transportClient, ok := config.HTTPClient.(interface{ GetTransport() *http.Transport }); ok {
+		wrapped = transportClient.GetTransport()
+	}
 
-	client := http.DefaultClient
-	client.Transport = &sigV4Transport{
-		wrapped: http.DefaultTransport,
-		signer:  sigv4.NewSigner(),
-		config:  config,
+	client := &http.Client{
+		Transport: &sigV4Transport{
+			wrapped: wrapped,
+			signer:  sigv4.NewSigner(),
+			config:  config,
+		},
 	}
 
 	return client, nil